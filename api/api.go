@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	proto "gogrpc-rpc-boiler/proto"
@@ -84,13 +85,39 @@ func main() {
         return c.JSON(fiber.Map{"message": res.ResponseStr})
     })
 
+    // public signing keys, lets other services verify our tokens
+    app.Get("/.well-known/jwks.json", func(c *fiber.Ctx) error {
+        ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+        defer cancel()
+
+        res, err := utilClient.GetJWKS(ctx, &emptypb.Empty{})
+        if err != nil {
+            return c.Status(500).SendString("Error calling UtilService: " + err.Error())
+        }
+
+        keys := []fiber.Map{}
+        for _, k := range res.Keys {
+            jwk := fiber.Map{"kty": k.Kty, "kid": k.Kid, "alg": k.Alg, "use": k.Use}
+            if k.Kty == "RSA" {
+                jwk["n"] = k.N
+                jwk["e"] = k.E
+            } else {
+                jwk["crv"] = k.Crv
+                jwk["x"] = k.X
+            }
+            keys = append(keys, jwk)
+        }
+
+        c.Set("Cache-Control", "public, max-age=300")
+        return c.JSON(fiber.Map{"keys": keys})
+    })
 
     // USER REST INTERFACE
 
     app.Post("/createuser", func(c *fiber.Ctx) error {
         bearerToken := c.Get("Authorization")
         ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
-        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        ctx, cancel := context.WithTimeout(ctx, 15*time.Second) // sends the verification email
         defer cancel()
 
         // INPUT
@@ -114,7 +141,7 @@ func main() {
 
     app.Post("/login", func(c *fiber.Ctx) error {
         bearerToken := c.Get("Authorization")
-        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken, "x-forwarded-for", c.IP())
         ctx, cancel := context.WithTimeout(ctx, time.Second)
         defer cancel()
 
@@ -125,12 +152,50 @@ func main() {
             return c.Status(500).SendString("Error calling UserService: " + err.Error())
         }
 
+        return c.JSON(fiber.Map{
+            "access_token":  res.AccessToken,
+            "refresh_token": res.RefreshToken,
+            "token_type":    res.TokenType,
+            "expires_in":    res.ExpiresIn,
+        })
+    })
+
+    app.Post("/refreshtoken", func(c *fiber.Ctx) error {
+        ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+        defer cancel()
+
+        // INPUT
+        req := &proto.StringRequest{RequestStr: c.FormValue("refresh_token")}
+        res, err := userClient.RefreshToken(ctx, req)
+        if err != nil {
+            return c.Status(500).SendString("Error calling UserService: " + err.Error())
+        }
+
+        return c.JSON(fiber.Map{
+            "access_token":  res.AccessToken,
+            "refresh_token": res.RefreshToken,
+            "token_type":    res.TokenType,
+            "expires_in":    res.ExpiresIn,
+        })
+    })
+
+    app.Post("/logout", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        res, err := userClient.Logout(ctx, &emptypb.Empty{})
+        if err != nil {
+            return c.Status(500).SendString("Error calling UserService: " + err.Error())
+        }
+
         return c.JSON(fiber.Map{"message": res.ResponseStr})
     })
 
     app.Post("/changepassword", func(c *fiber.Ctx) error {
         bearerToken := c.Get("Authorization")
-        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken, "x-forwarded-for", c.IP())
         ctx, cancel := context.WithTimeout(ctx, time.Second)
         defer cancel()
 
@@ -150,7 +215,7 @@ func main() {
 
     app.Post("/deleteuser", func(c *fiber.Ctx) error {
         bearerToken := c.Get("Authorization")
-        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken, "x-forwarded-for", c.IP())
         ctx, cancel := context.WithTimeout(ctx, time.Second)
         defer cancel()
 
@@ -172,6 +237,144 @@ func main() {
         return c.JSON(fiber.Map{"message": res.ResponseStr})
     })
 
+    app.Post("/requestpasswordreset", func(c *fiber.Ctx) error {
+        // sending the mail can take a while
+        ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+        defer cancel()
+
+        // INPUT
+        req := &proto.StringRequest{RequestStr: c.FormValue("email")}
+        res, err := userClient.RequestPasswordReset(ctx, req)
+        if err != nil {
+            return c.Status(500).SendString("Error calling UserService: " + err.Error())
+        }
+
+        return c.JSON(fiber.Map{"message": res.ResponseStr})
+    })
+
+    app.Post("/confirmpasswordreset", func(c *fiber.Ctx) error {
+        ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+        defer cancel()
+
+        // INPUT
+        req := &proto.PasswordReset{
+            Token:       c.FormValue("token"),
+            NewPassword: c.FormValue("new_password"),
+        }
+        res, err := userClient.ConfirmPasswordReset(ctx, req)
+        if err != nil {
+            return c.Status(500).SendString("Error calling UserService: " + err.Error())
+        }
+
+        return c.JSON(fiber.Map{"message": res.ResponseStr})
+    })
+
+    // GET so the link in the verification email works
+    app.Get("/verifyemail", func(c *fiber.Ctx) error {
+        ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+        defer cancel()
+
+        // INPUT
+        req := &proto.StringRequest{RequestStr: c.Query("token")}
+        res, err := userClient.VerifyEmail(ctx, req)
+        if err != nil {
+            return c.Status(500).SendString("Error calling UserService: " + err.Error())
+        }
+
+        return c.JSON(fiber.Map{"message": res.ResponseStr})
+    })
+
+    app.Post("/resendverification", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        // sending the mail can take a while
+        ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+        defer cancel()
+
+        res, err := userClient.ResendVerification(ctx, &emptypb.Empty{})
+        if err != nil {
+            return c.Status(500).SendString("Error calling UserService: " + err.Error())
+        }
+
+        return c.JSON(fiber.Map{"message": res.ResponseStr})
+    })
+
+    app.Post("/createapikey", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT, scopes comma separated
+        var scopes []string
+        for _, scope := range strings.Split(c.FormValue("scopes"), ",") {
+            if scope = strings.TrimSpace(scope); scope != "" {
+                scopes = append(scopes, scope)
+            }
+        }
+        req := &proto.APIKeyRequest{
+            Name:      c.FormValue("name"),
+            Role:      c.FormValue("role"),
+            Scopes:    scopes,
+            ExpiresAt: c.FormValue("expires_at"),
+        }
+        res, err := userClient.CreateAPIKey(ctx, req)
+        if err != nil {
+            return c.Status(500).SendString("Error calling UserService: " + err.Error())
+        }
+
+        return c.JSON(fiber.Map{"api_key": res.ApiKey, "key": res.Key})
+    })
+
+    app.Get("/listapikeys", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        res, err := userClient.ListAPIKeys(ctx, &emptypb.Empty{})
+        if err != nil {
+            return c.Status(500).SendString("Error calling UserService: " + err.Error())
+        }
+
+        return c.JSON(fiber.Map{"keys": res.Keys})
+    })
+
+    app.Post("/revokeapikey", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        keyID, err := strconv.Atoi(c.FormValue("key_id"))
+        if err != nil {
+            return c.Status(400).SendString("Invalid key_id")
+        }
+        res, err := userClient.RevokeAPIKey(ctx, &proto.IntRequest{RequestInt: int32(keyID)})
+        if err != nil {
+            return c.Status(500).SendString("Error calling UserService: " + err.Error())
+        }
+
+        return c.JSON(fiber.Map{"message": res.ResponseStr})
+    })
+
+    app.Post("/unlockaccount", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        req := &proto.StringRequest{RequestStr: c.FormValue("username")}
+        res, err := userClient.UnlockAccount(ctx, req)
+        if err != nil {
+            return c.Status(500).SendString("Error calling UserService: " + err.Error())
+        }
+
+        return c.JSON(fiber.Map{"message": res.ResponseStr})
+    })
+
     app.Get("/getuser/:id", func(c *fiber.Ctx) error {
         bearerToken := c.Get("Authorization")
         ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
@@ -644,22 +847,13 @@ func main() {
         if err != nil {
             return c.Status(500).SendString("failed to convert author_id to int")
         }
-        totalStockInt, err := strconv.Atoi(c.FormValue("total_stock"))
-        if err != nil {
-            return c.Status(500).SendString("failed to convert total_stock to int")
-        }
-        availableStockInt, err := strconv.Atoi(c.FormValue("available_stock"))
-        if err != nil {
-            return c.Status(500).SendString("failed to convert available_stock to int")
-        }
+        // stock follows the items added with /createitem
         req := &proto.Book{
         		Title:          c.FormValue("title"),
         		CategoryId:     int32(categoryIDInt),
         		AuthorId:       int32(authorIDInt),
         		PublishedDate:  c.FormValue("published_date"),
         		Isbn:           c.FormValue("isbn"),
-        		TotalStock:     int32(totalStockInt),
-        		AvailableStock: int32(availableStockInt),
         }
 
         res, err := bookClient.CreateBook(ctx, req)
@@ -789,14 +983,7 @@ func main() {
         if err != nil {
             return c.Status(500).SendString("failed to convert author_id to int")
         }
-        totalStockInt, err := strconv.Atoi(c.FormValue("new_total_stock"))
-        if err != nil {
-            return c.Status(500).SendString("failed to convert total_stock to int")
-        }
-        availableStockInt, err := strconv.Atoi(c.FormValue("new_available_stock"))
-        if err != nil {
-            return c.Status(500).SendString("failed to convert available_stock to int")
-        }
+        // stock follows the items, it cannot be edited here
         req := &proto.UpdateBook{
         	BookId:            int32(bookIDInt),
         	NewTitle:          c.FormValue("new_title"),
@@ -804,8 +991,6 @@ func main() {
         	NewAuthorId:       int32(authorIDInt),
         	NewPublishedDate:  c.FormValue("new_published_date"),
         	NewIsbn:           c.FormValue("new_isbn"),
-        	NewTotalStock:     int32(totalStockInt),
-        	NewAvailableStock: int32(availableStockInt),
         }
 
         res, err := bookClient.EditBook(ctx, req)
@@ -875,21 +1060,22 @@ func main() {
         defer cancel()
 
         // INPUT
-        bookIDInt, err := strconv.Atoi(c.FormValue("book_id"))
-        if err != nil {
-            return c.Status(500).SendString("failed to convert book_id to int")
-        }
         userIDInt, err := strconv.Atoi(c.FormValue("user_id"))
         if err != nil {
             return c.Status(500).SendString("failed to convert user_id to int")
         }
+        // optional, the branch the borrow happens at
+        branchIDInt := 0
+        if c.FormValue("branch_id") != "" {
+            branchIDInt, err = strconv.Atoi(c.FormValue("branch_id"))
+            if err != nil {
+                return c.Status(500).SendString("failed to convert branch_id to int")
+            }
+        }
         req := &proto.Borrow{
-        	BookId:       int32(bookIDInt),
         	UserId:       int32(userIDInt),
-        	BorrowedDate: c.FormValue("borrowed_date"),
-        	ReturnDate:   c.FormValue("return_date"),
-        	ReturnedDate: c.FormValue("returned_date"),
-        	Returned:     c.FormValue("returned"),
+        	Barcode:      c.FormValue("barcode"),
+        	BranchId:     int32(branchIDInt),
         }
 
         res, err := bookClient.CreateBorrow(ctx, req)
@@ -912,8 +1098,18 @@ func main() {
             return c.Status(500).SendString("failed to convert borrow_id to int")
         }
 
-        req := &proto.IntRequest{
-        	RequestInt: int32(borrowIDInt),
+        // optional, the branch the copy is returned to
+        branchIDInt := 0
+        if c.FormValue("branch_id") != "" {
+            branchIDInt, err = strconv.Atoi(c.FormValue("branch_id"))
+            if err != nil {
+                return c.Status(500).SendString("failed to convert branch_id to int")
+            }
+        }
+
+        req := &proto.Return{
+        	BorrowingId: int32(borrowIDInt),
+        	BranchId:    int32(branchIDInt),
         }
 
         res, err := bookClient.CreateReturn(ctx, req)
@@ -1092,18 +1288,19 @@ func main() {
         defer cancel()
 
         // INPUT
-        // validate start and end dates
-        _, err := time.Parse("2006-01-02", c.FormValue("start_date"))
-        if err != nil {
-            return c.Status(500).SendString("failed to parse start_date")
-        }
-        _, err = time.Parse("2006-01-02", c.FormValue("end_date"))
-        if err != nil {
-            return c.Status(500).SendString("failed to parse end_date")
-        }
-        req := &proto.DateLimits{
+        // validate the optional dates
+        for _, field := range []string{"start_date", "end_date", "as_of"} {
+            if c.FormValue(field) == "" {
+                continue
+            }
+            if _, err := time.Parse("2006-01-02", c.FormValue(field)); err != nil {
+                return c.Status(500).SendString("failed to parse " + field)
+            }
+        }
+        req := &proto.OverdueLimits{
         	StartDate: c.FormValue("start_date"),
         	EndDate:   c.FormValue("end_date"),
+        	AsOf:      c.FormValue("as_of"),
         }
 
         res, err := bookClient.GetOverdues(ctx, req)
@@ -1174,6 +1371,26 @@ func main() {
         return c.JSON(res)
     })
 
+    app.Post("/renewborrow", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        borrowingIDInt, err := strconv.Atoi(c.FormValue("borrowing_id"))
+        if err != nil {
+            return c.Status(500).SendString("failed to convert borrowing_id to int")
+        }
+
+        res, err := bookClient.RenewBorrow(ctx, &proto.IntRequest{RequestInt: int32(borrowingIDInt)})
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
     app.Post("/getbookrecommendations", func(c *fiber.Ctx) error {
         bearerToken := c.Get("Authorization")
         ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
@@ -1202,6 +1419,564 @@ func main() {
         return c.JSON(res)
     })
 
+    // ITEMS REST INTERFACE
+
+    app.Post("/createitem", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        bookIDInt, err := strconv.Atoi(c.FormValue("book_id"))
+        if err != nil {
+            return c.Status(500).SendString("failed to convert book_id to int")
+        }
+        branchIDInt, err := strconv.Atoi(c.FormValue("branch_id"))
+        if err != nil {
+            return c.Status(500).SendString("failed to convert branch_id to int")
+        }
+        req := &proto.Item{
+            BookId:        int32(bookIDInt),
+            BranchId:      int32(branchIDInt),
+            Barcode:       c.FormValue("barcode"),
+            Condition:     c.FormValue("condition"),
+            ShelfLocation: c.FormValue("shelf_location"),
+        }
+
+        res, err := bookClient.CreateItem(ctx, req)
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    app.Get("/listitems", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT, all optional
+        req := &proto.ItemQuery{
+            BookId:   int32(c.QueryInt("book_id")),
+            Status:   c.Query("status"),
+            BranchId: int32(c.QueryInt("branch_id")),
+        }
+
+        res, err := bookClient.ListItems(ctx, req)
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    app.Post("/markitemlost", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        res, err := bookClient.MarkItemLost(ctx, &proto.StringRequest{RequestStr: c.FormValue("barcode")})
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    app.Post("/markitemdamaged", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        res, err := bookClient.MarkItemDamaged(ctx, &proto.StringRequest{RequestStr: c.FormValue("barcode")})
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    // BRANCHES REST INTERFACE
+
+    app.Post("/createbranch", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        req := &proto.Branch{
+            Name:    c.FormValue("name"),
+            Address: c.FormValue("address"),
+        }
+
+        res, err := bookClient.CreateBranch(ctx, req)
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    app.Get("/getbranches", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        res, err := bookClient.GetBranches(ctx, &emptypb.Empty{})
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    app.Post("/requesttransfer", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        toBranchIDInt, err := strconv.Atoi(c.FormValue("to_branch_id"))
+        if err != nil {
+            return c.Status(500).SendString("failed to convert to_branch_id to int")
+        }
+        req := &proto.TransferRequest{
+            Barcode:    c.FormValue("barcode"),
+            ToBranchId: int32(toBranchIDInt),
+        }
+
+        res, err := bookClient.RequestTransfer(ctx, req)
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    app.Post("/shiptransfer", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        transferIDInt, err := strconv.Atoi(c.FormValue("transfer_id"))
+        if err != nil {
+            return c.Status(500).SendString("failed to convert transfer_id to int")
+        }
+
+        res, err := bookClient.ShipTransfer(ctx, &proto.IntRequest{RequestInt: int32(transferIDInt)})
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    app.Post("/receivetransfer", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        transferIDInt, err := strconv.Atoi(c.FormValue("transfer_id"))
+        if err != nil {
+            return c.Status(500).SendString("failed to convert transfer_id to int")
+        }
+
+        res, err := bookClient.ReceiveTransfer(ctx, &proto.IntRequest{RequestInt: int32(transferIDInt)})
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    app.Get("/listtransfers", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT, both optional
+        req := &proto.TransferQuery{
+            BranchId: int32(c.QueryInt("branch_id")),
+            Status:   c.Query("status"),
+        }
+
+        res, err := bookClient.ListTransfers(ctx, req)
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    // CALENDAR REST INTERFACE
+
+    app.Get("/getcalendar/:id", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT, 0 for the defaults of every branch
+        idInt, err := strconv.Atoi(c.Params("id"))
+        if err != nil {
+            return c.Status(500).SendString("failed to convert id to int")
+        }
+
+        res, err := bookClient.GetCalendar(ctx, &proto.IntRequest{RequestInt: int32(idInt)})
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    app.Post("/setopeninghours", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        weekdayInt, err := strconv.Atoi(c.FormValue("weekday"))
+        if err != nil {
+            return c.Status(500).SendString("failed to convert weekday to int")
+        }
+        // 0 for every branch
+        branchIDInt := 0
+        if c.FormValue("branch_id") != "" {
+            branchIDInt, err = strconv.Atoi(c.FormValue("branch_id"))
+            if err != nil {
+                return c.Status(500).SendString("failed to convert branch_id to int")
+            }
+        }
+        closed := false
+        if c.FormValue("closed") != "" {
+            closed, err = strconv.ParseBool(c.FormValue("closed"))
+            if err != nil {
+                return c.Status(500).SendString("failed to convert closed to bool")
+            }
+        }
+        req := &proto.OpeningHours{
+            BranchId: int32(branchIDInt),
+            Weekday:  int32(weekdayInt),
+            OpensAt:  c.FormValue("opens_at"),
+            ClosesAt: c.FormValue("closes_at"),
+            Closed:   closed,
+        }
+
+        res, err := bookClient.SetOpeningHours(ctx, req)
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    app.Post("/addholiday", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        var err error
+        // 0 for every branch
+        branchIDInt := 0
+        if c.FormValue("branch_id") != "" {
+            branchIDInt, err = strconv.Atoi(c.FormValue("branch_id"))
+            if err != nil {
+                return c.Status(500).SendString("failed to convert branch_id to int")
+            }
+        }
+        req := &proto.Holiday{
+            BranchId: int32(branchIDInt),
+            Date:     c.FormValue("date"),
+            Name:     c.FormValue("name"),
+        }
+
+        res, err := bookClient.AddHoliday(ctx, req)
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    app.Post("/deleteholiday", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        holidayIDInt, err := strconv.Atoi(c.FormValue("holiday_id"))
+        if err != nil {
+            return c.Status(500).SendString("failed to convert holiday_id to int")
+        }
+
+        res, err := bookClient.DeleteHoliday(ctx, &proto.IntRequest{RequestInt: int32(holidayIDInt)})
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    // CIRCULATION POLICIES REST INTERFACE
+
+    app.Get("/getcirculationpolicies", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        res, err := bookClient.GetCirculationPolicies(ctx, &emptypb.Empty{})
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    app.Post("/setcirculationpolicy", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        values := map[string]int{}
+        for _, field := range []string{"category_id", "max_loans", "loan_days", "renewals", "max_holds"} {
+            value, err := strconv.Atoi(c.FormValue(field, "0"))
+            if err != nil {
+                return c.Status(500).SendString("failed to convert " + field + " to int")
+            }
+            values[field] = value
+        }
+        req := &proto.CirculationPolicy{
+            Role:       c.FormValue("role", "*"),
+            CategoryId: int32(values["category_id"]),
+            MaxLoans:   int32(values["max_loans"]),
+            LoanDays:   int32(values["loan_days"]),
+            Renewals:   int32(values["renewals"]),
+            MaxHolds:   int32(values["max_holds"]),
+        }
+
+        res, err := bookClient.SetCirculationPolicy(ctx, req)
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    app.Post("/deletecirculationpolicy", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        policyIDInt, err := strconv.Atoi(c.FormValue("policy_id"))
+        if err != nil {
+            return c.Status(500).SendString("failed to convert policy_id to int")
+        }
+
+        res, err := bookClient.DeleteCirculationPolicy(ctx, &proto.IntRequest{RequestInt: int32(policyIDInt)})
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(fiber.Map{"message": res.ResponseStr})
+    })
+
+    // FINES REST INTERFACE
+
+    app.Get("/getuserfines/:id", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        idInt, err := strconv.Atoi(c.Params("id"))
+        if err != nil {
+            return c.Status(500).SendString("failed to convert id to int")
+        }
+
+        res, err := bookClient.GetUserFines(ctx, &proto.IntRequest{RequestInt: int32(idInt)})
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    app.Post("/payfine", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        fineIDInt, err := strconv.Atoi(c.FormValue("fine_id"))
+        if err != nil {
+            return c.Status(500).SendString("failed to convert fine_id to int")
+        }
+        amountInt, err := strconv.Atoi(c.FormValue("amount_cents"))
+        if err != nil {
+            return c.Status(500).SendString("failed to convert amount_cents to int")
+        }
+        req := &proto.FinePayment{
+            FineId:      int32(fineIDInt),
+            AmountCents: int32(amountInt),
+        }
+
+        res, err := bookClient.PayFine(ctx, req)
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    app.Post("/waivefine", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        fineIDInt, err := strconv.Atoi(c.FormValue("fine_id"))
+        if err != nil {
+            return c.Status(500).SendString("failed to convert fine_id to int")
+        }
+        req := &proto.FineWaiver{
+            FineId: int32(fineIDInt),
+            Reason: c.FormValue("reason"),
+        }
+
+        res, err := bookClient.WaiveFine(ctx, req)
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    // NOTIFICATIONS REST INTERFACE
+
+    app.Get("/getnotificationhistory/:id", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        idInt, err := strconv.Atoi(c.Params("id"))
+        if err != nil {
+            return c.Status(500).SendString("failed to convert id to int")
+        }
+
+        res, err := bookClient.GetNotificationHistory(ctx, &proto.IntRequest{RequestInt: int32(idInt)})
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    // HOLDS REST INTERFACE
+
+    app.Post("/placehold", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        bookIDInt, err := strconv.Atoi(c.FormValue("book_id"))
+        if err != nil {
+            return c.Status(500).SendString("failed to convert book_id to int")
+        }
+        userIDInt, err := strconv.Atoi(c.FormValue("user_id"))
+        if err != nil {
+            return c.Status(500).SendString("failed to convert user_id to int")
+        }
+        // optional, where the patron picks the copy up
+        pickupBranchIDInt := 0
+        if c.FormValue("pickup_branch_id") != "" {
+            pickupBranchIDInt, err = strconv.Atoi(c.FormValue("pickup_branch_id"))
+            if err != nil {
+                return c.Status(500).SendString("failed to convert pickup_branch_id to int")
+            }
+        }
+        req := &proto.HoldRequest{
+            BookId:         int32(bookIDInt),
+            UserId:         int32(userIDInt),
+            PickupBranchId: int32(pickupBranchIDInt),
+        }
+
+        res, err := bookClient.PlaceHold(ctx, req)
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
+
+    app.Post("/cancelhold", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT
+        holdIDInt, err := strconv.Atoi(c.FormValue("hold_id"))
+        if err != nil {
+            return c.Status(500).SendString("failed to convert hold_id to int")
+        }
+
+        res, err := bookClient.CancelHold(ctx, &proto.IntRequest{RequestInt: int32(holdIDInt)})
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(fiber.Map{"message": res.ResponseStr})
+    })
+
+    app.Get("/listholds", func(c *fiber.Ctx) error {
+        bearerToken := c.Get("Authorization")
+        ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", bearerToken)
+        ctx, cancel := context.WithTimeout(ctx, time.Second)
+        defer cancel()
+
+        // INPUT, both optional
+        req := &proto.HoldRequest{
+            BookId: int32(c.QueryInt("book_id")),
+            UserId: int32(c.QueryInt("user_id")),
+        }
+
+        res, err := bookClient.ListHolds(ctx, req)
+        if err != nil {
+            return c.Status(500).SendString("Error calling BookService: " + err.Error())
+        }
+
+        return c.JSON(res)
+    })
 
     // fiber rest
     log.Fatal(app.Listen("0.0.0.0:3000"))
@@ -21,6 +21,152 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type JWK struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kty string `protobuf:"bytes,1,opt,name=kty,proto3" json:"kty,omitempty"` // "RSA" or "OKP"
+	Kid string `protobuf:"bytes,2,opt,name=kid,proto3" json:"kid,omitempty"`
+	Alg string `protobuf:"bytes,3,opt,name=alg,proto3" json:"alg,omitempty"` // "RS256" or "EdDSA"
+	Use string `protobuf:"bytes,4,opt,name=use,proto3" json:"use,omitempty"` // "sig"
+	N   string `protobuf:"bytes,5,opt,name=n,proto3" json:"n,omitempty"`     // RSA only
+	E   string `protobuf:"bytes,6,opt,name=e,proto3" json:"e,omitempty"`     // RSA only
+	Crv string `protobuf:"bytes,7,opt,name=crv,proto3" json:"crv,omitempty"` // OKP only
+	X   string `protobuf:"bytes,8,opt,name=x,proto3" json:"x,omitempty"`     // OKP only
+}
+
+func (x *JWK) Reset() {
+	*x = JWK{}
+	mi := &file_proto_protos_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JWK) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWK) ProtoMessage() {}
+
+func (x *JWK) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWK.ProtoReflect.Descriptor instead.
+func (*JWK) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *JWK) GetKty() string {
+	if x != nil {
+		return x.Kty
+	}
+	return ""
+}
+
+func (x *JWK) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *JWK) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *JWK) GetUse() string {
+	if x != nil {
+		return x.Use
+	}
+	return ""
+}
+
+func (x *JWK) GetN() string {
+	if x != nil {
+		return x.N
+	}
+	return ""
+}
+
+func (x *JWK) GetE() string {
+	if x != nil {
+		return x.E
+	}
+	return ""
+}
+
+func (x *JWK) GetCrv() string {
+	if x != nil {
+		return x.Crv
+	}
+	return ""
+}
+
+func (x *JWK) GetX() string {
+	if x != nil {
+		return x.X
+	}
+	return ""
+}
+
+type JWKS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []*JWK `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *JWKS) Reset() {
+	*x = JWKS{}
+	mi := &file_proto_protos_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JWKS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWKS) ProtoMessage() {}
+
+func (x *JWKS) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWKS.ProtoReflect.Descriptor instead.
+func (*JWKS) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *JWKS) GetKeys() []*JWK {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
 type StringRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -31,7 +177,7 @@ type StringRequest struct {
 
 func (x *StringRequest) Reset() {
 	*x = StringRequest{}
-	mi := &file_proto_protos_proto_msgTypes[0]
+	mi := &file_proto_protos_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -43,7 +189,7 @@ func (x *StringRequest) String() string {
 func (*StringRequest) ProtoMessage() {}
 
 func (x *StringRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[0]
+	mi := &file_proto_protos_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -56,7 +202,7 @@ func (x *StringRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringRequest.ProtoReflect.Descriptor instead.
 func (*StringRequest) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{0}
+	return file_proto_protos_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *StringRequest) GetRequestStr() string {
@@ -76,7 +222,7 @@ type StringResponse struct {
 
 func (x *StringResponse) Reset() {
 	*x = StringResponse{}
-	mi := &file_proto_protos_proto_msgTypes[1]
+	mi := &file_proto_protos_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -88,7 +234,7 @@ func (x *StringResponse) String() string {
 func (*StringResponse) ProtoMessage() {}
 
 func (x *StringResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[1]
+	mi := &file_proto_protos_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -101,7 +247,7 @@ func (x *StringResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringResponse.ProtoReflect.Descriptor instead.
 func (*StringResponse) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{1}
+	return file_proto_protos_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *StringResponse) GetResponseStr() string {
@@ -121,7 +267,7 @@ type IntRequest struct {
 
 func (x *IntRequest) Reset() {
 	*x = IntRequest{}
-	mi := &file_proto_protos_proto_msgTypes[2]
+	mi := &file_proto_protos_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -133,7 +279,7 @@ func (x *IntRequest) String() string {
 func (*IntRequest) ProtoMessage() {}
 
 func (x *IntRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[2]
+	mi := &file_proto_protos_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -146,7 +292,7 @@ func (x *IntRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntRequest.ProtoReflect.Descriptor instead.
 func (*IntRequest) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{2}
+	return file_proto_protos_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *IntRequest) GetRequestInt() int32 {
@@ -166,7 +312,7 @@ type IntResponse struct {
 
 func (x *IntResponse) Reset() {
 	*x = IntResponse{}
-	mi := &file_proto_protos_proto_msgTypes[3]
+	mi := &file_proto_protos_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -178,7 +324,7 @@ func (x *IntResponse) String() string {
 func (*IntResponse) ProtoMessage() {}
 
 func (x *IntResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[3]
+	mi := &file_proto_protos_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -191,7 +337,7 @@ func (x *IntResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntResponse.ProtoReflect.Descriptor instead.
 func (*IntResponse) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{3}
+	return file_proto_protos_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *IntResponse) GetResponseInt() int32 {
@@ -211,7 +357,7 @@ type BoolResponse struct {
 
 func (x *BoolResponse) Reset() {
 	*x = BoolResponse{}
-	mi := &file_proto_protos_proto_msgTypes[4]
+	mi := &file_proto_protos_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -223,7 +369,7 @@ func (x *BoolResponse) String() string {
 func (*BoolResponse) ProtoMessage() {}
 
 func (x *BoolResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[4]
+	mi := &file_proto_protos_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -236,7 +382,7 @@ func (x *BoolResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoolResponse.ProtoReflect.Descriptor instead.
 func (*BoolResponse) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{4}
+	return file_proto_protos_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *BoolResponse) GetResponseBool() bool {
@@ -246,36 +392,32 @@ func (x *BoolResponse) GetResponseBool() bool {
 	return false
 }
 
-type UserSensitive struct {
+type TokenPair struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username  string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password  string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	FirstName string `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName  string `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Email     string `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
-	Role      string `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`
-	CreatedAt string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt string `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	AccessToken  string `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	TokenType    string `protobuf:"bytes,3,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`  // "Bearer"
+	ExpiresIn    int64  `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // access token lifetime, seconds
 }
 
-func (x *UserSensitive) Reset() {
-	*x = UserSensitive{}
-	mi := &file_proto_protos_proto_msgTypes[5]
+func (x *TokenPair) Reset() {
+	*x = TokenPair{}
+	mi := &file_proto_protos_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserSensitive) String() string {
+func (x *TokenPair) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserSensitive) ProtoMessage() {}
+func (*TokenPair) ProtoMessage() {}
 
-func (x *UserSensitive) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[5]
+func (x *TokenPair) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -286,95 +428,140 @@ func (x *UserSensitive) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserSensitive.ProtoReflect.Descriptor instead.
-func (*UserSensitive) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use TokenPair.ProtoReflect.Descriptor instead.
+func (*TokenPair) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *UserSensitive) GetUsername() string {
+func (x *TokenPair) GetAccessToken() string {
 	if x != nil {
-		return x.Username
+		return x.AccessToken
 	}
 	return ""
 }
 
-func (x *UserSensitive) GetPassword() string {
+func (x *TokenPair) GetRefreshToken() string {
 	if x != nil {
-		return x.Password
+		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *UserSensitive) GetFirstName() string {
+func (x *TokenPair) GetTokenType() string {
 	if x != nil {
-		return x.FirstName
+		return x.TokenType
 	}
 	return ""
 }
 
-func (x *UserSensitive) GetLastName() string {
+func (x *TokenPair) GetExpiresIn() int64 {
 	if x != nil {
-		return x.LastName
+		return x.ExpiresIn
 	}
-	return ""
+	return 0
 }
 
-func (x *UserSensitive) GetEmail() string {
+type APIKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role      string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`                            // at most the caller's own role
+	Scopes    []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`                        // "*", "<Service>" or "<Service>/<Method>", e.g. "BookAndBorrowService/GetBooks"
+	ExpiresAt string   `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // optional, YYYY-MM-DD
+}
+
+func (x *APIKeyRequest) Reset() {
+	*x = APIKeyRequest{}
+	mi := &file_proto_protos_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKeyRequest) ProtoMessage() {}
+
+func (x *APIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[8]
 	if x != nil {
-		return x.Email
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKeyRequest.ProtoReflect.Descriptor instead.
+func (*APIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *APIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UserSensitive) GetRole() string {
+func (x *APIKeyRequest) GetRole() string {
 	if x != nil {
 		return x.Role
 	}
 	return ""
 }
 
-func (x *UserSensitive) GetCreatedAt() string {
+func (x *APIKeyRequest) GetScopes() []string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Scopes
 	}
-	return ""
+	return nil
 }
 
-func (x *UserSensitive) GetUpdatedAt() string {
+func (x *APIKeyRequest) GetExpiresAt() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ExpiresAt
 	}
 	return ""
 }
 
-type User struct {
+type APIKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    int32  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Username  string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	FirstName string `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName  string `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Email     string `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
-	Role      string `protobuf:"bytes,7,opt,name=role,proto3" json:"role,omitempty"`
+	KeyId      int32    `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	UserId     int32    `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name       string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix     string   `protobuf:"bytes,4,opt,name=prefix,proto3" json:"prefix,omitempty"` // first characters of the key, to tell keys apart
+	Role       string   `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	Scopes     []string `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreatedAt  string   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt string   `protobuf:"bytes,8,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	ExpiresAt  string   `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RevokedAt  string   `protobuf:"bytes,10,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
 }
 
-func (x *User) Reset() {
-	*x = User{}
-	mi := &file_proto_protos_proto_msgTypes[6]
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	mi := &file_proto_protos_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *User) String() string {
+func (x *APIKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*APIKey) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[6]
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -385,130 +572,2375 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *User) GetUserId() int32 {
+func (x *APIKey) GetKeyId() int32 {
+	if x != nil {
+		return x.KeyId
+	}
+	return 0
+}
+
+func (x *APIKey) GetUserId() int32 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *User) GetUsername() string {
+func (x *APIKey) GetName() string {
 	if x != nil {
-		return x.Username
+		return x.Name
 	}
 	return ""
 }
 
-func (x *User) GetFirstName() string {
+func (x *APIKey) GetPrefix() string {
 	if x != nil {
-		return x.FirstName
+		return x.Prefix
 	}
 	return ""
 }
 
-func (x *User) GetLastName() string {
+func (x *APIKey) GetRole() string {
 	if x != nil {
-		return x.LastName
+		return x.Role
 	}
 	return ""
 }
 
-func (x *User) GetEmail() string {
+func (x *APIKey) GetScopes() []string {
 	if x != nil {
-		return x.Email
+		return x.Scopes
 	}
-	return ""
+	return nil
 }
 
-func (x *User) GetRole() string {
+func (x *APIKey) GetCreatedAt() string {
 	if x != nil {
-		return x.Role
+		return x.CreatedAt
 	}
 	return ""
 }
 
-type UserPassword struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+func (x *APIKey) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
 }
 
-func (x *UserPassword) Reset() {
+func (x *APIKey) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *APIKey) GetRevokedAt() string {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return ""
+}
+
+type APIKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []*APIKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *APIKeys) Reset() {
+	*x = APIKeys{}
+	mi := &file_proto_protos_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKeys) ProtoMessage() {}
+
+func (x *APIKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKeys.ProtoReflect.Descriptor instead.
+func (*APIKeys) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *APIKeys) GetKeys() []*APIKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type APIKeyCreated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    *APIKey `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	ApiKey string  `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"` // send as "Authorization: ApiKey <api_key>"
+}
+
+func (x *APIKeyCreated) Reset() {
+	*x = APIKeyCreated{}
+	mi := &file_proto_protos_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKeyCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKeyCreated) ProtoMessage() {}
+
+func (x *APIKeyCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKeyCreated.ProtoReflect.Descriptor instead.
+func (*APIKeyCreated) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *APIKeyCreated) GetKey() *APIKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *APIKeyCreated) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+type UserSensitive struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username  string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password  string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	FirstName string `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email     string `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Role      string `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`
+	CreatedAt string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *UserSensitive) Reset() {
+	*x = UserSensitive{}
+	mi := &file_proto_protos_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSensitive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSensitive) ProtoMessage() {}
+
+func (x *UserSensitive) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSensitive.ProtoReflect.Descriptor instead.
+func (*UserSensitive) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UserSensitive) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserSensitive) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *UserSensitive) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UserSensitive) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *UserSensitive) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserSensitive) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *UserSensitive) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserSensitive) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int32  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username  string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	FirstName string `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Email     string `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	Role      string `protobuf:"bytes,7,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_proto_protos_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *User) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *User) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UserPassword struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *UserPassword) Reset() {
 	*x = UserPassword{}
-	mi := &file_proto_protos_proto_msgTypes[7]
+	mi := &file_proto_protos_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPassword) ProtoMessage() {}
+
+func (x *UserPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPassword.ProtoReflect.Descriptor instead.
+func (*UserPassword) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UserPassword) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserPassword) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type UserIDPassword struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   int32  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *UserIDPassword) Reset() {
+	*x = UserIDPassword{}
+	mi := &file_proto_protos_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserIDPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserIDPassword) ProtoMessage() {}
+
+func (x *UserIDPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserIDPassword.ProtoReflect.Descriptor instead.
+func (*UserIDPassword) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UserIDPassword) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserIDPassword) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type NewPassword struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username    string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password    string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	NewPassword string `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+}
+
+func (x *NewPassword) Reset() {
+	*x = NewPassword{}
+	mi := &file_proto_protos_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewPassword) ProtoMessage() {}
+
+func (x *NewPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewPassword.ProtoReflect.Descriptor instead.
+func (*NewPassword) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *NewPassword) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *NewPassword) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *NewPassword) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type PasswordReset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token       string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+}
+
+func (x *PasswordReset) Reset() {
+	*x = PasswordReset{}
+	mi := &file_proto_protos_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordReset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordReset) ProtoMessage() {}
+
+func (x *PasswordReset) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordReset.ProtoReflect.Descriptor instead.
+func (*PasswordReset) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PasswordReset) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *PasswordReset) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type Category struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt   string `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   string `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_proto_protos_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Category) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Category) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CategoryMin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CategoryId int32  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CategoryMin) Reset() {
+	*x = CategoryMin{}
+	mi := &file_proto_protos_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryMin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryMin) ProtoMessage() {}
+
+func (x *CategoryMin) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryMin.ProtoReflect.Descriptor instead.
+func (*CategoryMin) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CategoryMin) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryMin) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CategoryMins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Categories []*CategoryMin `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+}
+
+func (x *CategoryMins) Reset() {
+	*x = CategoryMins{}
+	mi := &file_proto_protos_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryMins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryMins) ProtoMessage() {}
+
+func (x *CategoryMins) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryMins.ProtoReflect.Descriptor instead.
+func (*CategoryMins) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CategoryMins) GetCategories() []*CategoryMin {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type UpdateCategory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CategoryId     int32  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	NewName        string `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	NewDescription string `protobuf:"bytes,3,opt,name=new_description,json=newDescription,proto3" json:"new_description,omitempty"`
+	UpdatedAt      string `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *UpdateCategory) Reset() {
+	*x = UpdateCategory{}
+	mi := &file_proto_protos_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategory) ProtoMessage() {}
+
+func (x *UpdateCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategory.ProtoReflect.Descriptor instead.
+func (*UpdateCategory) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateCategory) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateCategory) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+func (x *UpdateCategory) GetNewDescription() string {
+	if x != nil {
+		return x.NewDescription
+	}
+	return ""
+}
+
+func (x *UpdateCategory) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type DateLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // format: 1997-06-26
+	EndDate   string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // format: 1997-06-26
+}
+
+func (x *DateLimits) Reset() {
+	*x = DateLimits{}
+	mi := &file_proto_protos_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateLimits) ProtoMessage() {}
+
+func (x *DateLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateLimits.ProtoReflect.Descriptor instead.
+func (*DateLimits) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DateLimits) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *DateLimits) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type OverdueLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // due date window, optional, format: 1997-06-26
+	EndDate   string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // optional, format: 1997-06-26
+	AsOf      string `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`                // optional, defaults to now, format: 1997-06-26
+}
+
+func (x *OverdueLimits) Reset() {
+	*x = OverdueLimits{}
+	mi := &file_proto_protos_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverdueLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverdueLimits) ProtoMessage() {}
+
+func (x *OverdueLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverdueLimits.ProtoReflect.Descriptor instead.
+func (*OverdueLimits) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *OverdueLimits) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *OverdueLimits) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *OverdueLimits) GetAsOf() string {
+	if x != nil {
+		return x.AsOf
+	}
+	return ""
+}
+
+type IDLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min int32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max int32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *IDLimits) Reset() {
+	*x = IDLimits{}
+	mi := &file_proto_protos_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IDLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IDLimits) ProtoMessage() {}
+
+func (x *IDLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IDLimits.ProtoReflect.Descriptor instead.
+func (*IDLimits) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *IDLimits) GetMin() int32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *IDLimits) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type Author struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Birthdate   string `protobuf:"bytes,2,opt,name=birthdate,proto3" json:"birthdate,omitempty"` // format: 1997-06-26
+	Nationality string `protobuf:"bytes,3,opt,name=nationality,proto3" json:"nationality,omitempty"`
+	Biography   string `protobuf:"bytes,4,opt,name=biography,proto3" json:"biography,omitempty"`
+	CreatedAt   string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   string `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Author) Reset() {
+	*x = Author{}
+	mi := &file_proto_protos_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Author) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Author) ProtoMessage() {}
+
+func (x *Author) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Author.ProtoReflect.Descriptor instead.
+func (*Author) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Author) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Author) GetBirthdate() string {
+	if x != nil {
+		return x.Birthdate
+	}
+	return ""
+}
+
+func (x *Author) GetNationality() string {
+	if x != nil {
+		return x.Nationality
+	}
+	return ""
+}
+
+func (x *Author) GetBiography() string {
+	if x != nil {
+		return x.Biography
+	}
+	return ""
+}
+
+func (x *Author) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Author) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type AuthorMin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorId int32  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *AuthorMin) Reset() {
+	*x = AuthorMin{}
+	mi := &file_proto_protos_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorMin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorMin) ProtoMessage() {}
+
+func (x *AuthorMin) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorMin.ProtoReflect.Descriptor instead.
+func (*AuthorMin) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AuthorMin) GetAuthorId() int32 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *AuthorMin) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AuthorMins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authors []*AuthorMin `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
+}
+
+func (x *AuthorMins) Reset() {
+	*x = AuthorMins{}
+	mi := &file_proto_protos_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorMins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorMins) ProtoMessage() {}
+
+func (x *AuthorMins) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorMins.ProtoReflect.Descriptor instead.
+func (*AuthorMins) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AuthorMins) GetAuthors() []*AuthorMin {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+type UpdateAuthor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorId       int32  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	NewName        string `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	NewBirthdate   string `protobuf:"bytes,3,opt,name=new_birthdate,json=newBirthdate,proto3" json:"new_birthdate,omitempty"` // format: 1997-06-26
+	NewNationality string `protobuf:"bytes,4,opt,name=new_nationality,json=newNationality,proto3" json:"new_nationality,omitempty"`
+	NewBiography   string `protobuf:"bytes,5,opt,name=new_biography,json=newBiography,proto3" json:"new_biography,omitempty"`
+	UpdatedAt      string `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *UpdateAuthor) Reset() {
+	*x = UpdateAuthor{}
+	mi := &file_proto_protos_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAuthor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAuthor) ProtoMessage() {}
+
+func (x *UpdateAuthor) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAuthor.ProtoReflect.Descriptor instead.
+func (*UpdateAuthor) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateAuthor) GetAuthorId() int32 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *UpdateAuthor) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+func (x *UpdateAuthor) GetNewBirthdate() string {
+	if x != nil {
+		return x.NewBirthdate
+	}
+	return ""
+}
+
+func (x *UpdateAuthor) GetNewNationality() string {
+	if x != nil {
+		return x.NewNationality
+	}
+	return ""
+}
+
+func (x *UpdateAuthor) GetNewBiography() string {
+	if x != nil {
+		return x.NewBiography
+	}
+	return ""
+}
+
+func (x *UpdateAuthor) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetRecommendation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CategoryId int32 `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Limit      int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetRecommendation) Reset() {
+	*x = GetRecommendation{}
+	mi := &file_proto_protos_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendation) ProtoMessage() {}
+
+func (x *GetRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendation.ProtoReflect.Descriptor instead.
+func (*GetRecommendation) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetRecommendation) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *GetRecommendation) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Book struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title          string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	CategoryId     int32  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	AuthorId       int32  `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	PublishedDate  string `protobuf:"bytes,4,opt,name=published_date,json=publishedDate,proto3" json:"published_date,omitempty"` // format:
+	Isbn           string `protobuf:"bytes,5,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	TotalStock     int32  `protobuf:"varint,6,opt,name=total_stock,json=totalStock,proto3" json:"total_stock,omitempty"`             // derived from items, ignored by CreateBook
+	AvailableStock int32  `protobuf:"varint,7,opt,name=available_stock,json=availableStock,proto3" json:"available_stock,omitempty"` // derived from items, ignored by CreateBook
+	CreatedAt      string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Book) Reset() {
+	*x = Book{}
+	mi := &file_proto_protos_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Book) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Book) ProtoMessage() {}
+
+func (x *Book) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Book.ProtoReflect.Descriptor instead.
+func (*Book) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Book) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Book) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Book) GetAuthorId() int32 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Book) GetPublishedDate() string {
+	if x != nil {
+		return x.PublishedDate
+	}
+	return ""
+}
+
+func (x *Book) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *Book) GetTotalStock() int32 {
+	if x != nil {
+		return x.TotalStock
+	}
+	return 0
+}
+
+func (x *Book) GetAvailableStock() int32 {
+	if x != nil {
+		return x.AvailableStock
+	}
+	return 0
+}
+
+func (x *Book) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Book) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type BookMin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookId         int32  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Title          string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	CategoryId     int32  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	AuthorId       string `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	PublishedDate  string `protobuf:"bytes,5,opt,name=published_date,json=publishedDate,proto3" json:"published_date,omitempty"` // format: 1997-06-26
+	AvailableStock int32  `protobuf:"varint,6,opt,name=available_stock,json=availableStock,proto3" json:"available_stock,omitempty"`
+}
+
+func (x *BookMin) Reset() {
+	*x = BookMin{}
+	mi := &file_proto_protos_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookMin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookMin) ProtoMessage() {}
+
+func (x *BookMin) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookMin.ProtoReflect.Descriptor instead.
+func (*BookMin) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BookMin) GetBookId() int32 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *BookMin) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BookMin) GetCategoryId() int32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *BookMin) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *BookMin) GetPublishedDate() string {
+	if x != nil {
+		return x.PublishedDate
+	}
+	return ""
+}
+
+func (x *BookMin) GetAvailableStock() int32 {
+	if x != nil {
+		return x.AvailableStock
+	}
+	return 0
+}
+
+type BookMins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Books []*BookMin `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"`
+}
+
+func (x *BookMins) Reset() {
+	*x = BookMins{}
+	mi := &file_proto_protos_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookMins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookMins) ProtoMessage() {}
+
+func (x *BookMins) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookMins.ProtoReflect.Descriptor instead.
+func (*BookMins) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BookMins) GetBooks() []*BookMin {
+	if x != nil {
+		return x.Books
+	}
+	return nil
+}
+
+type UpdateBook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookId            int32  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	NewTitle          string `protobuf:"bytes,2,opt,name=new_title,json=newTitle,proto3" json:"new_title,omitempty"`
+	NewCategoryId     int32  `protobuf:"varint,3,opt,name=new_category_id,json=newCategoryId,proto3" json:"new_category_id,omitempty"`
+	NewAuthorId       int32  `protobuf:"varint,4,opt,name=new_author_id,json=newAuthorId,proto3" json:"new_author_id,omitempty"`
+	NewPublishedDate  string `protobuf:"bytes,5,opt,name=new_published_date,json=newPublishedDate,proto3" json:"new_published_date,omitempty"` // format: 1997-06-26
+	NewIsbn           string `protobuf:"bytes,6,opt,name=new_isbn,json=newIsbn,proto3" json:"new_isbn,omitempty"`
+	NewTotalStock     int32  `protobuf:"varint,7,opt,name=new_total_stock,json=newTotalStock,proto3" json:"new_total_stock,omitempty"`             // ignored, derived from items
+	NewAvailableStock int32  `protobuf:"varint,8,opt,name=new_available_stock,json=newAvailableStock,proto3" json:"new_available_stock,omitempty"` // ignored, derived from items
+	UpdatedAt         string `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *UpdateBook) Reset() {
+	*x = UpdateBook{}
+	mi := &file_proto_protos_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBook) ProtoMessage() {}
+
+func (x *UpdateBook) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBook.ProtoReflect.Descriptor instead.
+func (*UpdateBook) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateBook) GetBookId() int32 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *UpdateBook) GetNewTitle() string {
+	if x != nil {
+		return x.NewTitle
+	}
+	return ""
+}
+
+func (x *UpdateBook) GetNewCategoryId() int32 {
+	if x != nil {
+		return x.NewCategoryId
+	}
+	return 0
+}
+
+func (x *UpdateBook) GetNewAuthorId() int32 {
+	if x != nil {
+		return x.NewAuthorId
+	}
+	return 0
+}
+
+func (x *UpdateBook) GetNewPublishedDate() string {
+	if x != nil {
+		return x.NewPublishedDate
+	}
+	return ""
+}
+
+func (x *UpdateBook) GetNewIsbn() string {
+	if x != nil {
+		return x.NewIsbn
+	}
+	return ""
+}
+
+func (x *UpdateBook) GetNewTotalStock() int32 {
+	if x != nil {
+		return x.NewTotalStock
+	}
+	return 0
+}
+
+func (x *UpdateBook) GetNewAvailableStock() int32 {
+	if x != nil {
+		return x.NewAvailableStock
+	}
+	return 0
+}
+
+func (x *UpdateBook) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type HoldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookId         int32 `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	UserId         int32 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PickupBranchId int32 `protobuf:"varint,3,opt,name=pickup_branch_id,json=pickupBranchId,proto3" json:"pickup_branch_id,omitempty"` // 0 to pick up at whichever branch the copy is
+}
+
+func (x *HoldRequest) Reset() {
+	*x = HoldRequest{}
+	mi := &file_proto_protos_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldRequest) ProtoMessage() {}
+
+func (x *HoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldRequest.ProtoReflect.Descriptor instead.
+func (*HoldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *HoldRequest) GetBookId() int32 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *HoldRequest) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *HoldRequest) GetPickupBranchId() int32 {
+	if x != nil {
+		return x.PickupBranchId
+	}
+	return 0
+}
+
+type Hold struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HoldId          int32  `protobuf:"varint,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	BookId          int32  `protobuf:"varint,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	UserId          int32  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status          string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`      // waiting, in_transit, ready, fulfilled, cancelled, expired
+	Position        int32  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"` // place in the queue while waiting
+	PlacedAt        string `protobuf:"bytes,6,opt,name=placed_at,json=placedAt,proto3" json:"placed_at,omitempty"`
+	ReadyAt         string `protobuf:"bytes,7,opt,name=ready_at,json=readyAt,proto3" json:"ready_at,omitempty"`
+	PickupExpiresAt string `protobuf:"bytes,8,opt,name=pickup_expires_at,json=pickupExpiresAt,proto3" json:"pickup_expires_at,omitempty"` // a ready copy is kept until then
+	Barcode         string `protobuf:"bytes,9,opt,name=barcode,proto3" json:"barcode,omitempty"`                                          // the reserved copy while in transit or ready
+	PickupBranchId  int32  `protobuf:"varint,10,opt,name=pickup_branch_id,json=pickupBranchId,proto3" json:"pickup_branch_id,omitempty"`
+}
+
+func (x *Hold) Reset() {
+	*x = Hold{}
+	mi := &file_proto_protos_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hold) ProtoMessage() {}
+
+func (x *Hold) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hold.ProtoReflect.Descriptor instead.
+func (*Hold) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Hold) GetHoldId() int32 {
+	if x != nil {
+		return x.HoldId
+	}
+	return 0
+}
+
+func (x *Hold) GetBookId() int32 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *Hold) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Hold) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Hold) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *Hold) GetPlacedAt() string {
+	if x != nil {
+		return x.PlacedAt
+	}
+	return ""
+}
+
+func (x *Hold) GetReadyAt() string {
+	if x != nil {
+		return x.ReadyAt
+	}
+	return ""
+}
+
+func (x *Hold) GetPickupExpiresAt() string {
+	if x != nil {
+		return x.PickupExpiresAt
+	}
+	return ""
+}
+
+func (x *Hold) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *Hold) GetPickupBranchId() int32 {
+	if x != nil {
+		return x.PickupBranchId
+	}
+	return 0
+}
+
+type Holds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Holds []*Hold `protobuf:"bytes,1,rep,name=holds,proto3" json:"holds,omitempty"`
+}
+
+func (x *Holds) Reset() {
+	*x = Holds{}
+	mi := &file_proto_protos_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Holds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holds) ProtoMessage() {}
+
+func (x *Holds) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holds.ProtoReflect.Descriptor instead.
+func (*Holds) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Holds) GetHolds() []*Hold {
+	if x != nil {
+		return x.Holds
+	}
+	return nil
+}
+
+type Borrow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookId       int32  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"` // ignored by CreateBorrow, the item's book is borrowed
+	UserId       int32  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BorrowedDate string `protobuf:"bytes,3,opt,name=borrowed_date,json=borrowedDate,proto3" json:"borrowed_date,omitempty"`
+	ReturnDate   string `protobuf:"bytes,4,opt,name=return_date,json=returnDate,proto3" json:"return_date,omitempty"` // ignored by CreateBorrow, the circulation policy sets it
+	ReturnedDate string `protobuf:"bytes,5,opt,name=returned_date,json=returnedDate,proto3" json:"returned_date,omitempty"`
+	Returned     string `protobuf:"bytes,6,opt,name=returned,proto3" json:"returned,omitempty"`                  // t/f
+	Barcode      string `protobuf:"bytes,7,opt,name=barcode,proto3" json:"barcode,omitempty"`                    // the copy being borrowed
+	BranchId     int32  `protobuf:"varint,8,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"` // where the borrow happens, 0 for the copy's branch
+}
+
+func (x *Borrow) Reset() {
+	*x = Borrow{}
+	mi := &file_proto_protos_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Borrow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Borrow) ProtoMessage() {}
+
+func (x *Borrow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Borrow.ProtoReflect.Descriptor instead.
+func (*Borrow) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Borrow) GetBookId() int32 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *Borrow) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Borrow) GetBorrowedDate() string {
+	if x != nil {
+		return x.BorrowedDate
+	}
+	return ""
+}
+
+func (x *Borrow) GetReturnDate() string {
+	if x != nil {
+		return x.ReturnDate
+	}
+	return ""
+}
+
+func (x *Borrow) GetReturnedDate() string {
+	if x != nil {
+		return x.ReturnedDate
+	}
+	return ""
+}
+
+func (x *Borrow) GetReturned() string {
+	if x != nil {
+		return x.Returned
+	}
+	return ""
+}
+
+func (x *Borrow) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *Borrow) GetBranchId() int32 {
+	if x != nil {
+		return x.BranchId
+	}
+	return 0
+}
+
+type Return struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BorrowingId int32 `protobuf:"varint,1,opt,name=borrowing_id,json=borrowingId,proto3" json:"borrowing_id,omitempty"`
+	BranchId    int32 `protobuf:"varint,2,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"` // where the copy is returned, 0 leaves it at its branch
+}
+
+func (x *Return) Reset() {
+	*x = Return{}
+	mi := &file_proto_protos_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Return) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Return) ProtoMessage() {}
+
+func (x *Return) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Return.ProtoReflect.Descriptor instead.
+func (*Return) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Return) GetBorrowingId() int32 {
+	if x != nil {
+		return x.BorrowingId
+	}
+	return 0
+}
+
+func (x *Return) GetBranchId() int32 {
+	if x != nil {
+		return x.BranchId
+	}
+	return 0
+}
+
+type BorrowOrReturnMin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BorrowingId  int32  `protobuf:"varint,1,opt,name=borrowing_id,json=borrowingId,proto3" json:"borrowing_id,omitempty"`
+	BookId       int32  `protobuf:"varint,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	UserId       int32  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BorrowedDate string `protobuf:"bytes,4,opt,name=borrowed_date,json=borrowedDate,proto3" json:"borrowed_date,omitempty"`
+	ReturnDate   string `protobuf:"bytes,5,opt,name=return_date,json=returnDate,proto3" json:"return_date,omitempty"` // due date
+	DaysLate     int32  `protobuf:"varint,6,opt,name=days_late,json=daysLate,proto3" json:"days_late,omitempty"`      // GetOverdues only
+}
+
+func (x *BorrowOrReturnMin) Reset() {
+	*x = BorrowOrReturnMin{}
+	mi := &file_proto_protos_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BorrowOrReturnMin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BorrowOrReturnMin) ProtoMessage() {}
+
+func (x *BorrowOrReturnMin) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BorrowOrReturnMin.ProtoReflect.Descriptor instead.
+func (*BorrowOrReturnMin) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BorrowOrReturnMin) GetBorrowingId() int32 {
+	if x != nil {
+		return x.BorrowingId
+	}
+	return 0
+}
+
+func (x *BorrowOrReturnMin) GetBookId() int32 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *BorrowOrReturnMin) GetUserId() int32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BorrowOrReturnMin) GetBorrowedDate() string {
+	if x != nil {
+		return x.BorrowedDate
+	}
+	return ""
+}
+
+func (x *BorrowOrReturnMin) GetReturnDate() string {
+	if x != nil {
+		return x.ReturnDate
+	}
+	return ""
+}
+
+func (x *BorrowOrReturnMin) GetDaysLate() int32 {
+	if x != nil {
+		return x.DaysLate
+	}
+	return 0
+}
+
+type BorrowOrReturnMins struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message    string               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // "borrowed" or "returned"
+	Borrowings []*BorrowOrReturnMin `protobuf:"bytes,2,rep,name=borrowings,proto3" json:"borrowings,omitempty"`
+}
+
+func (x *BorrowOrReturnMins) Reset() {
+	*x = BorrowOrReturnMins{}
+	mi := &file_proto_protos_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BorrowOrReturnMins) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BorrowOrReturnMins) ProtoMessage() {}
+
+func (x *BorrowOrReturnMins) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BorrowOrReturnMins.ProtoReflect.Descriptor instead.
+func (*BorrowOrReturnMins) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BorrowOrReturnMins) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BorrowOrReturnMins) GetBorrowings() []*BorrowOrReturnMin {
+	if x != nil {
+		return x.Borrowings
+	}
+	return nil
+}
+
+type RenewedBorrow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BorrowingId  int32  `protobuf:"varint,1,opt,name=borrowing_id,json=borrowingId,proto3" json:"borrowing_id,omitempty"`
+	ReturnDate   string `protobuf:"bytes,2,opt,name=return_date,json=returnDate,proto3" json:"return_date,omitempty"` // new due date
+	RenewalCount int32  `protobuf:"varint,3,opt,name=renewal_count,json=renewalCount,proto3" json:"renewal_count,omitempty"`
+	RenewalsLeft int32  `protobuf:"varint,4,opt,name=renewals_left,json=renewalsLeft,proto3" json:"renewals_left,omitempty"`
+}
+
+func (x *RenewedBorrow) Reset() {
+	*x = RenewedBorrow{}
+	mi := &file_proto_protos_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewedBorrow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewedBorrow) ProtoMessage() {}
+
+func (x *RenewedBorrow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewedBorrow.ProtoReflect.Descriptor instead.
+func (*RenewedBorrow) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RenewedBorrow) GetBorrowingId() int32 {
+	if x != nil {
+		return x.BorrowingId
+	}
+	return 0
+}
+
+func (x *RenewedBorrow) GetReturnDate() string {
+	if x != nil {
+		return x.ReturnDate
+	}
+	return ""
+}
+
+func (x *RenewedBorrow) GetRenewalCount() int32 {
+	if x != nil {
+		return x.RenewalCount
+	}
+	return 0
+}
+
+func (x *RenewedBorrow) GetRenewalsLeft() int32 {
+	if x != nil {
+		return x.RenewalsLeft
+	}
+	return 0
+}
+
+type Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId        int32  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	BookId        int32  `protobuf:"varint,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Barcode       string `protobuf:"bytes,3,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	Condition     string `protobuf:"bytes,4,opt,name=condition,proto3" json:"condition,omitempty"` // good, worn, damaged
+	Status        string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`       // available, on_loan, on_hold, in_transit, lost, damaged
+	ShelfLocation string `protobuf:"bytes,6,opt,name=shelf_location,json=shelfLocation,proto3" json:"shelf_location,omitempty"`
+	CreatedAt     string `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	BranchId      int32  `protobuf:"varint,9,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"` // where the copy is, or is headed while in transit
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	mi := &file_proto_protos_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserPassword) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Item) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *Item) GetBookId() int32 {
+	if x != nil {
+		return x.BookId
+	}
+	return 0
 }
 
-func (*UserPassword) ProtoMessage() {}
+func (x *Item) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
 
-func (x *UserPassword) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[7]
+func (x *Item) GetCondition() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Condition
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UserPassword.ProtoReflect.Descriptor instead.
-func (*UserPassword) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{7}
+func (x *Item) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *UserPassword) GetUsername() string {
+func (x *Item) GetShelfLocation() string {
 	if x != nil {
-		return x.Username
+		return x.ShelfLocation
 	}
 	return ""
 }
 
-func (x *UserPassword) GetPassword() string {
+func (x *Item) GetCreatedAt() string {
 	if x != nil {
-		return x.Password
+		return x.CreatedAt
 	}
 	return ""
 }
 
-type UserIDPassword struct {
+func (x *Item) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Item) GetBranchId() int32 {
+	if x != nil {
+		return x.BranchId
+	}
+	return 0
+}
+
+type ItemQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId   int32  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	BookId   int32  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`       // 0 for every book
+	Status   string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                      // empty for every status
+	BranchId int32  `protobuf:"varint,3,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"` // 0 for every branch
 }
 
-func (x *UserIDPassword) Reset() {
-	*x = UserIDPassword{}
-	mi := &file_proto_protos_proto_msgTypes[8]
+func (x *ItemQuery) Reset() {
+	*x = ItemQuery{}
+	mi := &file_proto_protos_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserIDPassword) String() string {
+func (x *ItemQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserIDPassword) ProtoMessage() {}
+func (*ItemQuery) ProtoMessage() {}
 
-func (x *UserIDPassword) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[8]
+func (x *ItemQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,50 +2951,55 @@ func (x *UserIDPassword) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserIDPassword.ProtoReflect.Descriptor instead.
-func (*UserIDPassword) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ItemQuery.ProtoReflect.Descriptor instead.
+func (*ItemQuery) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *UserIDPassword) GetUserId() int32 {
+func (x *ItemQuery) GetBookId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.BookId
 	}
 	return 0
 }
 
-func (x *UserIDPassword) GetPassword() string {
+func (x *ItemQuery) GetStatus() string {
 	if x != nil {
-		return x.Password
+		return x.Status
 	}
 	return ""
 }
 
-type NewPassword struct {
+func (x *ItemQuery) GetBranchId() int32 {
+	if x != nil {
+		return x.BranchId
+	}
+	return 0
+}
+
+type Items struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Username    string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password    string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	NewPassword string `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	Items []*Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *NewPassword) Reset() {
-	*x = NewPassword{}
-	mi := &file_proto_protos_proto_msgTypes[9]
+func (x *Items) Reset() {
+	*x = Items{}
+	mi := &file_proto_protos_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NewPassword) String() string {
+func (x *Items) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewPassword) ProtoMessage() {}
+func (*Items) ProtoMessage() {}
 
-func (x *NewPassword) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[9]
+func (x *Items) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -573,58 +3010,48 @@ func (x *NewPassword) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewPassword.ProtoReflect.Descriptor instead.
-func (*NewPassword) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *NewPassword) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-func (x *NewPassword) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
+// Deprecated: Use Items.ProtoReflect.Descriptor instead.
+func (*Items) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *NewPassword) GetNewPassword() string {
+func (x *Items) GetItems() []*Item {
 	if x != nil {
-		return x.NewPassword
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-type Category struct {
+type Notification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt   string `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt   string `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	NotificationId int32  `protobuf:"varint,1,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	UserId         int32  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BorrowingId    int32  `protobuf:"varint,3,opt,name=borrowing_id,json=borrowingId,proto3" json:"borrowing_id,omitempty"`
+	Kind           string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`                      // due_soon, overdue
+	DueDate        string `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"` // return_date of the borrow when the notice was sent
+	Recipient      string `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Subject        string `protobuf:"bytes,7,opt,name=subject,proto3" json:"subject,omitempty"`
+	SentAt         string `protobuf:"bytes,8,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
 }
 
-func (x *Category) Reset() {
-	*x = Category{}
-	mi := &file_proto_protos_proto_msgTypes[10]
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_proto_protos_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Category) String() string {
+func (x *Notification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Category) ProtoMessage() {}
+func (*Notification) ProtoMessage() {}
 
-func (x *Category) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[10]
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,115 +3062,90 @@ func (x *Category) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Category.ProtoReflect.Descriptor instead.
-func (*Category) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *Category) GetName() string {
+func (x *Notification) GetNotificationId() int32 {
 	if x != nil {
-		return x.Name
+		return x.NotificationId
 	}
-	return ""
+	return 0
 }
 
-func (x *Category) GetDescription() string {
+func (x *Notification) GetUserId() int32 {
 	if x != nil {
-		return x.Description
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *Category) GetCreatedAt() string {
+func (x *Notification) GetBorrowingId() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.BorrowingId
 	}
-	return ""
+	return 0
 }
 
-func (x *Category) GetUpdatedAt() string {
+func (x *Notification) GetKind() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Kind
 	}
 	return ""
 }
 
-type CategoryMin struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CategoryId int32  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-}
-
-func (x *CategoryMin) Reset() {
-	*x = CategoryMin{}
-	mi := &file_proto_protos_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CategoryMin) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CategoryMin) ProtoMessage() {}
-
-func (x *CategoryMin) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[11]
+func (x *Notification) GetDueDate() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DueDate
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CategoryMin.ProtoReflect.Descriptor instead.
-func (*CategoryMin) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{11}
+func (x *Notification) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
 }
 
-func (x *CategoryMin) GetCategoryId() int32 {
+func (x *Notification) GetSubject() string {
 	if x != nil {
-		return x.CategoryId
+		return x.Subject
 	}
-	return 0
+	return ""
 }
 
-func (x *CategoryMin) GetName() string {
+func (x *Notification) GetSentAt() string {
 	if x != nil {
-		return x.Name
+		return x.SentAt
 	}
 	return ""
 }
 
-type CategoryMins struct {
+type Notifications struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Categories []*CategoryMin `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Notifications []*Notification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
 }
 
-func (x *CategoryMins) Reset() {
-	*x = CategoryMins{}
-	mi := &file_proto_protos_proto_msgTypes[12]
+func (x *Notifications) Reset() {
+	*x = Notifications{}
+	mi := &file_proto_protos_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryMins) String() string {
+func (x *Notifications) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryMins) ProtoMessage() {}
+func (*Notifications) ProtoMessage() {}
 
-func (x *CategoryMins) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[12]
+func (x *Notifications) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,44 +3156,44 @@ func (x *CategoryMins) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryMins.ProtoReflect.Descriptor instead.
-func (*CategoryMins) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Notifications.ProtoReflect.Descriptor instead.
+func (*Notifications) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *CategoryMins) GetCategories() []*CategoryMin {
+func (x *Notifications) GetNotifications() []*Notification {
 	if x != nil {
-		return x.Categories
+		return x.Notifications
 	}
 	return nil
 }
 
-type UpdateCategory struct {
+type Branch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CategoryId     int32  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	NewName        string `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
-	NewDescription string `protobuf:"bytes,3,opt,name=new_description,json=newDescription,proto3" json:"new_description,omitempty"`
-	UpdatedAt      string `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	BranchId  int32  `protobuf:"varint,1,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address   string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	CreatedAt string `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *UpdateCategory) Reset() {
-	*x = UpdateCategory{}
-	mi := &file_proto_protos_proto_msgTypes[13]
+func (x *Branch) Reset() {
+	*x = Branch{}
+	mi := &file_proto_protos_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCategory) String() string {
+func (x *Branch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCategory) ProtoMessage() {}
+func (*Branch) ProtoMessage() {}
 
-func (x *UpdateCategory) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[13]
+func (x *Branch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -802,63 +3204,62 @@ func (x *UpdateCategory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCategory.ProtoReflect.Descriptor instead.
-func (*UpdateCategory) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use Branch.ProtoReflect.Descriptor instead.
+func (*Branch) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *UpdateCategory) GetCategoryId() int32 {
+func (x *Branch) GetBranchId() int32 {
 	if x != nil {
-		return x.CategoryId
+		return x.BranchId
 	}
 	return 0
 }
 
-func (x *UpdateCategory) GetNewName() string {
+func (x *Branch) GetName() string {
 	if x != nil {
-		return x.NewName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateCategory) GetNewDescription() string {
+func (x *Branch) GetAddress() string {
 	if x != nil {
-		return x.NewDescription
+		return x.Address
 	}
 	return ""
 }
 
-func (x *UpdateCategory) GetUpdatedAt() string {
+func (x *Branch) GetCreatedAt() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.CreatedAt
 	}
 	return ""
 }
 
-type DateLimits struct {
+type Branches struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // format: 1997-06-26
-	EndDate   string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // format: 1997-06-26
+	Branches []*Branch `protobuf:"bytes,1,rep,name=branches,proto3" json:"branches,omitempty"`
 }
 
-func (x *DateLimits) Reset() {
-	*x = DateLimits{}
-	mi := &file_proto_protos_proto_msgTypes[14]
+func (x *Branches) Reset() {
+	*x = Branches{}
+	mi := &file_proto_protos_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DateLimits) String() string {
+func (x *Branches) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DateLimits) ProtoMessage() {}
+func (*Branches) ProtoMessage() {}
 
-func (x *DateLimits) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[14]
+func (x *Branches) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -869,49 +3270,45 @@ func (x *DateLimits) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DateLimits.ProtoReflect.Descriptor instead.
-func (*DateLimits) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *DateLimits) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
+// Deprecated: Use Branches.ProtoReflect.Descriptor instead.
+func (*Branches) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *DateLimits) GetEndDate() string {
+func (x *Branches) GetBranches() []*Branch {
 	if x != nil {
-		return x.EndDate
+		return x.Branches
 	}
-	return ""
+	return nil
 }
 
-type IDLimits struct {
+type OpeningHours struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Min int32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
-	Max int32 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	BranchId int32  `protobuf:"varint,1,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"` // 0 for the default of every branch
+	Weekday  int32  `protobuf:"varint,2,opt,name=weekday,proto3" json:"weekday,omitempty"`                   // 0 Sunday to 6 Saturday
+	OpensAt  string `protobuf:"bytes,3,opt,name=opens_at,json=opensAt,proto3" json:"opens_at,omitempty"`     // HH:MM
+	ClosesAt string `protobuf:"bytes,4,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`  // HH:MM
+	Closed   bool   `protobuf:"varint,5,opt,name=closed,proto3" json:"closed,omitempty"`
 }
 
-func (x *IDLimits) Reset() {
-	*x = IDLimits{}
-	mi := &file_proto_protos_proto_msgTypes[15]
+func (x *OpeningHours) Reset() {
+	*x = OpeningHours{}
+	mi := &file_proto_protos_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IDLimits) String() string {
+func (x *OpeningHours) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IDLimits) ProtoMessage() {}
+func (*OpeningHours) ProtoMessage() {}
 
-func (x *IDLimits) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[15]
+func (x *OpeningHours) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,53 +3319,72 @@ func (x *IDLimits) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IDLimits.ProtoReflect.Descriptor instead.
-func (*IDLimits) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use OpeningHours.ProtoReflect.Descriptor instead.
+func (*OpeningHours) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *OpeningHours) GetBranchId() int32 {
+	if x != nil {
+		return x.BranchId
+	}
+	return 0
+}
+
+func (x *OpeningHours) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *OpeningHours) GetOpensAt() string {
+	if x != nil {
+		return x.OpensAt
+	}
+	return ""
 }
 
-func (x *IDLimits) GetMin() int32 {
+func (x *OpeningHours) GetClosesAt() string {
 	if x != nil {
-		return x.Min
+		return x.ClosesAt
 	}
-	return 0
+	return ""
 }
 
-func (x *IDLimits) GetMax() int32 {
+func (x *OpeningHours) GetClosed() bool {
 	if x != nil {
-		return x.Max
+		return x.Closed
 	}
-	return 0
+	return false
 }
 
-type Author struct {
+type Holiday struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Birthdate   string `protobuf:"bytes,2,opt,name=birthdate,proto3" json:"birthdate,omitempty"` // format: 1997-06-26
-	Nationality string `protobuf:"bytes,3,opt,name=nationality,proto3" json:"nationality,omitempty"`
-	Biography   string `protobuf:"bytes,4,opt,name=biography,proto3" json:"biography,omitempty"`
-	CreatedAt   string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt   string `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	HolidayId int32  `protobuf:"varint,1,opt,name=holiday_id,json=holidayId,proto3" json:"holiday_id,omitempty"`
+	BranchId  int32  `protobuf:"varint,2,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"` // 0 for every branch
+	Date      string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`                          // YYYY-MM-DD
+	Name      string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *Author) Reset() {
-	*x = Author{}
-	mi := &file_proto_protos_proto_msgTypes[16]
+func (x *Holiday) Reset() {
+	*x = Holiday{}
+	mi := &file_proto_protos_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Author) String() string {
+func (x *Holiday) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Author) ProtoMessage() {}
+func (*Holiday) ProtoMessage() {}
 
-func (x *Author) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[16]
+func (x *Holiday) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -979,77 +3395,64 @@ func (x *Author) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Author.ProtoReflect.Descriptor instead.
-func (*Author) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *Author) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Author) GetBirthdate() string {
-	if x != nil {
-		return x.Birthdate
-	}
-	return ""
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *Author) GetNationality() string {
+func (x *Holiday) GetHolidayId() int32 {
 	if x != nil {
-		return x.Nationality
+		return x.HolidayId
 	}
-	return ""
+	return 0
 }
 
-func (x *Author) GetBiography() string {
+func (x *Holiday) GetBranchId() int32 {
 	if x != nil {
-		return x.Biography
+		return x.BranchId
 	}
-	return ""
+	return 0
 }
 
-func (x *Author) GetCreatedAt() string {
+func (x *Holiday) GetDate() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Date
 	}
 	return ""
 }
 
-func (x *Author) GetUpdatedAt() string {
+func (x *Holiday) GetName() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Name
 	}
 	return ""
 }
 
-type AuthorMin struct {
+type Calendar struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AuthorId int32  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BranchId int32           `protobuf:"varint,1,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"`
+	Hours    []*OpeningHours `protobuf:"bytes,2,rep,name=hours,proto3" json:"hours,omitempty"` // in effect per weekday, a weekday without hours is open
+	Holidays []*Holiday      `protobuf:"bytes,3,rep,name=holidays,proto3" json:"holidays,omitempty"`
 }
 
-func (x *AuthorMin) Reset() {
-	*x = AuthorMin{}
-	mi := &file_proto_protos_proto_msgTypes[17]
+func (x *Calendar) Reset() {
+	*x = Calendar{}
+	mi := &file_proto_protos_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthorMin) String() string {
+func (x *Calendar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorMin) ProtoMessage() {}
+func (*Calendar) ProtoMessage() {}
 
-func (x *AuthorMin) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[17]
+func (x *Calendar) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1060,48 +3463,56 @@ func (x *AuthorMin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorMin.ProtoReflect.Descriptor instead.
-func (*AuthorMin) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use Calendar.ProtoReflect.Descriptor instead.
+func (*Calendar) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *AuthorMin) GetAuthorId() int32 {
+func (x *Calendar) GetBranchId() int32 {
 	if x != nil {
-		return x.AuthorId
+		return x.BranchId
 	}
 	return 0
 }
 
-func (x *AuthorMin) GetName() string {
+func (x *Calendar) GetHours() []*OpeningHours {
 	if x != nil {
-		return x.Name
+		return x.Hours
 	}
-	return ""
+	return nil
 }
 
-type AuthorMins struct {
+func (x *Calendar) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+type TransferRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authors []*AuthorMin `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
+	Barcode    string `protobuf:"bytes,1,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	ToBranchId int32  `protobuf:"varint,2,opt,name=to_branch_id,json=toBranchId,proto3" json:"to_branch_id,omitempty"`
 }
 
-func (x *AuthorMins) Reset() {
-	*x = AuthorMins{}
-	mi := &file_proto_protos_proto_msgTypes[18]
+func (x *TransferRequest) Reset() {
+	*x = TransferRequest{}
+	mi := &file_proto_protos_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuthorMins) String() string {
+func (x *TransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthorMins) ProtoMessage() {}
+func (*TransferRequest) ProtoMessage() {}
 
-func (x *AuthorMins) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[18]
+func (x *TransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,46 +3523,58 @@ func (x *AuthorMins) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthorMins.ProtoReflect.Descriptor instead.
-func (*AuthorMins) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use TransferRequest.ProtoReflect.Descriptor instead.
+func (*TransferRequest) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *AuthorMins) GetAuthors() []*AuthorMin {
+func (x *TransferRequest) GetBarcode() string {
 	if x != nil {
-		return x.Authors
+		return x.Barcode
 	}
-	return nil
+	return ""
 }
 
-type UpdateAuthor struct {
+func (x *TransferRequest) GetToBranchId() int32 {
+	if x != nil {
+		return x.ToBranchId
+	}
+	return 0
+}
+
+type Transfer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AuthorId       int32  `protobuf:"varint,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	NewName        string `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
-	NewBirthdate   string `protobuf:"bytes,3,opt,name=new_birthdate,json=newBirthdate,proto3" json:"new_birthdate,omitempty"` // format: 1997-06-26
-	NewNationality string `protobuf:"bytes,4,opt,name=new_nationality,json=newNationality,proto3" json:"new_nationality,omitempty"`
-	NewBiography   string `protobuf:"bytes,5,opt,name=new_biography,json=newBiography,proto3" json:"new_biography,omitempty"`
-	UpdatedAt      string `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-}
-
-func (x *UpdateAuthor) Reset() {
-	*x = UpdateAuthor{}
-	mi := &file_proto_protos_proto_msgTypes[19]
+	TransferId   int32  `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	ItemId       int32  `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Barcode      string `protobuf:"bytes,3,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	BookId       int32  `protobuf:"varint,4,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	FromBranchId int32  `protobuf:"varint,5,opt,name=from_branch_id,json=fromBranchId,proto3" json:"from_branch_id,omitempty"`
+	ToBranchId   int32  `protobuf:"varint,6,opt,name=to_branch_id,json=toBranchId,proto3" json:"to_branch_id,omitempty"`
+	HoldId       int32  `protobuf:"varint,7,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"` // the hold the copy is sent for, 0 for a plain transfer
+	Status       string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                // requested, in_transit, received, cancelled
+	RequestedAt  string `protobuf:"bytes,9,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	ShippedAt    string `protobuf:"bytes,10,opt,name=shipped_at,json=shippedAt,proto3" json:"shipped_at,omitempty"`
+	ReceivedAt   string `protobuf:"bytes,11,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+}
+
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	mi := &file_proto_protos_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAuthor) String() string {
+func (x *Transfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAuthor) ProtoMessage() {}
+func (*Transfer) ProtoMessage() {}
 
-func (x *UpdateAuthor) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[19]
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,77 +3585,112 @@ func (x *UpdateAuthor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAuthor.ProtoReflect.Descriptor instead.
-func (*UpdateAuthor) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *UpdateAuthor) GetAuthorId() int32 {
+func (x *Transfer) GetTransferId() int32 {
 	if x != nil {
-		return x.AuthorId
+		return x.TransferId
 	}
 	return 0
 }
 
-func (x *UpdateAuthor) GetNewName() string {
+func (x *Transfer) GetItemId() int32 {
 	if x != nil {
-		return x.NewName
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *Transfer) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
 	}
 	return ""
 }
 
-func (x *UpdateAuthor) GetNewBirthdate() string {
+func (x *Transfer) GetBookId() int32 {
 	if x != nil {
-		return x.NewBirthdate
+		return x.BookId
+	}
+	return 0
+}
+
+func (x *Transfer) GetFromBranchId() int32 {
+	if x != nil {
+		return x.FromBranchId
+	}
+	return 0
+}
+
+func (x *Transfer) GetToBranchId() int32 {
+	if x != nil {
+		return x.ToBranchId
+	}
+	return 0
+}
+
+func (x *Transfer) GetHoldId() int32 {
+	if x != nil {
+		return x.HoldId
+	}
+	return 0
+}
+
+func (x *Transfer) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-func (x *UpdateAuthor) GetNewNationality() string {
+func (x *Transfer) GetRequestedAt() string {
 	if x != nil {
-		return x.NewNationality
+		return x.RequestedAt
 	}
 	return ""
 }
 
-func (x *UpdateAuthor) GetNewBiography() string {
+func (x *Transfer) GetShippedAt() string {
 	if x != nil {
-		return x.NewBiography
+		return x.ShippedAt
 	}
 	return ""
 }
 
-func (x *UpdateAuthor) GetUpdatedAt() string {
+func (x *Transfer) GetReceivedAt() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ReceivedAt
 	}
 	return ""
 }
 
-type GetRecommendation struct {
+type TransferQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CategoryId int32 `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Limit      int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	BranchId int32  `protobuf:"varint,1,opt,name=branch_id,json=branchId,proto3" json:"branch_id,omitempty"` // sending or receiving branch
+	Status   string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *GetRecommendation) Reset() {
-	*x = GetRecommendation{}
-	mi := &file_proto_protos_proto_msgTypes[20]
+func (x *TransferQuery) Reset() {
+	*x = TransferQuery{}
+	mi := &file_proto_protos_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRecommendation) String() string {
+func (x *TransferQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRecommendation) ProtoMessage() {}
+func (*TransferQuery) ProtoMessage() {}
 
-func (x *GetRecommendation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[20]
+func (x *TransferQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1243,56 +3701,48 @@ func (x *GetRecommendation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRecommendation.ProtoReflect.Descriptor instead.
-func (*GetRecommendation) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use TransferQuery.ProtoReflect.Descriptor instead.
+func (*TransferQuery) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetRecommendation) GetCategoryId() int32 {
+func (x *TransferQuery) GetBranchId() int32 {
 	if x != nil {
-		return x.CategoryId
+		return x.BranchId
 	}
 	return 0
 }
 
-func (x *GetRecommendation) GetLimit() int32 {
+func (x *TransferQuery) GetStatus() string {
 	if x != nil {
-		return x.Limit
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-type Book struct {
+type Transfers struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title          string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	CategoryId     int32  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	AuthorId       int32  `protobuf:"varint,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	PublishedDate  string `protobuf:"bytes,4,opt,name=published_date,json=publishedDate,proto3" json:"published_date,omitempty"` // format:
-	Isbn           string `protobuf:"bytes,5,opt,name=isbn,proto3" json:"isbn,omitempty"`
-	TotalStock     int32  `protobuf:"varint,6,opt,name=total_stock,json=totalStock,proto3" json:"total_stock,omitempty"`
-	AvailableStock int32  `protobuf:"varint,7,opt,name=available_stock,json=availableStock,proto3" json:"available_stock,omitempty"`
-	CreatedAt      string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt      string `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Transfers []*Transfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
 }
 
-func (x *Book) Reset() {
-	*x = Book{}
-	mi := &file_proto_protos_proto_msgTypes[21]
+func (x *Transfers) Reset() {
+	*x = Transfers{}
+	mi := &file_proto_protos_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Book) String() string {
+func (x *Transfers) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Book) ProtoMessage() {}
+func (*Transfers) ProtoMessage() {}
 
-func (x *Book) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[21]
+func (x *Transfers) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1303,102 +3753,47 @@ func (x *Book) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Book.ProtoReflect.Descriptor instead.
-func (*Book) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *Book) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *Book) GetCategoryId() int32 {
-	if x != nil {
-		return x.CategoryId
-	}
-	return 0
-}
-
-func (x *Book) GetAuthorId() int32 {
-	if x != nil {
-		return x.AuthorId
-	}
-	return 0
-}
-
-func (x *Book) GetPublishedDate() string {
-	if x != nil {
-		return x.PublishedDate
-	}
-	return ""
-}
-
-func (x *Book) GetIsbn() string {
-	if x != nil {
-		return x.Isbn
-	}
-	return ""
-}
-
-func (x *Book) GetTotalStock() int32 {
-	if x != nil {
-		return x.TotalStock
-	}
-	return 0
-}
-
-func (x *Book) GetAvailableStock() int32 {
-	if x != nil {
-		return x.AvailableStock
-	}
-	return 0
-}
-
-func (x *Book) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use Transfers.ProtoReflect.Descriptor instead.
+func (*Transfers) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *Book) GetUpdatedAt() string {
+func (x *Transfers) GetTransfers() []*Transfer {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Transfers
 	}
-	return ""
+	return nil
 }
 
-type BookMin struct {
+type CirculationPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BookId         int32  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
-	Title          string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	CategoryId     int32  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	AuthorId       string `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
-	PublishedDate  string `protobuf:"bytes,5,opt,name=published_date,json=publishedDate,proto3" json:"published_date,omitempty"` // format: 1997-06-26
-	AvailableStock int32  `protobuf:"varint,6,opt,name=available_stock,json=availableStock,proto3" json:"available_stock,omitempty"`
+	PolicyId   int32  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Role       string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`                                // user, librarian, admin, or * for every role
+	CategoryId int32  `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // 0 for every category
+	MaxLoans   int32  `protobuf:"varint,4,opt,name=max_loans,json=maxLoans,proto3" json:"max_loans,omitempty"`       // concurrent loans
+	LoanDays   int32  `protobuf:"varint,5,opt,name=loan_days,json=loanDays,proto3" json:"loan_days,omitempty"`       // loan period, also added by each renewal
+	Renewals   int32  `protobuf:"varint,6,opt,name=renewals,proto3" json:"renewals,omitempty"`                       // renewals per borrow
+	MaxHolds   int32  `protobuf:"varint,7,opt,name=max_holds,json=maxHolds,proto3" json:"max_holds,omitempty"`       // active holds
 }
 
-func (x *BookMin) Reset() {
-	*x = BookMin{}
-	mi := &file_proto_protos_proto_msgTypes[22]
+func (x *CirculationPolicy) Reset() {
+	*x = CirculationPolicy{}
+	mi := &file_proto_protos_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BookMin) String() string {
+func (x *CirculationPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookMin) ProtoMessage() {}
+func (*CirculationPolicy) ProtoMessage() {}
 
-func (x *BookMin) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[22]
+func (x *CirculationPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1409,76 +3804,84 @@ func (x *BookMin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookMin.ProtoReflect.Descriptor instead.
-func (*BookMin) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use CirculationPolicy.ProtoReflect.Descriptor instead.
+func (*CirculationPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *BookMin) GetBookId() int32 {
+func (x *CirculationPolicy) GetPolicyId() int32 {
 	if x != nil {
-		return x.BookId
+		return x.PolicyId
 	}
 	return 0
 }
 
-func (x *BookMin) GetTitle() string {
+func (x *CirculationPolicy) GetRole() string {
 	if x != nil {
-		return x.Title
+		return x.Role
 	}
 	return ""
 }
 
-func (x *BookMin) GetCategoryId() int32 {
+func (x *CirculationPolicy) GetCategoryId() int32 {
 	if x != nil {
 		return x.CategoryId
 	}
 	return 0
 }
 
-func (x *BookMin) GetAuthorId() string {
+func (x *CirculationPolicy) GetMaxLoans() int32 {
 	if x != nil {
-		return x.AuthorId
+		return x.MaxLoans
 	}
-	return ""
+	return 0
 }
 
-func (x *BookMin) GetPublishedDate() string {
+func (x *CirculationPolicy) GetLoanDays() int32 {
 	if x != nil {
-		return x.PublishedDate
+		return x.LoanDays
 	}
-	return ""
+	return 0
 }
 
-func (x *BookMin) GetAvailableStock() int32 {
+func (x *CirculationPolicy) GetRenewals() int32 {
 	if x != nil {
-		return x.AvailableStock
+		return x.Renewals
 	}
 	return 0
 }
 
-type BookMins struct {
+func (x *CirculationPolicy) GetMaxHolds() int32 {
+	if x != nil {
+		return x.MaxHolds
+	}
+	return 0
+}
+
+type CirculationPolicies struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Books []*BookMin `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"`
+	Policies []*CirculationPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	Default  *CirculationPolicy   `protobuf:"bytes,2,opt,name=default,proto3" json:"default,omitempty"` // applies when no policy matches
 }
 
-func (x *BookMins) Reset() {
-	*x = BookMins{}
-	mi := &file_proto_protos_proto_msgTypes[23]
+func (x *CirculationPolicies) Reset() {
+	*x = CirculationPolicies{}
+	mi := &file_proto_protos_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BookMins) String() string {
+func (x *CirculationPolicies) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookMins) ProtoMessage() {}
+func (*CirculationPolicies) ProtoMessage() {}
 
-func (x *BookMins) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[23]
+func (x *CirculationPolicies) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1489,49 +3892,58 @@ func (x *BookMins) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookMins.ProtoReflect.Descriptor instead.
-func (*BookMins) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use CirculationPolicies.ProtoReflect.Descriptor instead.
+func (*CirculationPolicies) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *BookMins) GetBooks() []*BookMin {
+func (x *CirculationPolicies) GetPolicies() []*CirculationPolicy {
 	if x != nil {
-		return x.Books
+		return x.Policies
 	}
 	return nil
 }
 
-type UpdateBook struct {
+func (x *CirculationPolicies) GetDefault() *CirculationPolicy {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+type Fine struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BookId            int32  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
-	NewTitle          string `protobuf:"bytes,2,opt,name=new_title,json=newTitle,proto3" json:"new_title,omitempty"`
-	NewCategoryId     int32  `protobuf:"varint,3,opt,name=new_category_id,json=newCategoryId,proto3" json:"new_category_id,omitempty"`
-	NewAuthorId       int32  `protobuf:"varint,4,opt,name=new_author_id,json=newAuthorId,proto3" json:"new_author_id,omitempty"`
-	NewPublishedDate  string `protobuf:"bytes,5,opt,name=new_published_date,json=newPublishedDate,proto3" json:"new_published_date,omitempty"` // format: 1997-06-26
-	NewIsbn           string `protobuf:"bytes,6,opt,name=new_isbn,json=newIsbn,proto3" json:"new_isbn,omitempty"`
-	NewTotalStock     int32  `protobuf:"varint,7,opt,name=new_total_stock,json=newTotalStock,proto3" json:"new_total_stock,omitempty"`
-	NewAvailableStock int32  `protobuf:"varint,8,opt,name=new_available_stock,json=newAvailableStock,proto3" json:"new_available_stock,omitempty"`
-	UpdatedAt         string `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-}
-
-func (x *UpdateBook) Reset() {
-	*x = UpdateBook{}
-	mi := &file_proto_protos_proto_msgTypes[24]
+	FineId      int32  `protobuf:"varint,1,opt,name=fine_id,json=fineId,proto3" json:"fine_id,omitempty"`
+	BorrowingId int32  `protobuf:"varint,2,opt,name=borrowing_id,json=borrowingId,proto3" json:"borrowing_id,omitempty"`
+	BookId      int32  `protobuf:"varint,3,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	UserId      int32  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DaysLate    int32  `protobuf:"varint,5,opt,name=days_late,json=daysLate,proto3" json:"days_late,omitempty"`
+	AmountCents int32  `protobuf:"varint,6,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	PaidCents   int32  `protobuf:"varint,7,opt,name=paid_cents,json=paidCents,proto3" json:"paid_cents,omitempty"`
+	Status      string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // outstanding, paid, waived
+	Note        string `protobuf:"bytes,9,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt   string `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ClosedAt    string `protobuf:"bytes,11,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+}
+
+func (x *Fine) Reset() {
+	*x = Fine{}
+	mi := &file_proto_protos_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBook) String() string {
+func (x *Fine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBook) ProtoMessage() {}
+func (*Fine) ProtoMessage() {}
 
-func (x *UpdateBook) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[24]
+func (x *Fine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1542,102 +3954,112 @@ func (x *UpdateBook) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBook.ProtoReflect.Descriptor instead.
-func (*UpdateBook) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use Fine.ProtoReflect.Descriptor instead.
+func (*Fine) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *UpdateBook) GetBookId() int32 {
+func (x *Fine) GetFineId() int32 {
+	if x != nil {
+		return x.FineId
+	}
+	return 0
+}
+
+func (x *Fine) GetBorrowingId() int32 {
+	if x != nil {
+		return x.BorrowingId
+	}
+	return 0
+}
+
+func (x *Fine) GetBookId() int32 {
 	if x != nil {
 		return x.BookId
 	}
 	return 0
 }
 
-func (x *UpdateBook) GetNewTitle() string {
+func (x *Fine) GetUserId() int32 {
 	if x != nil {
-		return x.NewTitle
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateBook) GetNewCategoryId() int32 {
+func (x *Fine) GetDaysLate() int32 {
 	if x != nil {
-		return x.NewCategoryId
+		return x.DaysLate
 	}
 	return 0
 }
 
-func (x *UpdateBook) GetNewAuthorId() int32 {
+func (x *Fine) GetAmountCents() int32 {
 	if x != nil {
-		return x.NewAuthorId
+		return x.AmountCents
 	}
 	return 0
 }
 
-func (x *UpdateBook) GetNewPublishedDate() string {
+func (x *Fine) GetPaidCents() int32 {
 	if x != nil {
-		return x.NewPublishedDate
+		return x.PaidCents
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateBook) GetNewIsbn() string {
+func (x *Fine) GetStatus() string {
 	if x != nil {
-		return x.NewIsbn
+		return x.Status
 	}
 	return ""
 }
 
-func (x *UpdateBook) GetNewTotalStock() int32 {
+func (x *Fine) GetNote() string {
 	if x != nil {
-		return x.NewTotalStock
+		return x.Note
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateBook) GetNewAvailableStock() int32 {
+func (x *Fine) GetCreatedAt() string {
 	if x != nil {
-		return x.NewAvailableStock
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateBook) GetUpdatedAt() string {
+func (x *Fine) GetClosedAt() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.ClosedAt
 	}
 	return ""
 }
 
-type Borrow struct {
+type Fines struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BookId       int32  `protobuf:"varint,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
-	UserId       int32  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	BorrowedDate string `protobuf:"bytes,3,opt,name=borrowed_date,json=borrowedDate,proto3" json:"borrowed_date,omitempty"`
-	ReturnDate   string `protobuf:"bytes,4,opt,name=return_date,json=returnDate,proto3" json:"return_date,omitempty"`
-	ReturnedDate string `protobuf:"bytes,5,opt,name=returned_date,json=returnedDate,proto3" json:"returned_date,omitempty"`
-	Returned     string `protobuf:"bytes,6,opt,name=returned,proto3" json:"returned,omitempty"` // t/f
+	Fines            []*Fine `protobuf:"bytes,1,rep,name=fines,proto3" json:"fines,omitempty"`
+	OutstandingCents int32   `protobuf:"varint,2,opt,name=outstanding_cents,json=outstandingCents,proto3" json:"outstanding_cents,omitempty"`
 }
 
-func (x *Borrow) Reset() {
-	*x = Borrow{}
-	mi := &file_proto_protos_proto_msgTypes[25]
+func (x *Fines) Reset() {
+	*x = Fines{}
+	mi := &file_proto_protos_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Borrow) String() string {
+func (x *Fines) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Borrow) ProtoMessage() {}
+func (*Fines) ProtoMessage() {}
 
-func (x *Borrow) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[25]
+func (x *Fines) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1648,80 +4070,49 @@ func (x *Borrow) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Borrow.ProtoReflect.Descriptor instead.
-func (*Borrow) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use Fines.ProtoReflect.Descriptor instead.
+func (*Fines) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *Borrow) GetBookId() int32 {
+func (x *Fines) GetFines() []*Fine {
 	if x != nil {
-		return x.BookId
+		return x.Fines
 	}
-	return 0
+	return nil
 }
 
-func (x *Borrow) GetUserId() int32 {
+func (x *Fines) GetOutstandingCents() int32 {
 	if x != nil {
-		return x.UserId
+		return x.OutstandingCents
 	}
 	return 0
 }
 
-func (x *Borrow) GetBorrowedDate() string {
-	if x != nil {
-		return x.BorrowedDate
-	}
-	return ""
-}
-
-func (x *Borrow) GetReturnDate() string {
-	if x != nil {
-		return x.ReturnDate
-	}
-	return ""
-}
-
-func (x *Borrow) GetReturnedDate() string {
-	if x != nil {
-		return x.ReturnedDate
-	}
-	return ""
-}
-
-func (x *Borrow) GetReturned() string {
-	if x != nil {
-		return x.Returned
-	}
-	return ""
-}
-
-type BorrowOrReturnMin struct {
+type FinePayment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BorrowingId  int32  `protobuf:"varint,1,opt,name=borrowing_id,json=borrowingId,proto3" json:"borrowing_id,omitempty"`
-	BookId       int32  `protobuf:"varint,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
-	UserId       int32  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	BorrowedDate string `protobuf:"bytes,4,opt,name=borrowed_date,json=borrowedDate,proto3" json:"borrowed_date,omitempty"`
-	ReturnDate   string `protobuf:"bytes,5,opt,name=return_date,json=returnDate,proto3" json:"return_date,omitempty"`
+	FineId      int32 `protobuf:"varint,1,opt,name=fine_id,json=fineId,proto3" json:"fine_id,omitempty"`
+	AmountCents int32 `protobuf:"varint,2,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
 }
 
-func (x *BorrowOrReturnMin) Reset() {
-	*x = BorrowOrReturnMin{}
-	mi := &file_proto_protos_proto_msgTypes[26]
+func (x *FinePayment) Reset() {
+	*x = FinePayment{}
+	mi := &file_proto_protos_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BorrowOrReturnMin) String() string {
+func (x *FinePayment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BorrowOrReturnMin) ProtoMessage() {}
+func (*FinePayment) ProtoMessage() {}
 
-func (x *BorrowOrReturnMin) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[26]
+func (x *FinePayment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1732,70 +4123,49 @@ func (x *BorrowOrReturnMin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BorrowOrReturnMin.ProtoReflect.Descriptor instead.
-func (*BorrowOrReturnMin) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *BorrowOrReturnMin) GetBorrowingId() int32 {
-	if x != nil {
-		return x.BorrowingId
-	}
-	return 0
+// Deprecated: Use FinePayment.ProtoReflect.Descriptor instead.
+func (*FinePayment) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *BorrowOrReturnMin) GetBookId() int32 {
+func (x *FinePayment) GetFineId() int32 {
 	if x != nil {
-		return x.BookId
+		return x.FineId
 	}
 	return 0
 }
 
-func (x *BorrowOrReturnMin) GetUserId() int32 {
+func (x *FinePayment) GetAmountCents() int32 {
 	if x != nil {
-		return x.UserId
+		return x.AmountCents
 	}
 	return 0
 }
 
-func (x *BorrowOrReturnMin) GetBorrowedDate() string {
-	if x != nil {
-		return x.BorrowedDate
-	}
-	return ""
-}
-
-func (x *BorrowOrReturnMin) GetReturnDate() string {
-	if x != nil {
-		return x.ReturnDate
-	}
-	return ""
-}
-
-type BorrowOrReturnMins struct {
+type FineWaiver struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message    string               `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"` // "borrowed" or "returned"
-	Borrowings []*BorrowOrReturnMin `protobuf:"bytes,2,rep,name=borrowings,proto3" json:"borrowings,omitempty"`
+	FineId int32  `protobuf:"varint,1,opt,name=fine_id,json=fineId,proto3" json:"fine_id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *BorrowOrReturnMins) Reset() {
-	*x = BorrowOrReturnMins{}
-	mi := &file_proto_protos_proto_msgTypes[27]
+func (x *FineWaiver) Reset() {
+	*x = FineWaiver{}
+	mi := &file_proto_protos_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BorrowOrReturnMins) String() string {
+func (x *FineWaiver) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BorrowOrReturnMins) ProtoMessage() {}
+func (*FineWaiver) ProtoMessage() {}
 
-func (x *BorrowOrReturnMins) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[27]
+func (x *FineWaiver) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_protos_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1806,23 +4176,23 @@ func (x *BorrowOrReturnMins) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BorrowOrReturnMins.ProtoReflect.Descriptor instead.
-func (*BorrowOrReturnMins) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use FineWaiver.ProtoReflect.Descriptor instead.
+func (*FineWaiver) Descriptor() ([]byte, []int) {
+	return file_proto_protos_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *BorrowOrReturnMins) GetMessage() string {
+func (x *FineWaiver) GetFineId() int32 {
 	if x != nil {
-		return x.Message
+		return x.FineId
 	}
-	return ""
+	return 0
 }
 
-func (x *BorrowOrReturnMins) GetBorrowings() []*BorrowOrReturnMin {
+func (x *FineWaiver) GetReason() string {
 	if x != nil {
-		return x.Borrowings
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
 type UpdateBorrow struct {
@@ -1841,7 +4211,7 @@ type UpdateBorrow struct {
 
 func (x *UpdateBorrow) Reset() {
 	*x = UpdateBorrow{}
-	mi := &file_proto_protos_proto_msgTypes[28]
+	mi := &file_proto_protos_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1853,7 +4223,7 @@ func (x *UpdateBorrow) String() string {
 func (*UpdateBorrow) ProtoMessage() {}
 
 func (x *UpdateBorrow) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_protos_proto_msgTypes[28]
+	mi := &file_proto_protos_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1866,7 +4236,7 @@ func (x *UpdateBorrow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBorrow.ProtoReflect.Descriptor instead.
 func (*UpdateBorrow) Descriptor() ([]byte, []int) {
-	return file_proto_protos_proto_rawDescGZIP(), []int{28}
+	return file_proto_protos_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *UpdateBorrow) GetBorrowingId() int32 {
@@ -1924,62 +4294,119 @@ var file_proto_protos_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x1a, 0x1b, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x30, 0x0a, 0x0d, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x22, 0x33, 0x0a, 0x0e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x74, 0x72,
-	0x22, 0x2d, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
-	0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x22,
-	0x30, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6e,
-	0x74, 0x22, 0x33, 0x0a, 0x0c, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x62, 0x6f,
-	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x22, 0xeb, 0x01, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x53,
-	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x46, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x22, 0x45, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x68, 0x0a, 0x0b, 0x4e, 0x65, 0x77, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21,
-	0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03,
+	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x89, 0x01, 0x0a, 0x03, 0x4a, 0x57,
+	0x4b, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x61, 0x6c, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x73, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x73, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x6e, 0x12, 0x0c, 0x0a, 0x01, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x01, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x72, 0x76, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x63, 0x72, 0x76, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x01, 0x78, 0x22, 0x27, 0x0a, 0x04, 0x4a, 0x57, 0x4b, 0x53, 0x12, 0x1f, 0x0a,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4a, 0x57, 0x4b, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x30,
+	0x0a, 0x0d, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72,
+	0x22, 0x33, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x73,
+	0x74, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x53, 0x74, 0x72, 0x22, 0x2d, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x6e, 0x74, 0x22, 0x30, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x49, 0x6e, 0x74, 0x22, 0x33, 0x0a, 0x0c, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x22, 0x91, 0x01, 0x0a, 0x09,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x22,
+	0x6e, 0x0a, 0x0d, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22,
+	0x8f, 0x02, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x2d, 0x0a, 0x07, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x22, 0x0a, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73,
+	0x22, 0x4a, 0x0a, 0x0d, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x20, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x22, 0xeb, 0x01, 0x0a,
+	0x0d, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x04, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x46,
+	0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x45, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x68, 0x0a,
+	0x0b, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x48, 0x0a, 0x0d, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
 	0x64, 0x22, 0x7e, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
@@ -2011,7 +4438,13 @@ var file_proto_protos_proto_rawDesc = []byte{
 	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19,
 	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x2e, 0x0a, 0x08, 0x49, 0x44, 0x4c,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x5e, 0x0a, 0x0d, 0x4f, 0x76, 0x65,
+	0x72, 0x64, 0x75, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x22, 0x2e, 0x0a, 0x08, 0x49, 0x44, 0x4c,
 	0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x05, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0xb8, 0x01, 0x0a, 0x06, 0x41, 0x75,
@@ -2107,232 +4540,586 @@ var file_proto_protos_proto_rawDesc = []byte{
 	0x11, 0x6e, 0x65, 0x77, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x6f,
 	0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
 	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x22, 0xc1, 0x01, 0x0a, 0x06, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x17, 0x0a, 0x07,
-	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62,
-	0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23,
-	0x0a, 0x0d, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x44,
-	0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
-	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x65, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x11, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77,
-	0x4f, 0x72, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4d, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62,
-	0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x17,
+	0x74, 0x22, 0x69, 0x0a, 0x0b, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x69,
+	0x63, 0x6b, 0x75, 0x70, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x22, 0xad, 0x02, 0x0a,
+	0x04, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x17,
 	0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
 	0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
 	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65,
-	0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x22, 0x69, 0x0a, 0x12, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77,
-	0x4f, 0x72, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4d, 0x69, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77,
-	0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4f, 0x72, 0x52, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x4d, 0x69, 0x6e, 0x52, 0x0a, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67,
-	0x73, 0x22, 0x94, 0x02, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x72, 0x72,
-	0x6f, 0x77, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77,
-	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x6f, 0x6f,
-	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x42,
-	0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x6f, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x64, 0x79, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x72, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x72, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x69,
+	0x63, 0x6b, 0x75, 0x70, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x05,
+	0x48, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x48, 0x6f,
+	0x6c, 0x64, 0x52, 0x05, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x22, 0xf8, 0x01, 0x0a, 0x06, 0x42, 0x6f,
+	0x72, 0x72, 0x6f, 0x77, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77,
+	0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62,
+	0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x06, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x22, 0xcb,
+	0x01, 0x0a, 0x11, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4f, 0x72, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x4d, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x6f, 0x72, 0x72,
+	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6f, 0x72,
 	0x72, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x6e, 0x65, 0x77, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x61, 0x74,
-	0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x52,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x77,
-	0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65,
-	0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77,
-	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x32, 0xcb, 0x01, 0x0a, 0x0b, 0x55, 0x74, 0x69,
-	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x48, 0x65, 0x6c, 0x6c,
-	0x6f, 0x57, 0x6f, 0x72, 0x6c, 0x64, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
-	0x16, 0x41, 0x75, 0x74, 0x68, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xea, 0x02, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x1a, 0x16, 0x2e, 0x70, 0x72,
+	0x52, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x64, 0x61, 0x79, 0x73, 0x4c, 0x61, 0x74, 0x65, 0x22, 0x69, 0x0a, 0x12,
+	0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4f, 0x72, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4d, 0x69,
+	0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x0a,
+	0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77,
+	0x4f, 0x72, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4d, 0x69, 0x6e, 0x52, 0x0a, 0x62, 0x6f, 0x72,
+	0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6e, 0x65,
+	0x77, 0x65, 0x64, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x72,
+	0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x73, 0x5f, 0x6c,
+	0x65, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x6e, 0x65, 0x77,
+	0x61, 0x6c, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x22, 0x8a, 0x02, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x5f, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x65, 0x6c,
+	0x66, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x09, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x22,
+	0x2b, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xf3, 0x01, 0x0a,
+	0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a,
+	0x0f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x74,
+	0x41, 0x74, 0x22, 0x4b, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x72, 0x0a, 0x06, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x36, 0x0a, 0x08, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12,
+	0x2a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x0c,
+	0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x65,
+	0x6b, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x77, 0x65, 0x65, 0x6b,
+	0x64, 0x61, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x41, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x22, 0x6d, 0x0a, 0x07, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x08, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12,
+	0x1b, 0x0a, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x05,
+	0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72,
+	0x73, 0x52, 0x05, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x68, 0x6f, 0x6c, 0x69,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52, 0x08, 0x68, 0x6f, 0x6c,
+	0x69, 0x64, 0x61, 0x79, 0x73, 0x22, 0x4d, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x72, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x72, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x49, 0x64, 0x22, 0xd3, 0x02, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x61, 0x72, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61,
+	0x72, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x24,
+	0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x68, 0x69, 0x70, 0x70, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x22, 0x44, 0x0a, 0x0d, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x3b, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a,
+	0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0xd8, 0x01,
+	0x0a, 0x11, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x6f, 0x61,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x61,
+	0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x61, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x48, 0x6f, 0x6c, 0x64, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x13, 0x43, 0x69, 0x72,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
+	0x12, 0x35, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x69, 0x72, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0xbb, 0x02, 0x0a,
+	0x04, 0x46, 0x69, 0x6e, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x6c, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x61, 0x79, 0x73, 0x4c, 0x61, 0x74, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x63, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x69, 0x64, 0x43, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
+	0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x22, 0x58, 0x0a, 0x05, 0x46, 0x69,
+	0x6e, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x05, 0x66, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x46, 0x69, 0x6e, 0x65,
+	0x52, 0x05, 0x66, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x49, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x3d, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x65, 0x57, 0x61, 0x69, 0x76, 0x65, 0x72, 0x12, 0x17, 0x0a,
+	0x07, 0x66, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x66, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x94,
+	0x02, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x42, 0x6f, 0x6f, 0x6b,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77,
+	0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e,
+	0x65, 0x77, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x65, 0x64, 0x32, 0xfc, 0x01, 0x0a, 0x0b, 0x55, 0x74, 0x69, 0x6c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x57, 0x6f,
+	0x72, 0x6c, 0x64, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x16, 0x41, 0x75,
+	0x74, 0x68, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x75, 0x74, 0x68,
-	0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d,
-	0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a,
-	0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
-	0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0d, 0x44, 0x6f, 0x65, 0x73,
-	0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x32, 0xc0, 0x03, 0x0a, 0x0f, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x1a, 0x16, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
-	0x72, 0x69, 0x65, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x44,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
-	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4d, 0x69, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4d, 0x69, 0x6e, 0x73,
-	0x12, 0x37, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x42,
-	0x79, 0x49, 0x44, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
-	0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x0c, 0x45, 0x64, 0x69,
-	0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x11, 0x44, 0x6f, 0x65, 0x73, 0x43,
-	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa4, 0x03, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x32, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x10,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x44, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
-	0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x4d, 0x69, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4d,
-	0x69, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x42, 0x79, 0x49, 0x44, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x0a, 0x45, 0x64, 0x69, 0x74,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x1a, 0x16, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4a, 0x57, 0x4b, 0x53, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x4a, 0x57, 0x4b, 0x53, 0x32, 0x9a, 0x08, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x12, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x12, 0x38, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69,
+	0x72, 0x12, 0x38, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x13, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0d, 0x44, 0x6f, 0x65, 0x73, 0x55, 0x73, 0x65,
+	0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3e, 0x0a, 0x0d, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3b, 0x0a, 0x0f, 0x44, 0x6f, 0x65, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x78,
-	0x69, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
-	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xdc, 0x0a,
-	0x0a, 0x14, 0x42, 0x6f, 0x6f, 0x6b, 0x41, 0x6e, 0x64, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x13, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x42, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x15, 0x49, 0x73, 0x43, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x42, 0x79, 0x42, 0x6f, 0x6f, 0x6b,
-	0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f,
-	0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
-	0x0a, 0x08, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2e, 0x49, 0x44, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a, 0x10, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x4d, 0x69, 0x6e, 0x73, 0x12, 0x36,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65,
-	0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x73, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f,
-	0x6f, 0x6b, 0x4d, 0x69, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f,
-	0x6b, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x45, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x4d, 0x69, 0x6e,
-	0x73, 0x12, 0x2f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x42, 0x79, 0x49, 0x44,
-	0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f,
-	0x6f, 0x6b, 0x12, 0x36, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x12,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f,
-	0x6f, 0x6b, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x13, 0x44, 0x6f, 0x65, 0x73, 0x55, 0x73, 0x65, 0x72,
-	0x53, 0x74, 0x69, 0x6c, 0x6c, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x12, 0x2e, 0x70, 0x72,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x12,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x65, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
+	0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x15, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x12,
+	0x52, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0f, 0x49, 0x73, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3c, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41,
+	0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0xc0, 0x03, 0x0a, 0x0f, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x37, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x44, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4d, 0x69, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4d, 0x69, 0x6e, 0x73, 0x12, 0x37,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x49,
+	0x44, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x0c, 0x45, 0x64, 0x69, 0x74, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x1a,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x11, 0x44, 0x6f, 0x65, 0x73, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xa4, 0x03, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x10, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x44, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a, 0x12,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4d, 0x69,
+	0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73,
+	0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4d, 0x69, 0x6e,
+	0x73, 0x12, 0x33, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x42, 0x79,
+	0x49, 0x44, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x0a, 0x45, 0x64, 0x69, 0x74, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x0f, 0x44, 0x6f, 0x65, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe1, 0x15, 0x0a, 0x14,
+	0x42, 0x6f, 0x6f, 0x6b, 0x41, 0x6e, 0x64, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x13, 0x49, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x49, 0x6e, 0x55, 0x73, 0x65, 0x42, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
-	0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42,
-	0x6f, 0x72, 0x72, 0x6f, 0x77, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
-	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x12, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x47, 0x65, 0x74,
-	0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2e, 0x49, 0x44, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a, 0x1a, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4f, 0x72, 0x52, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x4d, 0x69, 0x6e, 0x73, 0x12, 0x45, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42,
-	0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12,
-	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x73, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x72,
-	0x72, 0x6f, 0x77, 0x4f, 0x72, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4d, 0x69, 0x6e, 0x73, 0x12,
-	0x47, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x15, 0x49, 0x73, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x49, 0x6e, 0x55, 0x73, 0x65, 0x42, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x12,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6b, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x49, 0x44, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x4d, 0x69, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b,
+	0x4d, 0x69, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x73,
+	0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x4d, 0x69, 0x6e, 0x73, 0x12,
+	0x2f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x42, 0x79, 0x49, 0x44, 0x12, 0x12,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b,
+	0x12, 0x36, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
+	0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x13, 0x44, 0x6f, 0x65, 0x73, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74,
+	0x69, 0x6c, 0x6c, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x72,
+	0x72, 0x6f, 0x77, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x72,
+	0x72, 0x6f, 0x77, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x0e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x1a, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x44,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4f, 0x72, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4d, 0x69,
+	0x6e, 0x73, 0x12, 0x45, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69,
+	0x6e, 0x67, 0x73, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4f, 0x72, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x4d, 0x69, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4f, 0x72, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4d, 0x69,
+	0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73,
+	0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x44, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x72, 0x72,
+	0x6f, 0x77, 0x4f, 0x72, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4d, 0x69, 0x6e, 0x73, 0x12, 0x42,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x42, 0x79, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4f, 0x72, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4d, 0x69,
+	0x6e, 0x73, 0x12, 0x44, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73,
 	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4f, 0x72, 0x52, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x4d, 0x69, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
-	0x49, 0x44, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x2e, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4f, 0x72, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x4d, 0x69, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x73, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a, 0x1a, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4f, 0x72, 0x52, 0x65,
-	0x74, 0x75, 0x72, 0x6e, 0x4d, 0x69, 0x6e, 0x73, 0x12, 0x44, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x72, 0x72,
-	0x6f, 0x77, 0x4f, 0x72, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4d, 0x69, 0x6e, 0x73, 0x12, 0x3d,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x73, 0x12, 0x12, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x73, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x72, 0x72, 0x6f,
-	0x77, 0x4f, 0x72, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4d, 0x69, 0x6e, 0x73, 0x12, 0x3a, 0x0a,
-	0x0a, 0x45, 0x64, 0x69, 0x74, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x14, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x72, 0x72, 0x6f,
-	0x77, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x4d, 0x69, 0x6e, 0x73, 0x42, 0x10, 0x5a, 0x0e,
-	0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x75, 0x72, 0x6e, 0x4d, 0x69, 0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4f,
+	0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x4f, 0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4f, 0x72,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4d, 0x69, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x45, 0x64,
+	0x69, 0x74, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x1a, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x42, 0x6f, 0x72, 0x72, 0x6f,
+	0x77, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x52,
+	0x65, 0x6e, 0x65, 0x77, 0x65, 0x64, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x31, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x46, 0x69, 0x6e, 0x65, 0x73, 0x12,
+	0x2c, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x46, 0x69, 0x6e, 0x65, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x46, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x1a,
+	0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x46, 0x69, 0x6e, 0x65, 0x12, 0x2d, 0x0a,
+	0x09, 0x57, 0x61, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x46, 0x69, 0x6e, 0x65, 0x57, 0x61, 0x69, 0x76, 0x65, 0x72, 0x1a, 0x0c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x46, 0x69, 0x6e, 0x65, 0x12, 0x43, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x28, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x1a, 0x0c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2d, 0x0a, 0x09, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x0d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x33, 0x0a, 0x0c, 0x4d, 0x61,
+	0x72, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x73, 0x74, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x36, 0x0a, 0x0f, 0x4d, 0x61, 0x72, 0x6b, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x64, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2e, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x1a, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x37, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x73,
+	0x12, 0x33, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12,
+	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x3d, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x1a, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x48,
+	0x6f, 0x75, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x48, 0x6f, 0x6c, 0x69, 0x64,
+	0x61, 0x79, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x48, 0x6f, 0x6c, 0x69,
+	0x64, 0x61, 0x79, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x48, 0x6f, 0x6c,
+	0x69, 0x64, 0x61, 0x79, 0x12, 0x3b, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x6f,
+	0x6c, 0x69, 0x64, 0x61, 0x79, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3c, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12,
+	0x34, 0x0a, 0x0c, 0x53, 0x68, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12,
+	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0f, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x39,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x4d, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x43,
+	0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x45, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b,
+	0x4d, 0x69, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x48, 0x6f, 0x6c,
+	0x64, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x48, 0x6f, 0x6c, 0x64, 0x12, 0x38, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x48, 0x6f,
+	0x6c, 0x64, 0x12, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
+	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x13, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x73, 0x42,
+	0x10, 0x5a, 0x0e, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2347,139 +5134,260 @@ func file_proto_protos_proto_rawDescGZIP() []byte {
 	return file_proto_protos_proto_rawDescData
 }
 
-var file_proto_protos_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_proto_protos_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
 var file_proto_protos_proto_goTypes = []any{
-	(*StringRequest)(nil),      // 0: protos.StringRequest
-	(*StringResponse)(nil),     // 1: protos.StringResponse
-	(*IntRequest)(nil),         // 2: protos.IntRequest
-	(*IntResponse)(nil),        // 3: protos.IntResponse
-	(*BoolResponse)(nil),       // 4: protos.BoolResponse
-	(*UserSensitive)(nil),      // 5: protos.UserSensitive
-	(*User)(nil),               // 6: protos.User
-	(*UserPassword)(nil),       // 7: protos.UserPassword
-	(*UserIDPassword)(nil),     // 8: protos.UserIDPassword
-	(*NewPassword)(nil),        // 9: protos.NewPassword
-	(*Category)(nil),           // 10: protos.Category
-	(*CategoryMin)(nil),        // 11: protos.CategoryMin
-	(*CategoryMins)(nil),       // 12: protos.CategoryMins
-	(*UpdateCategory)(nil),     // 13: protos.UpdateCategory
-	(*DateLimits)(nil),         // 14: protos.DateLimits
-	(*IDLimits)(nil),           // 15: protos.IDLimits
-	(*Author)(nil),             // 16: protos.Author
-	(*AuthorMin)(nil),          // 17: protos.AuthorMin
-	(*AuthorMins)(nil),         // 18: protos.AuthorMins
-	(*UpdateAuthor)(nil),       // 19: protos.UpdateAuthor
-	(*GetRecommendation)(nil),  // 20: protos.GetRecommendation
-	(*Book)(nil),               // 21: protos.Book
-	(*BookMin)(nil),            // 22: protos.BookMin
-	(*BookMins)(nil),           // 23: protos.BookMins
-	(*UpdateBook)(nil),         // 24: protos.UpdateBook
-	(*Borrow)(nil),             // 25: protos.Borrow
-	(*BorrowOrReturnMin)(nil),  // 26: protos.BorrowOrReturnMin
-	(*BorrowOrReturnMins)(nil), // 27: protos.BorrowOrReturnMins
-	(*UpdateBorrow)(nil),       // 28: protos.UpdateBorrow
-	(*emptypb.Empty)(nil),      // 29: google.protobuf.Empty
+	(*JWK)(nil),                 // 0: protos.JWK
+	(*JWKS)(nil),                // 1: protos.JWKS
+	(*StringRequest)(nil),       // 2: protos.StringRequest
+	(*StringResponse)(nil),      // 3: protos.StringResponse
+	(*IntRequest)(nil),          // 4: protos.IntRequest
+	(*IntResponse)(nil),         // 5: protos.IntResponse
+	(*BoolResponse)(nil),        // 6: protos.BoolResponse
+	(*TokenPair)(nil),           // 7: protos.TokenPair
+	(*APIKeyRequest)(nil),       // 8: protos.APIKeyRequest
+	(*APIKey)(nil),              // 9: protos.APIKey
+	(*APIKeys)(nil),             // 10: protos.APIKeys
+	(*APIKeyCreated)(nil),       // 11: protos.APIKeyCreated
+	(*UserSensitive)(nil),       // 12: protos.UserSensitive
+	(*User)(nil),                // 13: protos.User
+	(*UserPassword)(nil),        // 14: protos.UserPassword
+	(*UserIDPassword)(nil),      // 15: protos.UserIDPassword
+	(*NewPassword)(nil),         // 16: protos.NewPassword
+	(*PasswordReset)(nil),       // 17: protos.PasswordReset
+	(*Category)(nil),            // 18: protos.Category
+	(*CategoryMin)(nil),         // 19: protos.CategoryMin
+	(*CategoryMins)(nil),        // 20: protos.CategoryMins
+	(*UpdateCategory)(nil),      // 21: protos.UpdateCategory
+	(*DateLimits)(nil),          // 22: protos.DateLimits
+	(*OverdueLimits)(nil),       // 23: protos.OverdueLimits
+	(*IDLimits)(nil),            // 24: protos.IDLimits
+	(*Author)(nil),              // 25: protos.Author
+	(*AuthorMin)(nil),           // 26: protos.AuthorMin
+	(*AuthorMins)(nil),          // 27: protos.AuthorMins
+	(*UpdateAuthor)(nil),        // 28: protos.UpdateAuthor
+	(*GetRecommendation)(nil),   // 29: protos.GetRecommendation
+	(*Book)(nil),                // 30: protos.Book
+	(*BookMin)(nil),             // 31: protos.BookMin
+	(*BookMins)(nil),            // 32: protos.BookMins
+	(*UpdateBook)(nil),          // 33: protos.UpdateBook
+	(*HoldRequest)(nil),         // 34: protos.HoldRequest
+	(*Hold)(nil),                // 35: protos.Hold
+	(*Holds)(nil),               // 36: protos.Holds
+	(*Borrow)(nil),              // 37: protos.Borrow
+	(*Return)(nil),              // 38: protos.Return
+	(*BorrowOrReturnMin)(nil),   // 39: protos.BorrowOrReturnMin
+	(*BorrowOrReturnMins)(nil),  // 40: protos.BorrowOrReturnMins
+	(*RenewedBorrow)(nil),       // 41: protos.RenewedBorrow
+	(*Item)(nil),                // 42: protos.Item
+	(*ItemQuery)(nil),           // 43: protos.ItemQuery
+	(*Items)(nil),               // 44: protos.Items
+	(*Notification)(nil),        // 45: protos.Notification
+	(*Notifications)(nil),       // 46: protos.Notifications
+	(*Branch)(nil),              // 47: protos.Branch
+	(*Branches)(nil),            // 48: protos.Branches
+	(*OpeningHours)(nil),        // 49: protos.OpeningHours
+	(*Holiday)(nil),             // 50: protos.Holiday
+	(*Calendar)(nil),            // 51: protos.Calendar
+	(*TransferRequest)(nil),     // 52: protos.TransferRequest
+	(*Transfer)(nil),            // 53: protos.Transfer
+	(*TransferQuery)(nil),       // 54: protos.TransferQuery
+	(*Transfers)(nil),           // 55: protos.Transfers
+	(*CirculationPolicy)(nil),   // 56: protos.CirculationPolicy
+	(*CirculationPolicies)(nil), // 57: protos.CirculationPolicies
+	(*Fine)(nil),                // 58: protos.Fine
+	(*Fines)(nil),               // 59: protos.Fines
+	(*FinePayment)(nil),         // 60: protos.FinePayment
+	(*FineWaiver)(nil),          // 61: protos.FineWaiver
+	(*UpdateBorrow)(nil),        // 62: protos.UpdateBorrow
+	(*emptypb.Empty)(nil),       // 63: google.protobuf.Empty
 }
 var file_proto_protos_proto_depIdxs = []int32{
-	11, // 0: protos.CategoryMins.categories:type_name -> protos.CategoryMin
-	17, // 1: protos.AuthorMins.authors:type_name -> protos.AuthorMin
-	22, // 2: protos.BookMins.books:type_name -> protos.BookMin
-	26, // 3: protos.BorrowOrReturnMins.borrowings:type_name -> protos.BorrowOrReturnMin
-	0,  // 4: protos.UtilService.HelloWorld:input_type -> protos.StringRequest
-	29, // 5: protos.UtilService.Ping:input_type -> google.protobuf.Empty
-	0,  // 6: protos.UtilService.AuthWithoutCredentials:input_type -> protos.StringRequest
-	5,  // 7: protos.UserService.CreateUser:input_type -> protos.UserSensitive
-	7,  // 8: protos.UserService.LoginAuth:input_type -> protos.UserPassword
-	9,  // 9: protos.UserService.ChangePassword:input_type -> protos.NewPassword
-	8,  // 10: protos.UserService.DeleteUser:input_type -> protos.UserIDPassword
-	2,  // 11: protos.UserService.GetUser:input_type -> protos.IntRequest
-	2,  // 12: protos.UserService.DoesUserExist:input_type -> protos.IntRequest
-	10, // 13: protos.CategoryService.CreateCategory:input_type -> protos.Category
-	15, // 14: protos.CategoryService.GetCategories:input_type -> protos.IDLimits
-	0,  // 15: protos.CategoryService.GetCategoriesByName:input_type -> protos.StringRequest
-	2,  // 16: protos.CategoryService.GetCategoryByID:input_type -> protos.IntRequest
-	13, // 17: protos.CategoryService.EditCategory:input_type -> protos.UpdateCategory
-	2,  // 18: protos.CategoryService.DeleteCategory:input_type -> protos.IntRequest
-	2,  // 19: protos.CategoryService.DoesCategoryExist:input_type -> protos.IntRequest
-	16, // 20: protos.AuthorService.CreateAuthor:input_type -> protos.Author
-	15, // 21: protos.AuthorService.GetAuthors:input_type -> protos.IDLimits
-	0,  // 22: protos.AuthorService.GetAuthorsByName:input_type -> protos.StringRequest
-	2,  // 23: protos.AuthorService.GetAuthorByID:input_type -> protos.IntRequest
-	19, // 24: protos.AuthorService.EditAuthor:input_type -> protos.UpdateAuthor
-	2,  // 25: protos.AuthorService.DeleteAuthor:input_type -> protos.IntRequest
-	2,  // 26: protos.AuthorService.DoesAuthorExist:input_type -> protos.IntRequest
-	2,  // 27: protos.BookAndBorrowService.IsAuthorInUseByBook:input_type -> protos.IntRequest
-	2,  // 28: protos.BookAndBorrowService.IsCategoryInUseByBook:input_type -> protos.IntRequest
-	21, // 29: protos.BookAndBorrowService.CreateBook:input_type -> protos.Book
-	15, // 30: protos.BookAndBorrowService.GetBooks:input_type -> protos.IDLimits
-	14, // 31: protos.BookAndBorrowService.GetBooksByDate:input_type -> protos.DateLimits
-	0,  // 32: protos.BookAndBorrowService.GetBooksByName:input_type -> protos.StringRequest
-	2,  // 33: protos.BookAndBorrowService.GetBookByID:input_type -> protos.IntRequest
-	24, // 34: protos.BookAndBorrowService.EditBook:input_type -> protos.UpdateBook
-	2,  // 35: protos.BookAndBorrowService.DeleteBook:input_type -> protos.IntRequest
-	2,  // 36: protos.BookAndBorrowService.DoesUserStillBorrow:input_type -> protos.IntRequest
-	25, // 37: protos.BookAndBorrowService.CreateBorrow:input_type -> protos.Borrow
-	2,  // 38: protos.BookAndBorrowService.CreateReturn:input_type -> protos.IntRequest
-	15, // 39: protos.BookAndBorrowService.GetBorrowings:input_type -> protos.IDLimits
-	14, // 40: protos.BookAndBorrowService.GetBorrowingsByDate:input_type -> protos.DateLimits
-	2,  // 41: protos.BookAndBorrowService.GetBorrowingsByUserID:input_type -> protos.IntRequest
-	15, // 42: protos.BookAndBorrowService.GetReturns:input_type -> protos.IDLimits
-	14, // 43: protos.BookAndBorrowService.GetReturnsByDate:input_type -> protos.DateLimits
-	2,  // 44: protos.BookAndBorrowService.GetReturnsByUserID:input_type -> protos.IntRequest
-	14, // 45: protos.BookAndBorrowService.GetOverdues:input_type -> protos.DateLimits
-	28, // 46: protos.BookAndBorrowService.EditBorrow:input_type -> protos.UpdateBorrow
-	2,  // 47: protos.BookAndBorrowService.DeleteBorrow:input_type -> protos.IntRequest
-	20, // 48: protos.BookAndBorrowService.GetBookRecommendations:input_type -> protos.GetRecommendation
-	1,  // 49: protos.UtilService.HelloWorld:output_type -> protos.StringResponse
-	1,  // 50: protos.UtilService.Ping:output_type -> protos.StringResponse
-	1,  // 51: protos.UtilService.AuthWithoutCredentials:output_type -> protos.StringResponse
-	1,  // 52: protos.UserService.CreateUser:output_type -> protos.StringResponse
-	1,  // 53: protos.UserService.LoginAuth:output_type -> protos.StringResponse
-	1,  // 54: protos.UserService.ChangePassword:output_type -> protos.StringResponse
-	1,  // 55: protos.UserService.DeleteUser:output_type -> protos.StringResponse
-	6,  // 56: protos.UserService.GetUser:output_type -> protos.User
-	4,  // 57: protos.UserService.DoesUserExist:output_type -> protos.BoolResponse
-	1,  // 58: protos.CategoryService.CreateCategory:output_type -> protos.StringResponse
-	12, // 59: protos.CategoryService.GetCategories:output_type -> protos.CategoryMins
-	12, // 60: protos.CategoryService.GetCategoriesByName:output_type -> protos.CategoryMins
-	10, // 61: protos.CategoryService.GetCategoryByID:output_type -> protos.Category
-	1,  // 62: protos.CategoryService.EditCategory:output_type -> protos.StringResponse
-	1,  // 63: protos.CategoryService.DeleteCategory:output_type -> protos.StringResponse
-	4,  // 64: protos.CategoryService.DoesCategoryExist:output_type -> protos.BoolResponse
-	1,  // 65: protos.AuthorService.CreateAuthor:output_type -> protos.StringResponse
-	18, // 66: protos.AuthorService.GetAuthors:output_type -> protos.AuthorMins
-	18, // 67: protos.AuthorService.GetAuthorsByName:output_type -> protos.AuthorMins
-	16, // 68: protos.AuthorService.GetAuthorByID:output_type -> protos.Author
-	1,  // 69: protos.AuthorService.EditAuthor:output_type -> protos.StringResponse
-	1,  // 70: protos.AuthorService.DeleteAuthor:output_type -> protos.StringResponse
-	4,  // 71: protos.AuthorService.DoesAuthorExist:output_type -> protos.BoolResponse
-	4,  // 72: protos.BookAndBorrowService.IsAuthorInUseByBook:output_type -> protos.BoolResponse
-	4,  // 73: protos.BookAndBorrowService.IsCategoryInUseByBook:output_type -> protos.BoolResponse
-	1,  // 74: protos.BookAndBorrowService.CreateBook:output_type -> protos.StringResponse
-	23, // 75: protos.BookAndBorrowService.GetBooks:output_type -> protos.BookMins
-	23, // 76: protos.BookAndBorrowService.GetBooksByDate:output_type -> protos.BookMins
-	23, // 77: protos.BookAndBorrowService.GetBooksByName:output_type -> protos.BookMins
-	21, // 78: protos.BookAndBorrowService.GetBookByID:output_type -> protos.Book
-	1,  // 79: protos.BookAndBorrowService.EditBook:output_type -> protos.StringResponse
-	1,  // 80: protos.BookAndBorrowService.DeleteBook:output_type -> protos.StringResponse
-	4,  // 81: protos.BookAndBorrowService.DoesUserStillBorrow:output_type -> protos.BoolResponse
-	1,  // 82: protos.BookAndBorrowService.CreateBorrow:output_type -> protos.StringResponse
-	1,  // 83: protos.BookAndBorrowService.CreateReturn:output_type -> protos.StringResponse
-	27, // 84: protos.BookAndBorrowService.GetBorrowings:output_type -> protos.BorrowOrReturnMins
-	27, // 85: protos.BookAndBorrowService.GetBorrowingsByDate:output_type -> protos.BorrowOrReturnMins
-	27, // 86: protos.BookAndBorrowService.GetBorrowingsByUserID:output_type -> protos.BorrowOrReturnMins
-	27, // 87: protos.BookAndBorrowService.GetReturns:output_type -> protos.BorrowOrReturnMins
-	27, // 88: protos.BookAndBorrowService.GetReturnsByDate:output_type -> protos.BorrowOrReturnMins
-	27, // 89: protos.BookAndBorrowService.GetReturnsByUserID:output_type -> protos.BorrowOrReturnMins
-	27, // 90: protos.BookAndBorrowService.GetOverdues:output_type -> protos.BorrowOrReturnMins
-	1,  // 91: protos.BookAndBorrowService.EditBorrow:output_type -> protos.StringResponse
-	1,  // 92: protos.BookAndBorrowService.DeleteBorrow:output_type -> protos.StringResponse
-	23, // 93: protos.BookAndBorrowService.GetBookRecommendations:output_type -> protos.BookMins
-	49, // [49:94] is the sub-list for method output_type
-	4,  // [4:49] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	0,  // 0: protos.JWKS.keys:type_name -> protos.JWK
+	9,  // 1: protos.APIKeys.keys:type_name -> protos.APIKey
+	9,  // 2: protos.APIKeyCreated.key:type_name -> protos.APIKey
+	19, // 3: protos.CategoryMins.categories:type_name -> protos.CategoryMin
+	26, // 4: protos.AuthorMins.authors:type_name -> protos.AuthorMin
+	31, // 5: protos.BookMins.books:type_name -> protos.BookMin
+	35, // 6: protos.Holds.holds:type_name -> protos.Hold
+	39, // 7: protos.BorrowOrReturnMins.borrowings:type_name -> protos.BorrowOrReturnMin
+	42, // 8: protos.Items.items:type_name -> protos.Item
+	45, // 9: protos.Notifications.notifications:type_name -> protos.Notification
+	47, // 10: protos.Branches.branches:type_name -> protos.Branch
+	49, // 11: protos.Calendar.hours:type_name -> protos.OpeningHours
+	50, // 12: protos.Calendar.holidays:type_name -> protos.Holiday
+	53, // 13: protos.Transfers.transfers:type_name -> protos.Transfer
+	56, // 14: protos.CirculationPolicies.policies:type_name -> protos.CirculationPolicy
+	56, // 15: protos.CirculationPolicies.default:type_name -> protos.CirculationPolicy
+	58, // 16: protos.Fines.fines:type_name -> protos.Fine
+	2,  // 17: protos.UtilService.HelloWorld:input_type -> protos.StringRequest
+	63, // 18: protos.UtilService.Ping:input_type -> google.protobuf.Empty
+	2,  // 19: protos.UtilService.AuthWithoutCredentials:input_type -> protos.StringRequest
+	63, // 20: protos.UtilService.GetJWKS:input_type -> google.protobuf.Empty
+	12, // 21: protos.UserService.CreateUser:input_type -> protos.UserSensitive
+	14, // 22: protos.UserService.LoginAuth:input_type -> protos.UserPassword
+	2,  // 23: protos.UserService.RefreshToken:input_type -> protos.StringRequest
+	63, // 24: protos.UserService.Logout:input_type -> google.protobuf.Empty
+	16, // 25: protos.UserService.ChangePassword:input_type -> protos.NewPassword
+	15, // 26: protos.UserService.DeleteUser:input_type -> protos.UserIDPassword
+	4,  // 27: protos.UserService.GetUser:input_type -> protos.IntRequest
+	4,  // 28: protos.UserService.DoesUserExist:input_type -> protos.IntRequest
+	2,  // 29: protos.UserService.UnlockAccount:input_type -> protos.StringRequest
+	2,  // 30: protos.UserService.RequestPasswordReset:input_type -> protos.StringRequest
+	17, // 31: protos.UserService.ConfirmPasswordReset:input_type -> protos.PasswordReset
+	2,  // 32: protos.UserService.VerifyEmail:input_type -> protos.StringRequest
+	63, // 33: protos.UserService.ResendVerification:input_type -> google.protobuf.Empty
+	4,  // 34: protos.UserService.IsEmailVerified:input_type -> protos.IntRequest
+	8,  // 35: protos.UserService.CreateAPIKey:input_type -> protos.APIKeyRequest
+	63, // 36: protos.UserService.ListAPIKeys:input_type -> google.protobuf.Empty
+	4,  // 37: protos.UserService.RevokeAPIKey:input_type -> protos.IntRequest
+	18, // 38: protos.CategoryService.CreateCategory:input_type -> protos.Category
+	24, // 39: protos.CategoryService.GetCategories:input_type -> protos.IDLimits
+	2,  // 40: protos.CategoryService.GetCategoriesByName:input_type -> protos.StringRequest
+	4,  // 41: protos.CategoryService.GetCategoryByID:input_type -> protos.IntRequest
+	21, // 42: protos.CategoryService.EditCategory:input_type -> protos.UpdateCategory
+	4,  // 43: protos.CategoryService.DeleteCategory:input_type -> protos.IntRequest
+	4,  // 44: protos.CategoryService.DoesCategoryExist:input_type -> protos.IntRequest
+	25, // 45: protos.AuthorService.CreateAuthor:input_type -> protos.Author
+	24, // 46: protos.AuthorService.GetAuthors:input_type -> protos.IDLimits
+	2,  // 47: protos.AuthorService.GetAuthorsByName:input_type -> protos.StringRequest
+	4,  // 48: protos.AuthorService.GetAuthorByID:input_type -> protos.IntRequest
+	28, // 49: protos.AuthorService.EditAuthor:input_type -> protos.UpdateAuthor
+	4,  // 50: protos.AuthorService.DeleteAuthor:input_type -> protos.IntRequest
+	4,  // 51: protos.AuthorService.DoesAuthorExist:input_type -> protos.IntRequest
+	4,  // 52: protos.BookAndBorrowService.IsAuthorInUseByBook:input_type -> protos.IntRequest
+	4,  // 53: protos.BookAndBorrowService.IsCategoryInUseByBook:input_type -> protos.IntRequest
+	30, // 54: protos.BookAndBorrowService.CreateBook:input_type -> protos.Book
+	24, // 55: protos.BookAndBorrowService.GetBooks:input_type -> protos.IDLimits
+	22, // 56: protos.BookAndBorrowService.GetBooksByDate:input_type -> protos.DateLimits
+	2,  // 57: protos.BookAndBorrowService.GetBooksByName:input_type -> protos.StringRequest
+	4,  // 58: protos.BookAndBorrowService.GetBookByID:input_type -> protos.IntRequest
+	33, // 59: protos.BookAndBorrowService.EditBook:input_type -> protos.UpdateBook
+	4,  // 60: protos.BookAndBorrowService.DeleteBook:input_type -> protos.IntRequest
+	4,  // 61: protos.BookAndBorrowService.DoesUserStillBorrow:input_type -> protos.IntRequest
+	37, // 62: protos.BookAndBorrowService.CreateBorrow:input_type -> protos.Borrow
+	38, // 63: protos.BookAndBorrowService.CreateReturn:input_type -> protos.Return
+	24, // 64: protos.BookAndBorrowService.GetBorrowings:input_type -> protos.IDLimits
+	22, // 65: protos.BookAndBorrowService.GetBorrowingsByDate:input_type -> protos.DateLimits
+	4,  // 66: protos.BookAndBorrowService.GetBorrowingsByUserID:input_type -> protos.IntRequest
+	24, // 67: protos.BookAndBorrowService.GetReturns:input_type -> protos.IDLimits
+	22, // 68: protos.BookAndBorrowService.GetReturnsByDate:input_type -> protos.DateLimits
+	4,  // 69: protos.BookAndBorrowService.GetReturnsByUserID:input_type -> protos.IntRequest
+	23, // 70: protos.BookAndBorrowService.GetOverdues:input_type -> protos.OverdueLimits
+	62, // 71: protos.BookAndBorrowService.EditBorrow:input_type -> protos.UpdateBorrow
+	4,  // 72: protos.BookAndBorrowService.DeleteBorrow:input_type -> protos.IntRequest
+	4,  // 73: protos.BookAndBorrowService.RenewBorrow:input_type -> protos.IntRequest
+	4,  // 74: protos.BookAndBorrowService.GetUserFines:input_type -> protos.IntRequest
+	60, // 75: protos.BookAndBorrowService.PayFine:input_type -> protos.FinePayment
+	61, // 76: protos.BookAndBorrowService.WaiveFine:input_type -> protos.FineWaiver
+	4,  // 77: protos.BookAndBorrowService.GetNotificationHistory:input_type -> protos.IntRequest
+	42, // 78: protos.BookAndBorrowService.CreateItem:input_type -> protos.Item
+	43, // 79: protos.BookAndBorrowService.ListItems:input_type -> protos.ItemQuery
+	2,  // 80: protos.BookAndBorrowService.MarkItemLost:input_type -> protos.StringRequest
+	2,  // 81: protos.BookAndBorrowService.MarkItemDamaged:input_type -> protos.StringRequest
+	47, // 82: protos.BookAndBorrowService.CreateBranch:input_type -> protos.Branch
+	63, // 83: protos.BookAndBorrowService.GetBranches:input_type -> google.protobuf.Empty
+	4,  // 84: protos.BookAndBorrowService.GetCalendar:input_type -> protos.IntRequest
+	49, // 85: protos.BookAndBorrowService.SetOpeningHours:input_type -> protos.OpeningHours
+	50, // 86: protos.BookAndBorrowService.AddHoliday:input_type -> protos.Holiday
+	4,  // 87: protos.BookAndBorrowService.DeleteHoliday:input_type -> protos.IntRequest
+	52, // 88: protos.BookAndBorrowService.RequestTransfer:input_type -> protos.TransferRequest
+	4,  // 89: protos.BookAndBorrowService.ShipTransfer:input_type -> protos.IntRequest
+	4,  // 90: protos.BookAndBorrowService.ReceiveTransfer:input_type -> protos.IntRequest
+	54, // 91: protos.BookAndBorrowService.ListTransfers:input_type -> protos.TransferQuery
+	63, // 92: protos.BookAndBorrowService.GetCirculationPolicies:input_type -> google.protobuf.Empty
+	56, // 93: protos.BookAndBorrowService.SetCirculationPolicy:input_type -> protos.CirculationPolicy
+	4,  // 94: protos.BookAndBorrowService.DeleteCirculationPolicy:input_type -> protos.IntRequest
+	29, // 95: protos.BookAndBorrowService.GetBookRecommendations:input_type -> protos.GetRecommendation
+	34, // 96: protos.BookAndBorrowService.PlaceHold:input_type -> protos.HoldRequest
+	4,  // 97: protos.BookAndBorrowService.CancelHold:input_type -> protos.IntRequest
+	34, // 98: protos.BookAndBorrowService.ListHolds:input_type -> protos.HoldRequest
+	3,  // 99: protos.UtilService.HelloWorld:output_type -> protos.StringResponse
+	3,  // 100: protos.UtilService.Ping:output_type -> protos.StringResponse
+	3,  // 101: protos.UtilService.AuthWithoutCredentials:output_type -> protos.StringResponse
+	1,  // 102: protos.UtilService.GetJWKS:output_type -> protos.JWKS
+	3,  // 103: protos.UserService.CreateUser:output_type -> protos.StringResponse
+	7,  // 104: protos.UserService.LoginAuth:output_type -> protos.TokenPair
+	7,  // 105: protos.UserService.RefreshToken:output_type -> protos.TokenPair
+	3,  // 106: protos.UserService.Logout:output_type -> protos.StringResponse
+	3,  // 107: protos.UserService.ChangePassword:output_type -> protos.StringResponse
+	3,  // 108: protos.UserService.DeleteUser:output_type -> protos.StringResponse
+	13, // 109: protos.UserService.GetUser:output_type -> protos.User
+	6,  // 110: protos.UserService.DoesUserExist:output_type -> protos.BoolResponse
+	3,  // 111: protos.UserService.UnlockAccount:output_type -> protos.StringResponse
+	3,  // 112: protos.UserService.RequestPasswordReset:output_type -> protos.StringResponse
+	3,  // 113: protos.UserService.ConfirmPasswordReset:output_type -> protos.StringResponse
+	3,  // 114: protos.UserService.VerifyEmail:output_type -> protos.StringResponse
+	3,  // 115: protos.UserService.ResendVerification:output_type -> protos.StringResponse
+	6,  // 116: protos.UserService.IsEmailVerified:output_type -> protos.BoolResponse
+	11, // 117: protos.UserService.CreateAPIKey:output_type -> protos.APIKeyCreated
+	10, // 118: protos.UserService.ListAPIKeys:output_type -> protos.APIKeys
+	3,  // 119: protos.UserService.RevokeAPIKey:output_type -> protos.StringResponse
+	3,  // 120: protos.CategoryService.CreateCategory:output_type -> protos.StringResponse
+	20, // 121: protos.CategoryService.GetCategories:output_type -> protos.CategoryMins
+	20, // 122: protos.CategoryService.GetCategoriesByName:output_type -> protos.CategoryMins
+	18, // 123: protos.CategoryService.GetCategoryByID:output_type -> protos.Category
+	3,  // 124: protos.CategoryService.EditCategory:output_type -> protos.StringResponse
+	3,  // 125: protos.CategoryService.DeleteCategory:output_type -> protos.StringResponse
+	6,  // 126: protos.CategoryService.DoesCategoryExist:output_type -> protos.BoolResponse
+	3,  // 127: protos.AuthorService.CreateAuthor:output_type -> protos.StringResponse
+	27, // 128: protos.AuthorService.GetAuthors:output_type -> protos.AuthorMins
+	27, // 129: protos.AuthorService.GetAuthorsByName:output_type -> protos.AuthorMins
+	25, // 130: protos.AuthorService.GetAuthorByID:output_type -> protos.Author
+	3,  // 131: protos.AuthorService.EditAuthor:output_type -> protos.StringResponse
+	3,  // 132: protos.AuthorService.DeleteAuthor:output_type -> protos.StringResponse
+	6,  // 133: protos.AuthorService.DoesAuthorExist:output_type -> protos.BoolResponse
+	6,  // 134: protos.BookAndBorrowService.IsAuthorInUseByBook:output_type -> protos.BoolResponse
+	6,  // 135: protos.BookAndBorrowService.IsCategoryInUseByBook:output_type -> protos.BoolResponse
+	3,  // 136: protos.BookAndBorrowService.CreateBook:output_type -> protos.StringResponse
+	32, // 137: protos.BookAndBorrowService.GetBooks:output_type -> protos.BookMins
+	32, // 138: protos.BookAndBorrowService.GetBooksByDate:output_type -> protos.BookMins
+	32, // 139: protos.BookAndBorrowService.GetBooksByName:output_type -> protos.BookMins
+	30, // 140: protos.BookAndBorrowService.GetBookByID:output_type -> protos.Book
+	3,  // 141: protos.BookAndBorrowService.EditBook:output_type -> protos.StringResponse
+	3,  // 142: protos.BookAndBorrowService.DeleteBook:output_type -> protos.StringResponse
+	6,  // 143: protos.BookAndBorrowService.DoesUserStillBorrow:output_type -> protos.BoolResponse
+	3,  // 144: protos.BookAndBorrowService.CreateBorrow:output_type -> protos.StringResponse
+	3,  // 145: protos.BookAndBorrowService.CreateReturn:output_type -> protos.StringResponse
+	40, // 146: protos.BookAndBorrowService.GetBorrowings:output_type -> protos.BorrowOrReturnMins
+	40, // 147: protos.BookAndBorrowService.GetBorrowingsByDate:output_type -> protos.BorrowOrReturnMins
+	40, // 148: protos.BookAndBorrowService.GetBorrowingsByUserID:output_type -> protos.BorrowOrReturnMins
+	40, // 149: protos.BookAndBorrowService.GetReturns:output_type -> protos.BorrowOrReturnMins
+	40, // 150: protos.BookAndBorrowService.GetReturnsByDate:output_type -> protos.BorrowOrReturnMins
+	40, // 151: protos.BookAndBorrowService.GetReturnsByUserID:output_type -> protos.BorrowOrReturnMins
+	40, // 152: protos.BookAndBorrowService.GetOverdues:output_type -> protos.BorrowOrReturnMins
+	3,  // 153: protos.BookAndBorrowService.EditBorrow:output_type -> protos.StringResponse
+	3,  // 154: protos.BookAndBorrowService.DeleteBorrow:output_type -> protos.StringResponse
+	41, // 155: protos.BookAndBorrowService.RenewBorrow:output_type -> protos.RenewedBorrow
+	59, // 156: protos.BookAndBorrowService.GetUserFines:output_type -> protos.Fines
+	58, // 157: protos.BookAndBorrowService.PayFine:output_type -> protos.Fine
+	58, // 158: protos.BookAndBorrowService.WaiveFine:output_type -> protos.Fine
+	46, // 159: protos.BookAndBorrowService.GetNotificationHistory:output_type -> protos.Notifications
+	42, // 160: protos.BookAndBorrowService.CreateItem:output_type -> protos.Item
+	44, // 161: protos.BookAndBorrowService.ListItems:output_type -> protos.Items
+	42, // 162: protos.BookAndBorrowService.MarkItemLost:output_type -> protos.Item
+	42, // 163: protos.BookAndBorrowService.MarkItemDamaged:output_type -> protos.Item
+	47, // 164: protos.BookAndBorrowService.CreateBranch:output_type -> protos.Branch
+	48, // 165: protos.BookAndBorrowService.GetBranches:output_type -> protos.Branches
+	51, // 166: protos.BookAndBorrowService.GetCalendar:output_type -> protos.Calendar
+	49, // 167: protos.BookAndBorrowService.SetOpeningHours:output_type -> protos.OpeningHours
+	50, // 168: protos.BookAndBorrowService.AddHoliday:output_type -> protos.Holiday
+	3,  // 169: protos.BookAndBorrowService.DeleteHoliday:output_type -> protos.StringResponse
+	53, // 170: protos.BookAndBorrowService.RequestTransfer:output_type -> protos.Transfer
+	53, // 171: protos.BookAndBorrowService.ShipTransfer:output_type -> protos.Transfer
+	53, // 172: protos.BookAndBorrowService.ReceiveTransfer:output_type -> protos.Transfer
+	55, // 173: protos.BookAndBorrowService.ListTransfers:output_type -> protos.Transfers
+	57, // 174: protos.BookAndBorrowService.GetCirculationPolicies:output_type -> protos.CirculationPolicies
+	56, // 175: protos.BookAndBorrowService.SetCirculationPolicy:output_type -> protos.CirculationPolicy
+	3,  // 176: protos.BookAndBorrowService.DeleteCirculationPolicy:output_type -> protos.StringResponse
+	32, // 177: protos.BookAndBorrowService.GetBookRecommendations:output_type -> protos.BookMins
+	35, // 178: protos.BookAndBorrowService.PlaceHold:output_type -> protos.Hold
+	3,  // 179: protos.BookAndBorrowService.CancelHold:output_type -> protos.StringResponse
+	36, // 180: protos.BookAndBorrowService.ListHolds:output_type -> protos.Holds
+	99, // [99:181] is the sub-list for method output_type
+	17, // [17:99] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_proto_protos_proto_init() }
@@ -2493,7 +5401,7 @@ func file_proto_protos_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_protos_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   29,
+			NumMessages:   63,
 			NumExtensions: 0,
 			NumServices:   5,
 		},
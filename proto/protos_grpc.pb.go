@@ -23,6 +23,7 @@ const (
 	UtilService_HelloWorld_FullMethodName             = "/protos.UtilService/HelloWorld"
 	UtilService_Ping_FullMethodName                   = "/protos.UtilService/Ping"
 	UtilService_AuthWithoutCredentials_FullMethodName = "/protos.UtilService/AuthWithoutCredentials"
+	UtilService_GetJWKS_FullMethodName                = "/protos.UtilService/GetJWKS"
 )
 
 // UtilServiceClient is the client API for UtilService service.
@@ -32,6 +33,7 @@ type UtilServiceClient interface {
 	HelloWorld(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*StringResponse, error)
 	Ping(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StringResponse, error)
 	AuthWithoutCredentials(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*StringResponse, error)
+	GetJWKS(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*JWKS, error)
 }
 
 type utilServiceClient struct {
@@ -72,6 +74,16 @@ func (c *utilServiceClient) AuthWithoutCredentials(ctx context.Context, in *Stri
 	return out, nil
 }
 
+func (c *utilServiceClient) GetJWKS(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*JWKS, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JWKS)
+	err := c.cc.Invoke(ctx, UtilService_GetJWKS_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UtilServiceServer is the server API for UtilService service.
 // All implementations must embed UnimplementedUtilServiceServer
 // for forward compatibility.
@@ -79,6 +91,7 @@ type UtilServiceServer interface {
 	HelloWorld(context.Context, *StringRequest) (*StringResponse, error)
 	Ping(context.Context, *emptypb.Empty) (*StringResponse, error)
 	AuthWithoutCredentials(context.Context, *StringRequest) (*StringResponse, error)
+	GetJWKS(context.Context, *emptypb.Empty) (*JWKS, error)
 	mustEmbedUnimplementedUtilServiceServer()
 }
 
@@ -98,6 +111,9 @@ func (UnimplementedUtilServiceServer) Ping(context.Context, *emptypb.Empty) (*St
 func (UnimplementedUtilServiceServer) AuthWithoutCredentials(context.Context, *StringRequest) (*StringResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthWithoutCredentials not implemented")
 }
+func (UnimplementedUtilServiceServer) GetJWKS(context.Context, *emptypb.Empty) (*JWKS, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJWKS not implemented")
+}
 func (UnimplementedUtilServiceServer) mustEmbedUnimplementedUtilServiceServer() {}
 func (UnimplementedUtilServiceServer) testEmbeddedByValue()                     {}
 
@@ -173,6 +189,24 @@ func _UtilService_AuthWithoutCredentials_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UtilService_GetJWKS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UtilServiceServer).GetJWKS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UtilService_GetJWKS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UtilServiceServer).GetJWKS(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UtilService_ServiceDesc is the grpc.ServiceDesc for UtilService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -192,18 +226,33 @@ var UtilService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuthWithoutCredentials",
 			Handler:    _UtilService_AuthWithoutCredentials_Handler,
 		},
+		{
+			MethodName: "GetJWKS",
+			Handler:    _UtilService_GetJWKS_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/protos.proto",
 }
 
 const (
-	UserService_CreateUser_FullMethodName     = "/protos.UserService/CreateUser"
-	UserService_LoginAuth_FullMethodName      = "/protos.UserService/LoginAuth"
-	UserService_ChangePassword_FullMethodName = "/protos.UserService/ChangePassword"
-	UserService_DeleteUser_FullMethodName     = "/protos.UserService/DeleteUser"
-	UserService_GetUser_FullMethodName        = "/protos.UserService/GetUser"
-	UserService_DoesUserExist_FullMethodName  = "/protos.UserService/DoesUserExist"
+	UserService_CreateUser_FullMethodName           = "/protos.UserService/CreateUser"
+	UserService_LoginAuth_FullMethodName            = "/protos.UserService/LoginAuth"
+	UserService_RefreshToken_FullMethodName         = "/protos.UserService/RefreshToken"
+	UserService_Logout_FullMethodName               = "/protos.UserService/Logout"
+	UserService_ChangePassword_FullMethodName       = "/protos.UserService/ChangePassword"
+	UserService_DeleteUser_FullMethodName           = "/protos.UserService/DeleteUser"
+	UserService_GetUser_FullMethodName              = "/protos.UserService/GetUser"
+	UserService_DoesUserExist_FullMethodName        = "/protos.UserService/DoesUserExist"
+	UserService_UnlockAccount_FullMethodName        = "/protos.UserService/UnlockAccount"
+	UserService_RequestPasswordReset_FullMethodName = "/protos.UserService/RequestPasswordReset"
+	UserService_ConfirmPasswordReset_FullMethodName = "/protos.UserService/ConfirmPasswordReset"
+	UserService_VerifyEmail_FullMethodName          = "/protos.UserService/VerifyEmail"
+	UserService_ResendVerification_FullMethodName   = "/protos.UserService/ResendVerification"
+	UserService_IsEmailVerified_FullMethodName      = "/protos.UserService/IsEmailVerified"
+	UserService_CreateAPIKey_FullMethodName         = "/protos.UserService/CreateAPIKey"
+	UserService_ListAPIKeys_FullMethodName          = "/protos.UserService/ListAPIKeys"
+	UserService_RevokeAPIKey_FullMethodName         = "/protos.UserService/RevokeAPIKey"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -211,11 +260,22 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UserServiceClient interface {
 	CreateUser(ctx context.Context, in *UserSensitive, opts ...grpc.CallOption) (*StringResponse, error)
-	LoginAuth(ctx context.Context, in *UserPassword, opts ...grpc.CallOption) (*StringResponse, error)
+	LoginAuth(ctx context.Context, in *UserPassword, opts ...grpc.CallOption) (*TokenPair, error)
+	RefreshToken(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*TokenPair, error)
+	Logout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StringResponse, error)
 	ChangePassword(ctx context.Context, in *NewPassword, opts ...grpc.CallOption) (*StringResponse, error)
 	DeleteUser(ctx context.Context, in *UserIDPassword, opts ...grpc.CallOption) (*StringResponse, error)
 	GetUser(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*User, error)
 	DoesUserExist(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	UnlockAccount(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*StringResponse, error)
+	RequestPasswordReset(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*StringResponse, error)
+	ConfirmPasswordReset(ctx context.Context, in *PasswordReset, opts ...grpc.CallOption) (*StringResponse, error)
+	VerifyEmail(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*StringResponse, error)
+	ResendVerification(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StringResponse, error)
+	IsEmailVerified(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	CreateAPIKey(ctx context.Context, in *APIKeyRequest, opts ...grpc.CallOption) (*APIKeyCreated, error)
+	ListAPIKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*APIKeys, error)
+	RevokeAPIKey(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*StringResponse, error)
 }
 
 type userServiceClient struct {
@@ -236,9 +296,9 @@ func (c *userServiceClient) CreateUser(ctx context.Context, in *UserSensitive, o
 	return out, nil
 }
 
-func (c *userServiceClient) LoginAuth(ctx context.Context, in *UserPassword, opts ...grpc.CallOption) (*StringResponse, error) {
+func (c *userServiceClient) LoginAuth(ctx context.Context, in *UserPassword, opts ...grpc.CallOption) (*TokenPair, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(StringResponse)
+	out := new(TokenPair)
 	err := c.cc.Invoke(ctx, UserService_LoginAuth_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -246,6 +306,26 @@ func (c *userServiceClient) LoginAuth(ctx context.Context, in *UserPassword, opt
 	return out, nil
 }
 
+func (c *userServiceClient) RefreshToken(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*TokenPair, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenPair)
+	err := c.cc.Invoke(ctx, UserService_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Logout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringResponse)
+	err := c.cc.Invoke(ctx, UserService_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ChangePassword(ctx context.Context, in *NewPassword, opts ...grpc.CallOption) (*StringResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StringResponse)
@@ -286,16 +366,117 @@ func (c *userServiceClient) DoesUserExist(ctx context.Context, in *IntRequest, o
 	return out, nil
 }
 
+func (c *userServiceClient) UnlockAccount(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*StringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringResponse)
+	err := c.cc.Invoke(ctx, UserService_UnlockAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RequestPasswordReset(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*StringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringResponse)
+	err := c.cc.Invoke(ctx, UserService_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ConfirmPasswordReset(ctx context.Context, in *PasswordReset, opts ...grpc.CallOption) (*StringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringResponse)
+	err := c.cc.Invoke(ctx, UserService_ConfirmPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) VerifyEmail(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*StringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringResponse)
+	err := c.cc.Invoke(ctx, UserService_VerifyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ResendVerification(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringResponse)
+	err := c.cc.Invoke(ctx, UserService_ResendVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) IsEmailVerified(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, UserService_IsEmailVerified_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateAPIKey(ctx context.Context, in *APIKeyRequest, opts ...grpc.CallOption) (*APIKeyCreated, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APIKeyCreated)
+	err := c.cc.Invoke(ctx, UserService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListAPIKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*APIKeys, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APIKeys)
+	err := c.cc.Invoke(ctx, UserService_ListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeAPIKey(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*StringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
 type UserServiceServer interface {
 	CreateUser(context.Context, *UserSensitive) (*StringResponse, error)
-	LoginAuth(context.Context, *UserPassword) (*StringResponse, error)
+	LoginAuth(context.Context, *UserPassword) (*TokenPair, error)
+	RefreshToken(context.Context, *StringRequest) (*TokenPair, error)
+	Logout(context.Context, *emptypb.Empty) (*StringResponse, error)
 	ChangePassword(context.Context, *NewPassword) (*StringResponse, error)
 	DeleteUser(context.Context, *UserIDPassword) (*StringResponse, error)
 	GetUser(context.Context, *IntRequest) (*User, error)
 	DoesUserExist(context.Context, *IntRequest) (*BoolResponse, error)
+	UnlockAccount(context.Context, *StringRequest) (*StringResponse, error)
+	RequestPasswordReset(context.Context, *StringRequest) (*StringResponse, error)
+	ConfirmPasswordReset(context.Context, *PasswordReset) (*StringResponse, error)
+	VerifyEmail(context.Context, *StringRequest) (*StringResponse, error)
+	ResendVerification(context.Context, *emptypb.Empty) (*StringResponse, error)
+	IsEmailVerified(context.Context, *IntRequest) (*BoolResponse, error)
+	CreateAPIKey(context.Context, *APIKeyRequest) (*APIKeyCreated, error)
+	ListAPIKeys(context.Context, *emptypb.Empty) (*APIKeys, error)
+	RevokeAPIKey(context.Context, *IntRequest) (*StringResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -309,9 +490,15 @@ type UnimplementedUserServiceServer struct{}
 func (UnimplementedUserServiceServer) CreateUser(context.Context, *UserSensitive) (*StringResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
 }
-func (UnimplementedUserServiceServer) LoginAuth(context.Context, *UserPassword) (*StringResponse, error) {
+func (UnimplementedUserServiceServer) LoginAuth(context.Context, *UserPassword) (*TokenPair, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoginAuth not implemented")
 }
+func (UnimplementedUserServiceServer) RefreshToken(context.Context, *StringRequest) (*TokenPair, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedUserServiceServer) Logout(context.Context, *emptypb.Empty) (*StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
 func (UnimplementedUserServiceServer) ChangePassword(context.Context, *NewPassword) (*StringResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
 }
@@ -324,6 +511,33 @@ func (UnimplementedUserServiceServer) GetUser(context.Context, *IntRequest) (*Us
 func (UnimplementedUserServiceServer) DoesUserExist(context.Context, *IntRequest) (*BoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DoesUserExist not implemented")
 }
+func (UnimplementedUserServiceServer) UnlockAccount(context.Context, *StringRequest) (*StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockAccount not implemented")
+}
+func (UnimplementedUserServiceServer) RequestPasswordReset(context.Context, *StringRequest) (*StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedUserServiceServer) ConfirmPasswordReset(context.Context, *PasswordReset) (*StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPasswordReset not implemented")
+}
+func (UnimplementedUserServiceServer) VerifyEmail(context.Context, *StringRequest) (*StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedUserServiceServer) ResendVerification(context.Context, *emptypb.Empty) (*StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendVerification not implemented")
+}
+func (UnimplementedUserServiceServer) IsEmailVerified(context.Context, *IntRequest) (*BoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsEmailVerified not implemented")
+}
+func (UnimplementedUserServiceServer) CreateAPIKey(context.Context, *APIKeyRequest) (*APIKeyCreated, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedUserServiceServer) ListAPIKeys(context.Context, *emptypb.Empty) (*APIKeys, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeAPIKey(context.Context, *IntRequest) (*StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -381,6 +595,42 @@ func _UserService_LoginAuth_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RefreshToken(ctx, req.(*StringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Logout(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NewPassword)
 	if err := dec(in); err != nil {
@@ -453,102 +703,308 @@ func _UserService_DoesUserExist_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var UserService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "protos.UserService",
-	HandlerType: (*UserServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateUser",
-			Handler:    _UserService_CreateUser_Handler,
-		},
-		{
-			MethodName: "LoginAuth",
-			Handler:    _UserService_LoginAuth_Handler,
-		},
-		{
-			MethodName: "ChangePassword",
-			Handler:    _UserService_ChangePassword_Handler,
-		},
-		{
-			MethodName: "DeleteUser",
-			Handler:    _UserService_DeleteUser_Handler,
-		},
-		{
-			MethodName: "GetUser",
-			Handler:    _UserService_GetUser_Handler,
-		},
-		{
-			MethodName: "DoesUserExist",
-			Handler:    _UserService_DoesUserExist_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/protos.proto",
-}
-
-const (
-	CategoryService_CreateCategory_FullMethodName      = "/protos.CategoryService/CreateCategory"
-	CategoryService_GetCategories_FullMethodName       = "/protos.CategoryService/GetCategories"
-	CategoryService_GetCategoriesByName_FullMethodName = "/protos.CategoryService/GetCategoriesByName"
-	CategoryService_GetCategoryByID_FullMethodName     = "/protos.CategoryService/GetCategoryByID"
-	CategoryService_EditCategory_FullMethodName        = "/protos.CategoryService/EditCategory"
-	CategoryService_DeleteCategory_FullMethodName      = "/protos.CategoryService/DeleteCategory"
-	CategoryService_DoesCategoryExist_FullMethodName   = "/protos.CategoryService/DoesCategoryExist"
-)
-
-// CategoryServiceClient is the client API for CategoryService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-type CategoryServiceClient interface {
-	CreateCategory(ctx context.Context, in *Category, opts ...grpc.CallOption) (*StringResponse, error)
-	GetCategories(ctx context.Context, in *IDLimits, opts ...grpc.CallOption) (*CategoryMins, error)
-	GetCategoriesByName(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*CategoryMins, error)
-	GetCategoryByID(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*Category, error)
-	EditCategory(ctx context.Context, in *UpdateCategory, opts ...grpc.CallOption) (*StringResponse, error)
-	DeleteCategory(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*StringResponse, error)
-	DoesCategoryExist(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*BoolResponse, error)
-}
-
-type categoryServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewCategoryServiceClient(cc grpc.ClientConnInterface) CategoryServiceClient {
-	return &categoryServiceClient{cc}
-}
-
-func (c *categoryServiceClient) CreateCategory(ctx context.Context, in *Category, opts ...grpc.CallOption) (*StringResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(StringResponse)
-	err := c.cc.Invoke(ctx, CategoryService_CreateCategory_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _UserService_UnlockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnlockAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnlockAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnlockAccount(ctx, req.(*StringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *categoryServiceClient) GetCategories(ctx context.Context, in *IDLimits, opts ...grpc.CallOption) (*CategoryMins, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CategoryMins)
-	err := c.cc.Invoke(ctx, CategoryService_GetCategories_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _UserService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RequestPasswordReset(ctx, req.(*StringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *categoryServiceClient) GetCategoriesByName(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*CategoryMins, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CategoryMins)
-	err := c.cc.Invoke(ctx, CategoryService_GetCategoriesByName_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _UserService_ConfirmPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordReset)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ConfirmPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ConfirmPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ConfirmPasswordReset(ctx, req.(*PasswordReset))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VerifyEmail(ctx, req.(*StringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResendVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResendVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResendVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResendVerification(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_IsEmailVerified_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).IsEmailVerified(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_IsEmailVerified_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).IsEmailVerified(ctx, req.(*IntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(APIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateAPIKey(ctx, req.(*APIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListAPIKeys(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeAPIKey(ctx, req.(*IntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "protos.UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateUser",
+			Handler:    _UserService_CreateUser_Handler,
+		},
+		{
+			MethodName: "LoginAuth",
+			Handler:    _UserService_LoginAuth_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _UserService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _UserService_Logout_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _UserService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _UserService_DeleteUser_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _UserService_GetUser_Handler,
+		},
+		{
+			MethodName: "DoesUserExist",
+			Handler:    _UserService_DoesUserExist_Handler,
+		},
+		{
+			MethodName: "UnlockAccount",
+			Handler:    _UserService_UnlockAccount_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _UserService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ConfirmPasswordReset",
+			Handler:    _UserService_ConfirmPasswordReset_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _UserService_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "ResendVerification",
+			Handler:    _UserService_ResendVerification_Handler,
+		},
+		{
+			MethodName: "IsEmailVerified",
+			Handler:    _UserService_IsEmailVerified_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _UserService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _UserService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _UserService_RevokeAPIKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/protos.proto",
+}
+
+const (
+	CategoryService_CreateCategory_FullMethodName      = "/protos.CategoryService/CreateCategory"
+	CategoryService_GetCategories_FullMethodName       = "/protos.CategoryService/GetCategories"
+	CategoryService_GetCategoriesByName_FullMethodName = "/protos.CategoryService/GetCategoriesByName"
+	CategoryService_GetCategoryByID_FullMethodName     = "/protos.CategoryService/GetCategoryByID"
+	CategoryService_EditCategory_FullMethodName        = "/protos.CategoryService/EditCategory"
+	CategoryService_DeleteCategory_FullMethodName      = "/protos.CategoryService/DeleteCategory"
+	CategoryService_DoesCategoryExist_FullMethodName   = "/protos.CategoryService/DoesCategoryExist"
+)
+
+// CategoryServiceClient is the client API for CategoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CategoryServiceClient interface {
+	CreateCategory(ctx context.Context, in *Category, opts ...grpc.CallOption) (*StringResponse, error)
+	GetCategories(ctx context.Context, in *IDLimits, opts ...grpc.CallOption) (*CategoryMins, error)
+	GetCategoriesByName(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*CategoryMins, error)
+	GetCategoryByID(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*Category, error)
+	EditCategory(ctx context.Context, in *UpdateCategory, opts ...grpc.CallOption) (*StringResponse, error)
+	DeleteCategory(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*StringResponse, error)
+	DoesCategoryExist(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+}
+
+type categoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCategoryServiceClient(cc grpc.ClientConnInterface) CategoryServiceClient {
+	return &categoryServiceClient{cc}
+}
+
+func (c *categoryServiceClient) CreateCategory(ctx context.Context, in *Category, opts ...grpc.CallOption) (*StringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringResponse)
+	err := c.cc.Invoke(ctx, CategoryService_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) GetCategories(ctx context.Context, in *IDLimits, opts ...grpc.CallOption) (*CategoryMins, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryMins)
+	err := c.cc.Invoke(ctx, CategoryService_GetCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) GetCategoriesByName(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*CategoryMins, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryMins)
+	err := c.cc.Invoke(ctx, CategoryService_GetCategoriesByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
 func (c *categoryServiceClient) GetCategoryByID(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*Category, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
@@ -1150,28 +1606,53 @@ var AuthorService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	BookAndBorrowService_IsAuthorInUseByBook_FullMethodName    = "/protos.BookAndBorrowService/IsAuthorInUseByBook"
-	BookAndBorrowService_IsCategoryInUseByBook_FullMethodName  = "/protos.BookAndBorrowService/IsCategoryInUseByBook"
-	BookAndBorrowService_CreateBook_FullMethodName             = "/protos.BookAndBorrowService/CreateBook"
-	BookAndBorrowService_GetBooks_FullMethodName               = "/protos.BookAndBorrowService/GetBooks"
-	BookAndBorrowService_GetBooksByDate_FullMethodName         = "/protos.BookAndBorrowService/GetBooksByDate"
-	BookAndBorrowService_GetBooksByName_FullMethodName         = "/protos.BookAndBorrowService/GetBooksByName"
-	BookAndBorrowService_GetBookByID_FullMethodName            = "/protos.BookAndBorrowService/GetBookByID"
-	BookAndBorrowService_EditBook_FullMethodName               = "/protos.BookAndBorrowService/EditBook"
-	BookAndBorrowService_DeleteBook_FullMethodName             = "/protos.BookAndBorrowService/DeleteBook"
-	BookAndBorrowService_DoesUserStillBorrow_FullMethodName    = "/protos.BookAndBorrowService/DoesUserStillBorrow"
-	BookAndBorrowService_CreateBorrow_FullMethodName           = "/protos.BookAndBorrowService/CreateBorrow"
-	BookAndBorrowService_CreateReturn_FullMethodName           = "/protos.BookAndBorrowService/CreateReturn"
-	BookAndBorrowService_GetBorrowings_FullMethodName          = "/protos.BookAndBorrowService/GetBorrowings"
-	BookAndBorrowService_GetBorrowingsByDate_FullMethodName    = "/protos.BookAndBorrowService/GetBorrowingsByDate"
-	BookAndBorrowService_GetBorrowingsByUserID_FullMethodName  = "/protos.BookAndBorrowService/GetBorrowingsByUserID"
-	BookAndBorrowService_GetReturns_FullMethodName             = "/protos.BookAndBorrowService/GetReturns"
-	BookAndBorrowService_GetReturnsByDate_FullMethodName       = "/protos.BookAndBorrowService/GetReturnsByDate"
-	BookAndBorrowService_GetReturnsByUserID_FullMethodName     = "/protos.BookAndBorrowService/GetReturnsByUserID"
-	BookAndBorrowService_GetOverdues_FullMethodName            = "/protos.BookAndBorrowService/GetOverdues"
-	BookAndBorrowService_EditBorrow_FullMethodName             = "/protos.BookAndBorrowService/EditBorrow"
-	BookAndBorrowService_DeleteBorrow_FullMethodName           = "/protos.BookAndBorrowService/DeleteBorrow"
-	BookAndBorrowService_GetBookRecommendations_FullMethodName = "/protos.BookAndBorrowService/GetBookRecommendations"
+	BookAndBorrowService_IsAuthorInUseByBook_FullMethodName     = "/protos.BookAndBorrowService/IsAuthorInUseByBook"
+	BookAndBorrowService_IsCategoryInUseByBook_FullMethodName   = "/protos.BookAndBorrowService/IsCategoryInUseByBook"
+	BookAndBorrowService_CreateBook_FullMethodName              = "/protos.BookAndBorrowService/CreateBook"
+	BookAndBorrowService_GetBooks_FullMethodName                = "/protos.BookAndBorrowService/GetBooks"
+	BookAndBorrowService_GetBooksByDate_FullMethodName          = "/protos.BookAndBorrowService/GetBooksByDate"
+	BookAndBorrowService_GetBooksByName_FullMethodName          = "/protos.BookAndBorrowService/GetBooksByName"
+	BookAndBorrowService_GetBookByID_FullMethodName             = "/protos.BookAndBorrowService/GetBookByID"
+	BookAndBorrowService_EditBook_FullMethodName                = "/protos.BookAndBorrowService/EditBook"
+	BookAndBorrowService_DeleteBook_FullMethodName              = "/protos.BookAndBorrowService/DeleteBook"
+	BookAndBorrowService_DoesUserStillBorrow_FullMethodName     = "/protos.BookAndBorrowService/DoesUserStillBorrow"
+	BookAndBorrowService_CreateBorrow_FullMethodName            = "/protos.BookAndBorrowService/CreateBorrow"
+	BookAndBorrowService_CreateReturn_FullMethodName            = "/protos.BookAndBorrowService/CreateReturn"
+	BookAndBorrowService_GetBorrowings_FullMethodName           = "/protos.BookAndBorrowService/GetBorrowings"
+	BookAndBorrowService_GetBorrowingsByDate_FullMethodName     = "/protos.BookAndBorrowService/GetBorrowingsByDate"
+	BookAndBorrowService_GetBorrowingsByUserID_FullMethodName   = "/protos.BookAndBorrowService/GetBorrowingsByUserID"
+	BookAndBorrowService_GetReturns_FullMethodName              = "/protos.BookAndBorrowService/GetReturns"
+	BookAndBorrowService_GetReturnsByDate_FullMethodName        = "/protos.BookAndBorrowService/GetReturnsByDate"
+	BookAndBorrowService_GetReturnsByUserID_FullMethodName      = "/protos.BookAndBorrowService/GetReturnsByUserID"
+	BookAndBorrowService_GetOverdues_FullMethodName             = "/protos.BookAndBorrowService/GetOverdues"
+	BookAndBorrowService_EditBorrow_FullMethodName              = "/protos.BookAndBorrowService/EditBorrow"
+	BookAndBorrowService_DeleteBorrow_FullMethodName            = "/protos.BookAndBorrowService/DeleteBorrow"
+	BookAndBorrowService_RenewBorrow_FullMethodName             = "/protos.BookAndBorrowService/RenewBorrow"
+	BookAndBorrowService_GetUserFines_FullMethodName            = "/protos.BookAndBorrowService/GetUserFines"
+	BookAndBorrowService_PayFine_FullMethodName                 = "/protos.BookAndBorrowService/PayFine"
+	BookAndBorrowService_WaiveFine_FullMethodName               = "/protos.BookAndBorrowService/WaiveFine"
+	BookAndBorrowService_GetNotificationHistory_FullMethodName  = "/protos.BookAndBorrowService/GetNotificationHistory"
+	BookAndBorrowService_CreateItem_FullMethodName              = "/protos.BookAndBorrowService/CreateItem"
+	BookAndBorrowService_ListItems_FullMethodName               = "/protos.BookAndBorrowService/ListItems"
+	BookAndBorrowService_MarkItemLost_FullMethodName            = "/protos.BookAndBorrowService/MarkItemLost"
+	BookAndBorrowService_MarkItemDamaged_FullMethodName         = "/protos.BookAndBorrowService/MarkItemDamaged"
+	BookAndBorrowService_CreateBranch_FullMethodName            = "/protos.BookAndBorrowService/CreateBranch"
+	BookAndBorrowService_GetBranches_FullMethodName             = "/protos.BookAndBorrowService/GetBranches"
+	BookAndBorrowService_GetCalendar_FullMethodName             = "/protos.BookAndBorrowService/GetCalendar"
+	BookAndBorrowService_SetOpeningHours_FullMethodName         = "/protos.BookAndBorrowService/SetOpeningHours"
+	BookAndBorrowService_AddHoliday_FullMethodName              = "/protos.BookAndBorrowService/AddHoliday"
+	BookAndBorrowService_DeleteHoliday_FullMethodName           = "/protos.BookAndBorrowService/DeleteHoliday"
+	BookAndBorrowService_RequestTransfer_FullMethodName         = "/protos.BookAndBorrowService/RequestTransfer"
+	BookAndBorrowService_ShipTransfer_FullMethodName            = "/protos.BookAndBorrowService/ShipTransfer"
+	BookAndBorrowService_ReceiveTransfer_FullMethodName         = "/protos.BookAndBorrowService/ReceiveTransfer"
+	BookAndBorrowService_ListTransfers_FullMethodName           = "/protos.BookAndBorrowService/ListTransfers"
+	BookAndBorrowService_GetCirculationPolicies_FullMethodName  = "/protos.BookAndBorrowService/GetCirculationPolicies"
+	BookAndBorrowService_SetCirculationPolicy_FullMethodName    = "/protos.BookAndBorrowService/SetCirculationPolicy"
+	BookAndBorrowService_DeleteCirculationPolicy_FullMethodName = "/protos.BookAndBorrowService/DeleteCirculationPolicy"
+	BookAndBorrowService_GetBookRecommendations_FullMethodName  = "/protos.BookAndBorrowService/GetBookRecommendations"
+	BookAndBorrowService_PlaceHold_FullMethodName               = "/protos.BookAndBorrowService/PlaceHold"
+	BookAndBorrowService_CancelHold_FullMethodName              = "/protos.BookAndBorrowService/CancelHold"
+	BookAndBorrowService_ListHolds_FullMethodName               = "/protos.BookAndBorrowService/ListHolds"
 )
 
 // BookAndBorrowServiceClient is the client API for BookAndBorrowService service.
@@ -1189,17 +1670,42 @@ type BookAndBorrowServiceClient interface {
 	DeleteBook(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*StringResponse, error)
 	DoesUserStillBorrow(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*BoolResponse, error)
 	CreateBorrow(ctx context.Context, in *Borrow, opts ...grpc.CallOption) (*StringResponse, error)
-	CreateReturn(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*StringResponse, error)
+	CreateReturn(ctx context.Context, in *Return, opts ...grpc.CallOption) (*StringResponse, error)
 	GetBorrowings(ctx context.Context, in *IDLimits, opts ...grpc.CallOption) (*BorrowOrReturnMins, error)
 	GetBorrowingsByDate(ctx context.Context, in *DateLimits, opts ...grpc.CallOption) (*BorrowOrReturnMins, error)
 	GetBorrowingsByUserID(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*BorrowOrReturnMins, error)
 	GetReturns(ctx context.Context, in *IDLimits, opts ...grpc.CallOption) (*BorrowOrReturnMins, error)
 	GetReturnsByDate(ctx context.Context, in *DateLimits, opts ...grpc.CallOption) (*BorrowOrReturnMins, error)
 	GetReturnsByUserID(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*BorrowOrReturnMins, error)
-	GetOverdues(ctx context.Context, in *DateLimits, opts ...grpc.CallOption) (*BorrowOrReturnMins, error)
+	GetOverdues(ctx context.Context, in *OverdueLimits, opts ...grpc.CallOption) (*BorrowOrReturnMins, error)
 	EditBorrow(ctx context.Context, in *UpdateBorrow, opts ...grpc.CallOption) (*StringResponse, error)
 	DeleteBorrow(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*StringResponse, error)
+	RenewBorrow(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*RenewedBorrow, error)
+	GetUserFines(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*Fines, error)
+	PayFine(ctx context.Context, in *FinePayment, opts ...grpc.CallOption) (*Fine, error)
+	WaiveFine(ctx context.Context, in *FineWaiver, opts ...grpc.CallOption) (*Fine, error)
+	GetNotificationHistory(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*Notifications, error)
+	CreateItem(ctx context.Context, in *Item, opts ...grpc.CallOption) (*Item, error)
+	ListItems(ctx context.Context, in *ItemQuery, opts ...grpc.CallOption) (*Items, error)
+	MarkItemLost(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*Item, error)
+	MarkItemDamaged(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*Item, error)
+	CreateBranch(ctx context.Context, in *Branch, opts ...grpc.CallOption) (*Branch, error)
+	GetBranches(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Branches, error)
+	GetCalendar(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*Calendar, error)
+	SetOpeningHours(ctx context.Context, in *OpeningHours, opts ...grpc.CallOption) (*OpeningHours, error)
+	AddHoliday(ctx context.Context, in *Holiday, opts ...grpc.CallOption) (*Holiday, error)
+	DeleteHoliday(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*StringResponse, error)
+	RequestTransfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*Transfer, error)
+	ShipTransfer(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*Transfer, error)
+	ReceiveTransfer(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*Transfer, error)
+	ListTransfers(ctx context.Context, in *TransferQuery, opts ...grpc.CallOption) (*Transfers, error)
+	GetCirculationPolicies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CirculationPolicies, error)
+	SetCirculationPolicy(ctx context.Context, in *CirculationPolicy, opts ...grpc.CallOption) (*CirculationPolicy, error)
+	DeleteCirculationPolicy(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*StringResponse, error)
 	GetBookRecommendations(ctx context.Context, in *GetRecommendation, opts ...grpc.CallOption) (*BookMins, error)
+	PlaceHold(ctx context.Context, in *HoldRequest, opts ...grpc.CallOption) (*Hold, error)
+	CancelHold(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*StringResponse, error)
+	ListHolds(ctx context.Context, in *HoldRequest, opts ...grpc.CallOption) (*Holds, error)
 }
 
 type bookAndBorrowServiceClient struct {
@@ -1320,7 +1826,7 @@ func (c *bookAndBorrowServiceClient) CreateBorrow(ctx context.Context, in *Borro
 	return out, nil
 }
 
-func (c *bookAndBorrowServiceClient) CreateReturn(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*StringResponse, error) {
+func (c *bookAndBorrowServiceClient) CreateReturn(ctx context.Context, in *Return, opts ...grpc.CallOption) (*StringResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StringResponse)
 	err := c.cc.Invoke(ctx, BookAndBorrowService_CreateReturn_FullMethodName, in, out, cOpts...)
@@ -1390,7 +1896,7 @@ func (c *bookAndBorrowServiceClient) GetReturnsByUserID(ctx context.Context, in
 	return out, nil
 }
 
-func (c *bookAndBorrowServiceClient) GetOverdues(ctx context.Context, in *DateLimits, opts ...grpc.CallOption) (*BorrowOrReturnMins, error) {
+func (c *bookAndBorrowServiceClient) GetOverdues(ctx context.Context, in *OverdueLimits, opts ...grpc.CallOption) (*BorrowOrReturnMins, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BorrowOrReturnMins)
 	err := c.cc.Invoke(ctx, BookAndBorrowService_GetOverdues_FullMethodName, in, out, cOpts...)
@@ -1420,51 +1926,326 @@ func (c *bookAndBorrowServiceClient) DeleteBorrow(ctx context.Context, in *IntRe
 	return out, nil
 }
 
-func (c *bookAndBorrowServiceClient) GetBookRecommendations(ctx context.Context, in *GetRecommendation, opts ...grpc.CallOption) (*BookMins, error) {
+func (c *bookAndBorrowServiceClient) RenewBorrow(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*RenewedBorrow, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(BookMins)
-	err := c.cc.Invoke(ctx, BookAndBorrowService_GetBookRecommendations_FullMethodName, in, out, cOpts...)
+	out := new(RenewedBorrow)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_RenewBorrow_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// BookAndBorrowServiceServer is the server API for BookAndBorrowService service.
-// All implementations must embed UnimplementedBookAndBorrowServiceServer
-// for forward compatibility.
-type BookAndBorrowServiceServer interface {
-	IsAuthorInUseByBook(context.Context, *IntRequest) (*BoolResponse, error)
-	IsCategoryInUseByBook(context.Context, *IntRequest) (*BoolResponse, error)
-	CreateBook(context.Context, *Book) (*StringResponse, error)
-	GetBooks(context.Context, *IDLimits) (*BookMins, error)
-	GetBooksByDate(context.Context, *DateLimits) (*BookMins, error)
-	GetBooksByName(context.Context, *StringRequest) (*BookMins, error)
-	GetBookByID(context.Context, *IntRequest) (*Book, error)
-	EditBook(context.Context, *UpdateBook) (*StringResponse, error)
-	DeleteBook(context.Context, *IntRequest) (*StringResponse, error)
-	DoesUserStillBorrow(context.Context, *IntRequest) (*BoolResponse, error)
-	CreateBorrow(context.Context, *Borrow) (*StringResponse, error)
-	CreateReturn(context.Context, *IntRequest) (*StringResponse, error)
-	GetBorrowings(context.Context, *IDLimits) (*BorrowOrReturnMins, error)
-	GetBorrowingsByDate(context.Context, *DateLimits) (*BorrowOrReturnMins, error)
-	GetBorrowingsByUserID(context.Context, *IntRequest) (*BorrowOrReturnMins, error)
-	GetReturns(context.Context, *IDLimits) (*BorrowOrReturnMins, error)
-	GetReturnsByDate(context.Context, *DateLimits) (*BorrowOrReturnMins, error)
-	GetReturnsByUserID(context.Context, *IntRequest) (*BorrowOrReturnMins, error)
-	GetOverdues(context.Context, *DateLimits) (*BorrowOrReturnMins, error)
-	EditBorrow(context.Context, *UpdateBorrow) (*StringResponse, error)
-	DeleteBorrow(context.Context, *IntRequest) (*StringResponse, error)
-	GetBookRecommendations(context.Context, *GetRecommendation) (*BookMins, error)
-	mustEmbedUnimplementedBookAndBorrowServiceServer()
+func (c *bookAndBorrowServiceClient) GetUserFines(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*Fines, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Fines)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_GetUserFines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedBookAndBorrowServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedBookAndBorrowServiceServer struct{}
+func (c *bookAndBorrowServiceClient) PayFine(ctx context.Context, in *FinePayment, opts ...grpc.CallOption) (*Fine, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Fine)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_PayFine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) WaiveFine(ctx context.Context, in *FineWaiver, opts ...grpc.CallOption) (*Fine, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Fine)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_WaiveFine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) GetNotificationHistory(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*Notifications, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Notifications)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_GetNotificationHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) CreateItem(ctx context.Context, in *Item, opts ...grpc.CallOption) (*Item, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Item)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_CreateItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) ListItems(ctx context.Context, in *ItemQuery, opts ...grpc.CallOption) (*Items, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Items)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_ListItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) MarkItemLost(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*Item, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Item)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_MarkItemLost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) MarkItemDamaged(ctx context.Context, in *StringRequest, opts ...grpc.CallOption) (*Item, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Item)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_MarkItemDamaged_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) CreateBranch(ctx context.Context, in *Branch, opts ...grpc.CallOption) (*Branch, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Branch)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_CreateBranch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) GetBranches(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Branches, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Branches)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_GetBranches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) GetCalendar(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*Calendar, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Calendar)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_GetCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) SetOpeningHours(ctx context.Context, in *OpeningHours, opts ...grpc.CallOption) (*OpeningHours, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpeningHours)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_SetOpeningHours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) AddHoliday(ctx context.Context, in *Holiday, opts ...grpc.CallOption) (*Holiday, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Holiday)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_AddHoliday_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) DeleteHoliday(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*StringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringResponse)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_DeleteHoliday_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) RequestTransfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*Transfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_RequestTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) ShipTransfer(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*Transfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_ShipTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) ReceiveTransfer(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*Transfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_ReceiveTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) ListTransfers(ctx context.Context, in *TransferQuery, opts ...grpc.CallOption) (*Transfers, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transfers)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_ListTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) GetCirculationPolicies(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CirculationPolicies, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CirculationPolicies)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_GetCirculationPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) SetCirculationPolicy(ctx context.Context, in *CirculationPolicy, opts ...grpc.CallOption) (*CirculationPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CirculationPolicy)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_SetCirculationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) DeleteCirculationPolicy(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*StringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringResponse)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_DeleteCirculationPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) GetBookRecommendations(ctx context.Context, in *GetRecommendation, opts ...grpc.CallOption) (*BookMins, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BookMins)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_GetBookRecommendations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) PlaceHold(ctx context.Context, in *HoldRequest, opts ...grpc.CallOption) (*Hold, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Hold)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_PlaceHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) CancelHold(ctx context.Context, in *IntRequest, opts ...grpc.CallOption) (*StringResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StringResponse)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_CancelHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookAndBorrowServiceClient) ListHolds(ctx context.Context, in *HoldRequest, opts ...grpc.CallOption) (*Holds, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Holds)
+	err := c.cc.Invoke(ctx, BookAndBorrowService_ListHolds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BookAndBorrowServiceServer is the server API for BookAndBorrowService service.
+// All implementations must embed UnimplementedBookAndBorrowServiceServer
+// for forward compatibility.
+type BookAndBorrowServiceServer interface {
+	IsAuthorInUseByBook(context.Context, *IntRequest) (*BoolResponse, error)
+	IsCategoryInUseByBook(context.Context, *IntRequest) (*BoolResponse, error)
+	CreateBook(context.Context, *Book) (*StringResponse, error)
+	GetBooks(context.Context, *IDLimits) (*BookMins, error)
+	GetBooksByDate(context.Context, *DateLimits) (*BookMins, error)
+	GetBooksByName(context.Context, *StringRequest) (*BookMins, error)
+	GetBookByID(context.Context, *IntRequest) (*Book, error)
+	EditBook(context.Context, *UpdateBook) (*StringResponse, error)
+	DeleteBook(context.Context, *IntRequest) (*StringResponse, error)
+	DoesUserStillBorrow(context.Context, *IntRequest) (*BoolResponse, error)
+	CreateBorrow(context.Context, *Borrow) (*StringResponse, error)
+	CreateReturn(context.Context, *Return) (*StringResponse, error)
+	GetBorrowings(context.Context, *IDLimits) (*BorrowOrReturnMins, error)
+	GetBorrowingsByDate(context.Context, *DateLimits) (*BorrowOrReturnMins, error)
+	GetBorrowingsByUserID(context.Context, *IntRequest) (*BorrowOrReturnMins, error)
+	GetReturns(context.Context, *IDLimits) (*BorrowOrReturnMins, error)
+	GetReturnsByDate(context.Context, *DateLimits) (*BorrowOrReturnMins, error)
+	GetReturnsByUserID(context.Context, *IntRequest) (*BorrowOrReturnMins, error)
+	GetOverdues(context.Context, *OverdueLimits) (*BorrowOrReturnMins, error)
+	EditBorrow(context.Context, *UpdateBorrow) (*StringResponse, error)
+	DeleteBorrow(context.Context, *IntRequest) (*StringResponse, error)
+	RenewBorrow(context.Context, *IntRequest) (*RenewedBorrow, error)
+	GetUserFines(context.Context, *IntRequest) (*Fines, error)
+	PayFine(context.Context, *FinePayment) (*Fine, error)
+	WaiveFine(context.Context, *FineWaiver) (*Fine, error)
+	GetNotificationHistory(context.Context, *IntRequest) (*Notifications, error)
+	CreateItem(context.Context, *Item) (*Item, error)
+	ListItems(context.Context, *ItemQuery) (*Items, error)
+	MarkItemLost(context.Context, *StringRequest) (*Item, error)
+	MarkItemDamaged(context.Context, *StringRequest) (*Item, error)
+	CreateBranch(context.Context, *Branch) (*Branch, error)
+	GetBranches(context.Context, *emptypb.Empty) (*Branches, error)
+	GetCalendar(context.Context, *IntRequest) (*Calendar, error)
+	SetOpeningHours(context.Context, *OpeningHours) (*OpeningHours, error)
+	AddHoliday(context.Context, *Holiday) (*Holiday, error)
+	DeleteHoliday(context.Context, *IntRequest) (*StringResponse, error)
+	RequestTransfer(context.Context, *TransferRequest) (*Transfer, error)
+	ShipTransfer(context.Context, *IntRequest) (*Transfer, error)
+	ReceiveTransfer(context.Context, *IntRequest) (*Transfer, error)
+	ListTransfers(context.Context, *TransferQuery) (*Transfers, error)
+	GetCirculationPolicies(context.Context, *emptypb.Empty) (*CirculationPolicies, error)
+	SetCirculationPolicy(context.Context, *CirculationPolicy) (*CirculationPolicy, error)
+	DeleteCirculationPolicy(context.Context, *IntRequest) (*StringResponse, error)
+	GetBookRecommendations(context.Context, *GetRecommendation) (*BookMins, error)
+	PlaceHold(context.Context, *HoldRequest) (*Hold, error)
+	CancelHold(context.Context, *IntRequest) (*StringResponse, error)
+	ListHolds(context.Context, *HoldRequest) (*Holds, error)
+	mustEmbedUnimplementedBookAndBorrowServiceServer()
+}
+
+// UnimplementedBookAndBorrowServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBookAndBorrowServiceServer struct{}
 
 func (UnimplementedBookAndBorrowServiceServer) IsAuthorInUseByBook(context.Context, *IntRequest) (*BoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsAuthorInUseByBook not implemented")
@@ -1499,7 +2280,7 @@ func (UnimplementedBookAndBorrowServiceServer) DoesUserStillBorrow(context.Conte
 func (UnimplementedBookAndBorrowServiceServer) CreateBorrow(context.Context, *Borrow) (*StringResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBorrow not implemented")
 }
-func (UnimplementedBookAndBorrowServiceServer) CreateReturn(context.Context, *IntRequest) (*StringResponse, error) {
+func (UnimplementedBookAndBorrowServiceServer) CreateReturn(context.Context, *Return) (*StringResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateReturn not implemented")
 }
 func (UnimplementedBookAndBorrowServiceServer) GetBorrowings(context.Context, *IDLimits) (*BorrowOrReturnMins, error) {
@@ -1520,7 +2301,7 @@ func (UnimplementedBookAndBorrowServiceServer) GetReturnsByDate(context.Context,
 func (UnimplementedBookAndBorrowServiceServer) GetReturnsByUserID(context.Context, *IntRequest) (*BorrowOrReturnMins, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReturnsByUserID not implemented")
 }
-func (UnimplementedBookAndBorrowServiceServer) GetOverdues(context.Context, *DateLimits) (*BorrowOrReturnMins, error) {
+func (UnimplementedBookAndBorrowServiceServer) GetOverdues(context.Context, *OverdueLimits) (*BorrowOrReturnMins, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOverdues not implemented")
 }
 func (UnimplementedBookAndBorrowServiceServer) EditBorrow(context.Context, *UpdateBorrow) (*StringResponse, error) {
@@ -1529,404 +2310,875 @@ func (UnimplementedBookAndBorrowServiceServer) EditBorrow(context.Context, *Upda
 func (UnimplementedBookAndBorrowServiceServer) DeleteBorrow(context.Context, *IntRequest) (*StringResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBorrow not implemented")
 }
+func (UnimplementedBookAndBorrowServiceServer) RenewBorrow(context.Context, *IntRequest) (*RenewedBorrow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewBorrow not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) GetUserFines(context.Context, *IntRequest) (*Fines, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserFines not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) PayFine(context.Context, *FinePayment) (*Fine, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayFine not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) WaiveFine(context.Context, *FineWaiver) (*Fine, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaiveFine not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) GetNotificationHistory(context.Context, *IntRequest) (*Notifications, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationHistory not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) CreateItem(context.Context, *Item) (*Item, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateItem not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) ListItems(context.Context, *ItemQuery) (*Items, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItems not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) MarkItemLost(context.Context, *StringRequest) (*Item, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkItemLost not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) MarkItemDamaged(context.Context, *StringRequest) (*Item, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkItemDamaged not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) CreateBranch(context.Context, *Branch) (*Branch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBranch not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) GetBranches(context.Context, *emptypb.Empty) (*Branches, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBranches not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) GetCalendar(context.Context, *IntRequest) (*Calendar, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendar not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) SetOpeningHours(context.Context, *OpeningHours) (*OpeningHours, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOpeningHours not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) AddHoliday(context.Context, *Holiday) (*Holiday, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddHoliday not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) DeleteHoliday(context.Context, *IntRequest) (*StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHoliday not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) RequestTransfer(context.Context, *TransferRequest) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestTransfer not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) ShipTransfer(context.Context, *IntRequest) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShipTransfer not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) ReceiveTransfer(context.Context, *IntRequest) (*Transfer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveTransfer not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) ListTransfers(context.Context, *TransferQuery) (*Transfers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransfers not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) GetCirculationPolicies(context.Context, *emptypb.Empty) (*CirculationPolicies, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCirculationPolicies not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) SetCirculationPolicy(context.Context, *CirculationPolicy) (*CirculationPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCirculationPolicy not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) DeleteCirculationPolicy(context.Context, *IntRequest) (*StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCirculationPolicy not implemented")
+}
 func (UnimplementedBookAndBorrowServiceServer) GetBookRecommendations(context.Context, *GetRecommendation) (*BookMins, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBookRecommendations not implemented")
 }
-func (UnimplementedBookAndBorrowServiceServer) mustEmbedUnimplementedBookAndBorrowServiceServer() {}
-func (UnimplementedBookAndBorrowServiceServer) testEmbeddedByValue()                              {}
+func (UnimplementedBookAndBorrowServiceServer) PlaceHold(context.Context, *HoldRequest) (*Hold, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceHold not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) CancelHold(context.Context, *IntRequest) (*StringResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelHold not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) ListHolds(context.Context, *HoldRequest) (*Holds, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHolds not implemented")
+}
+func (UnimplementedBookAndBorrowServiceServer) mustEmbedUnimplementedBookAndBorrowServiceServer() {}
+func (UnimplementedBookAndBorrowServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeBookAndBorrowServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BookAndBorrowServiceServer will
+// result in compilation errors.
+type UnsafeBookAndBorrowServiceServer interface {
+	mustEmbedUnimplementedBookAndBorrowServiceServer()
+}
+
+func RegisterBookAndBorrowServiceServer(s grpc.ServiceRegistrar, srv BookAndBorrowServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBookAndBorrowServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BookAndBorrowService_ServiceDesc, srv)
+}
+
+func _BookAndBorrowService_IsAuthorInUseByBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).IsAuthorInUseByBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_IsAuthorInUseByBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).IsAuthorInUseByBook(ctx, req.(*IntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_IsCategoryInUseByBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).IsCategoryInUseByBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_IsCategoryInUseByBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).IsCategoryInUseByBook(ctx, req.(*IntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_CreateBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Book)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).CreateBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_CreateBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).CreateBook(ctx, req.(*Book))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_GetBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).GetBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_GetBooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).GetBooks(ctx, req.(*IDLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_GetBooksByDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DateLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).GetBooksByDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_GetBooksByDate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).GetBooksByDate(ctx, req.(*DateLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_GetBooksByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).GetBooksByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_GetBooksByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).GetBooksByName(ctx, req.(*StringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_GetBookByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).GetBookByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_GetBookByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).GetBookByID(ctx, req.(*IntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_EditBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).EditBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_EditBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).EditBook(ctx, req.(*UpdateBook))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_DeleteBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).DeleteBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_DeleteBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).DeleteBook(ctx, req.(*IntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_DoesUserStillBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).DoesUserStillBorrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_DoesUserStillBorrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).DoesUserStillBorrow(ctx, req.(*IntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_CreateBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Borrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).CreateBorrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_CreateBorrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).CreateBorrow(ctx, req.(*Borrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_CreateReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Return)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).CreateReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_CreateReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).CreateReturn(ctx, req.(*Return))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_GetBorrowings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).GetBorrowings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_GetBorrowings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).GetBorrowings(ctx, req.(*IDLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_GetBorrowingsByDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DateLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).GetBorrowingsByDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_GetBorrowingsByDate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).GetBorrowingsByDate(ctx, req.(*DateLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_GetBorrowingsByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).GetBorrowingsByUserID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_GetBorrowingsByUserID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).GetBorrowingsByUserID(ctx, req.(*IntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_GetReturns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IDLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).GetReturns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_GetReturns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).GetReturns(ctx, req.(*IDLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_GetReturnsByDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DateLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).GetReturnsByDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_GetReturnsByDate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).GetReturnsByDate(ctx, req.(*DateLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_GetReturnsByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).GetReturnsByUserID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_GetReturnsByUserID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).GetReturnsByUserID(ctx, req.(*IntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_GetOverdues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OverdueLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).GetOverdues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_GetOverdues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).GetOverdues(ctx, req.(*OverdueLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// UnsafeBookAndBorrowServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to BookAndBorrowServiceServer will
-// result in compilation errors.
-type UnsafeBookAndBorrowServiceServer interface {
-	mustEmbedUnimplementedBookAndBorrowServiceServer()
+func _BookAndBorrowService_EditBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBorrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).EditBorrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_EditBorrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).EditBorrow(ctx, req.(*UpdateBorrow))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterBookAndBorrowServiceServer(s grpc.ServiceRegistrar, srv BookAndBorrowServiceServer) {
-	// If the following call pancis, it indicates UnimplementedBookAndBorrowServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _BookAndBorrowService_DeleteBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&BookAndBorrowService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).DeleteBorrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_DeleteBorrow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).DeleteBorrow(ctx, req.(*IntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_IsAuthorInUseByBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _BookAndBorrowService_RenewBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IntRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).IsAuthorInUseByBook(ctx, in)
+		return srv.(BookAndBorrowServiceServer).RenewBorrow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_IsAuthorInUseByBook_FullMethodName,
+		FullMethod: BookAndBorrowService_RenewBorrow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).IsAuthorInUseByBook(ctx, req.(*IntRequest))
+		return srv.(BookAndBorrowServiceServer).RenewBorrow(ctx, req.(*IntRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_IsCategoryInUseByBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _BookAndBorrowService_GetUserFines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IntRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).IsCategoryInUseByBook(ctx, in)
+		return srv.(BookAndBorrowServiceServer).GetUserFines(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_IsCategoryInUseByBook_FullMethodName,
+		FullMethod: BookAndBorrowService_GetUserFines_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).IsCategoryInUseByBook(ctx, req.(*IntRequest))
+		return srv.(BookAndBorrowServiceServer).GetUserFines(ctx, req.(*IntRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_CreateBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Book)
+func _BookAndBorrowService_PayFine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinePayment)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).CreateBook(ctx, in)
+		return srv.(BookAndBorrowServiceServer).PayFine(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_CreateBook_FullMethodName,
+		FullMethod: BookAndBorrowService_PayFine_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).CreateBook(ctx, req.(*Book))
+		return srv.(BookAndBorrowServiceServer).PayFine(ctx, req.(*FinePayment))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_GetBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDLimits)
+func _BookAndBorrowService_WaiveFine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FineWaiver)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).GetBooks(ctx, in)
+		return srv.(BookAndBorrowServiceServer).WaiveFine(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_GetBooks_FullMethodName,
+		FullMethod: BookAndBorrowService_WaiveFine_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).GetBooks(ctx, req.(*IDLimits))
+		return srv.(BookAndBorrowServiceServer).WaiveFine(ctx, req.(*FineWaiver))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_GetBooksByDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DateLimits)
+func _BookAndBorrowService_GetNotificationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).GetBooksByDate(ctx, in)
+		return srv.(BookAndBorrowServiceServer).GetNotificationHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_GetBooksByDate_FullMethodName,
+		FullMethod: BookAndBorrowService_GetNotificationHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).GetBooksByDate(ctx, req.(*DateLimits))
+		return srv.(BookAndBorrowServiceServer).GetNotificationHistory(ctx, req.(*IntRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_GetBooksByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StringRequest)
+func _BookAndBorrowService_CreateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Item)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).GetBooksByName(ctx, in)
+		return srv.(BookAndBorrowServiceServer).CreateItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_GetBooksByName_FullMethodName,
+		FullMethod: BookAndBorrowService_CreateItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).GetBooksByName(ctx, req.(*StringRequest))
+		return srv.(BookAndBorrowServiceServer).CreateItem(ctx, req.(*Item))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_GetBookByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IntRequest)
+func _BookAndBorrowService_ListItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ItemQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).GetBookByID(ctx, in)
+		return srv.(BookAndBorrowServiceServer).ListItems(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_GetBookByID_FullMethodName,
+		FullMethod: BookAndBorrowService_ListItems_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).GetBookByID(ctx, req.(*IntRequest))
+		return srv.(BookAndBorrowServiceServer).ListItems(ctx, req.(*ItemQuery))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_EditBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBook)
+func _BookAndBorrowService_MarkItemLost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).EditBook(ctx, in)
+		return srv.(BookAndBorrowServiceServer).MarkItemLost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_EditBook_FullMethodName,
+		FullMethod: BookAndBorrowService_MarkItemLost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).EditBook(ctx, req.(*UpdateBook))
+		return srv.(BookAndBorrowServiceServer).MarkItemLost(ctx, req.(*StringRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_DeleteBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IntRequest)
+func _BookAndBorrowService_MarkItemDamaged_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).DeleteBook(ctx, in)
+		return srv.(BookAndBorrowServiceServer).MarkItemDamaged(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_DeleteBook_FullMethodName,
+		FullMethod: BookAndBorrowService_MarkItemDamaged_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).DeleteBook(ctx, req.(*IntRequest))
+		return srv.(BookAndBorrowServiceServer).MarkItemDamaged(ctx, req.(*StringRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_DoesUserStillBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IntRequest)
+func _BookAndBorrowService_CreateBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Branch)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).DoesUserStillBorrow(ctx, in)
+		return srv.(BookAndBorrowServiceServer).CreateBranch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_DoesUserStillBorrow_FullMethodName,
+		FullMethod: BookAndBorrowService_CreateBranch_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).DoesUserStillBorrow(ctx, req.(*IntRequest))
+		return srv.(BookAndBorrowServiceServer).CreateBranch(ctx, req.(*Branch))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_CreateBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Borrow)
+func _BookAndBorrowService_GetBranches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).CreateBorrow(ctx, in)
+		return srv.(BookAndBorrowServiceServer).GetBranches(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_CreateBorrow_FullMethodName,
+		FullMethod: BookAndBorrowService_GetBranches_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).CreateBorrow(ctx, req.(*Borrow))
+		return srv.(BookAndBorrowServiceServer).GetBranches(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_CreateReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _BookAndBorrowService_GetCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IntRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).CreateReturn(ctx, in)
+		return srv.(BookAndBorrowServiceServer).GetCalendar(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_CreateReturn_FullMethodName,
+		FullMethod: BookAndBorrowService_GetCalendar_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).CreateReturn(ctx, req.(*IntRequest))
+		return srv.(BookAndBorrowServiceServer).GetCalendar(ctx, req.(*IntRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_GetBorrowings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDLimits)
+func _BookAndBorrowService_SetOpeningHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpeningHours)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).GetBorrowings(ctx, in)
+		return srv.(BookAndBorrowServiceServer).SetOpeningHours(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_GetBorrowings_FullMethodName,
+		FullMethod: BookAndBorrowService_SetOpeningHours_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).GetBorrowings(ctx, req.(*IDLimits))
+		return srv.(BookAndBorrowServiceServer).SetOpeningHours(ctx, req.(*OpeningHours))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_GetBorrowingsByDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DateLimits)
+func _BookAndBorrowService_AddHoliday_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Holiday)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).GetBorrowingsByDate(ctx, in)
+		return srv.(BookAndBorrowServiceServer).AddHoliday(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_GetBorrowingsByDate_FullMethodName,
+		FullMethod: BookAndBorrowService_AddHoliday_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).GetBorrowingsByDate(ctx, req.(*DateLimits))
+		return srv.(BookAndBorrowServiceServer).AddHoliday(ctx, req.(*Holiday))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_GetBorrowingsByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _BookAndBorrowService_DeleteHoliday_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IntRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).GetBorrowingsByUserID(ctx, in)
+		return srv.(BookAndBorrowServiceServer).DeleteHoliday(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_GetBorrowingsByUserID_FullMethodName,
+		FullMethod: BookAndBorrowService_DeleteHoliday_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).GetBorrowingsByUserID(ctx, req.(*IntRequest))
+		return srv.(BookAndBorrowServiceServer).DeleteHoliday(ctx, req.(*IntRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_GetReturns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IDLimits)
+func _BookAndBorrowService_RequestTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).GetReturns(ctx, in)
+		return srv.(BookAndBorrowServiceServer).RequestTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_GetReturns_FullMethodName,
+		FullMethod: BookAndBorrowService_RequestTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).GetReturns(ctx, req.(*IDLimits))
+		return srv.(BookAndBorrowServiceServer).RequestTransfer(ctx, req.(*TransferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_GetReturnsByDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DateLimits)
+func _BookAndBorrowService_ShipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).GetReturnsByDate(ctx, in)
+		return srv.(BookAndBorrowServiceServer).ShipTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_GetReturnsByDate_FullMethodName,
+		FullMethod: BookAndBorrowService_ShipTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).GetReturnsByDate(ctx, req.(*DateLimits))
+		return srv.(BookAndBorrowServiceServer).ShipTransfer(ctx, req.(*IntRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_GetReturnsByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _BookAndBorrowService_ReceiveTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IntRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).GetReturnsByUserID(ctx, in)
+		return srv.(BookAndBorrowServiceServer).ReceiveTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_GetReturnsByUserID_FullMethodName,
+		FullMethod: BookAndBorrowService_ReceiveTransfer_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).GetReturnsByUserID(ctx, req.(*IntRequest))
+		return srv.(BookAndBorrowServiceServer).ReceiveTransfer(ctx, req.(*IntRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_GetOverdues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DateLimits)
+func _BookAndBorrowService_ListTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).GetOverdues(ctx, in)
+		return srv.(BookAndBorrowServiceServer).ListTransfers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_GetOverdues_FullMethodName,
+		FullMethod: BookAndBorrowService_ListTransfers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).GetOverdues(ctx, req.(*DateLimits))
+		return srv.(BookAndBorrowServiceServer).ListTransfers(ctx, req.(*TransferQuery))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_EditBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBorrow)
+func _BookAndBorrowService_GetCirculationPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).EditBorrow(ctx, in)
+		return srv.(BookAndBorrowServiceServer).GetCirculationPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_EditBorrow_FullMethodName,
+		FullMethod: BookAndBorrowService_GetCirculationPolicies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).EditBorrow(ctx, req.(*UpdateBorrow))
+		return srv.(BookAndBorrowServiceServer).GetCirculationPolicies(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BookAndBorrowService_DeleteBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _BookAndBorrowService_SetCirculationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CirculationPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).SetCirculationPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_SetCirculationPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).SetCirculationPolicy(ctx, req.(*CirculationPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_DeleteCirculationPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IntRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BookAndBorrowServiceServer).DeleteBorrow(ctx, in)
+		return srv.(BookAndBorrowServiceServer).DeleteCirculationPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: BookAndBorrowService_DeleteBorrow_FullMethodName,
+		FullMethod: BookAndBorrowService_DeleteCirculationPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BookAndBorrowServiceServer).DeleteBorrow(ctx, req.(*IntRequest))
+		return srv.(BookAndBorrowServiceServer).DeleteCirculationPolicy(ctx, req.(*IntRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1949,6 +3201,60 @@ func _BookAndBorrowService_GetBookRecommendations_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookAndBorrowService_PlaceHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).PlaceHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_PlaceHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).PlaceHold(ctx, req.(*HoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_CancelHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).CancelHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_CancelHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).CancelHold(ctx, req.(*IntRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookAndBorrowService_ListHolds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookAndBorrowServiceServer).ListHolds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookAndBorrowService_ListHolds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookAndBorrowServiceServer).ListHolds(ctx, req.(*HoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BookAndBorrowService_ServiceDesc is the grpc.ServiceDesc for BookAndBorrowService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2040,10 +3346,110 @@ var BookAndBorrowService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBorrow",
 			Handler:    _BookAndBorrowService_DeleteBorrow_Handler,
 		},
+		{
+			MethodName: "RenewBorrow",
+			Handler:    _BookAndBorrowService_RenewBorrow_Handler,
+		},
+		{
+			MethodName: "GetUserFines",
+			Handler:    _BookAndBorrowService_GetUserFines_Handler,
+		},
+		{
+			MethodName: "PayFine",
+			Handler:    _BookAndBorrowService_PayFine_Handler,
+		},
+		{
+			MethodName: "WaiveFine",
+			Handler:    _BookAndBorrowService_WaiveFine_Handler,
+		},
+		{
+			MethodName: "GetNotificationHistory",
+			Handler:    _BookAndBorrowService_GetNotificationHistory_Handler,
+		},
+		{
+			MethodName: "CreateItem",
+			Handler:    _BookAndBorrowService_CreateItem_Handler,
+		},
+		{
+			MethodName: "ListItems",
+			Handler:    _BookAndBorrowService_ListItems_Handler,
+		},
+		{
+			MethodName: "MarkItemLost",
+			Handler:    _BookAndBorrowService_MarkItemLost_Handler,
+		},
+		{
+			MethodName: "MarkItemDamaged",
+			Handler:    _BookAndBorrowService_MarkItemDamaged_Handler,
+		},
+		{
+			MethodName: "CreateBranch",
+			Handler:    _BookAndBorrowService_CreateBranch_Handler,
+		},
+		{
+			MethodName: "GetBranches",
+			Handler:    _BookAndBorrowService_GetBranches_Handler,
+		},
+		{
+			MethodName: "GetCalendar",
+			Handler:    _BookAndBorrowService_GetCalendar_Handler,
+		},
+		{
+			MethodName: "SetOpeningHours",
+			Handler:    _BookAndBorrowService_SetOpeningHours_Handler,
+		},
+		{
+			MethodName: "AddHoliday",
+			Handler:    _BookAndBorrowService_AddHoliday_Handler,
+		},
+		{
+			MethodName: "DeleteHoliday",
+			Handler:    _BookAndBorrowService_DeleteHoliday_Handler,
+		},
+		{
+			MethodName: "RequestTransfer",
+			Handler:    _BookAndBorrowService_RequestTransfer_Handler,
+		},
+		{
+			MethodName: "ShipTransfer",
+			Handler:    _BookAndBorrowService_ShipTransfer_Handler,
+		},
+		{
+			MethodName: "ReceiveTransfer",
+			Handler:    _BookAndBorrowService_ReceiveTransfer_Handler,
+		},
+		{
+			MethodName: "ListTransfers",
+			Handler:    _BookAndBorrowService_ListTransfers_Handler,
+		},
+		{
+			MethodName: "GetCirculationPolicies",
+			Handler:    _BookAndBorrowService_GetCirculationPolicies_Handler,
+		},
+		{
+			MethodName: "SetCirculationPolicy",
+			Handler:    _BookAndBorrowService_SetCirculationPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteCirculationPolicy",
+			Handler:    _BookAndBorrowService_DeleteCirculationPolicy_Handler,
+		},
 		{
 			MethodName: "GetBookRecommendations",
 			Handler:    _BookAndBorrowService_GetBookRecommendations_Handler,
 		},
+		{
+			MethodName: "PlaceHold",
+			Handler:    _BookAndBorrowService_PlaceHold_Handler,
+		},
+		{
+			MethodName: "CancelHold",
+			Handler:    _BookAndBorrowService_CancelHold_Handler,
+		},
+		{
+			MethodName: "ListHolds",
+			Handler:    _BookAndBorrowService_ListHolds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/protos.proto",
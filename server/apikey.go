@@ -0,0 +1,278 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	logger "gogrpc-rpc-boiler/server/log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// API keys look like lbk_<random>, the prefix length shown in listings
+const (
+	apiKeyPrefix    = "lbk_"
+	apiKeyShownChar = 12
+)
+
+// methods that need a real login session, API keys can never call them
+var sessionOnlyMethods = map[string]bool{
+	proto.UserService_Logout_FullMethodName:                 true,
+	proto.UserService_ChangePassword_FullMethodName:         true,
+	proto.UserService_DeleteUser_FullMethodName:             true,
+	proto.UserService_CreateAPIKey_FullMethodName:           true,
+	proto.UserService_RevokeAPIKey_FullMethodName:           true,
+	proto.UtilService_AuthWithoutCredentials_FullMethodName: true,
+}
+
+// "/protos.BookAndBorrowService/GetBooks" --> "BookAndBorrowService", "GetBooks"
+func splitMethod(fullMethod string) (string, string) {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if i := strings.LastIndex(service, "."); i >= 0 {
+		service = service[i+1:]
+	}
+	return service, method
+}
+
+func isValidScope(scope string) bool {
+	if scope == "*" {
+		return true
+	}
+	for fullMethod := range rpcPolicy {
+		service, method := splitMethod(fullMethod)
+		if scope == service || scope == service+"/"+method {
+			return true
+		}
+	}
+	return false
+}
+
+// checks an API key caller against the key's scopes, inter-service calls only against sessionOnlyMethods
+func authorizeAPIKey(fullMethod string, caller *principal, internal bool) error {
+	if sessionOnlyMethods[fullMethod] {
+		return status.Errorf(codes.PermissionDenied, "%s cannot be called with an API key", fullMethod)
+	}
+	if internal {
+		return nil
+	}
+	service, method := splitMethod(fullMethod)
+	for _, scope := range caller.Scopes {
+		if scope == "*" || scope == service || scope == service+"/"+method {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "API key is not scoped for %s", fullMethod)
+}
+
+// the inter-service calls a handler makes to this server forward the caller's authorization and carry
+// a secret of this process under internalCallHeader. an API key's scopes are checked on the RPC it called,
+// not again on the calls made to serve it, so a key scoped for CreateBorrow needs no UserService scope
+const internalCallHeader = "x-internal-call"
+
+var internalCallSecret = func() string {
+	secret, _, err := newOpaqueToken()
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to generate the internal call secret, scopes apply to inter-service calls: %v", err))
+		return ""
+	}
+	return secret
+}()
+
+// the incoming metadata to forward on an inter-service call
+func internalCallMetadata(md metadata.MD) metadata.MD {
+	md = md.Copy()
+	md.Set(internalCallHeader, internalCallSecret)
+	return md
+}
+
+func isInternalCall(ctx context.Context) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(internalCallHeader)
+	return internalCallSecret != "" && len(values) == 1 && subtle.ConstantTimeCompare([]byte(values[0]), []byte(internalCallSecret)) == 1
+}
+
+// resolves an "ApiKey" authorization to the key's principal
+func (s *server) validateAPIKey(ctx context.Context, key string) (*principal, error) {
+	if !strings.HasPrefix(key, apiKeyPrefix) || s.apiKeys == nil {
+		return nil, fmt.Errorf("invalid API key")
+	}
+
+	record, err := s.apiKeys.GetByHash(ctx, hashOpaqueToken(key))
+	if err == errNotFound {
+		return nil, fmt.Errorf("invalid API key")
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to check API key: %v", err)
+	}
+
+	now := time.Now().UTC()
+	if !record.RevokedAt.IsZero() {
+		return nil, fmt.Errorf("API key %d has been revoked", record.KeyID)
+	}
+	if !record.ExpiresAt.IsZero() && now.After(record.ExpiresAt) {
+		return nil, fmt.Errorf("API key %d has expired", record.KeyID)
+	}
+	// a key never outranks its owner, e.g. after the owner was demoted
+	if roleRank[record.Role] > roleRank[record.OwnerRole] {
+		return nil, fmt.Errorf("API key %d outranks its owner", record.KeyID)
+	}
+
+	if err := s.apiKeys.Touch(ctx, record.KeyID, now); err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to update last_used_at of API key %d: %v", record.KeyID, err))
+	}
+
+	return &principal{
+		UserID:   record.UserID,
+		Username: record.Username,
+		Role:     record.Role,
+		APIKeyID: record.KeyID,
+		Scopes:   record.Scopes,
+	}, nil
+}
+
+func formatNullTime(t sql.NullTime) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.Format("2006-01-02 15:04:05")
+}
+
+// formats an optional time of an API key, empty when unset
+func formatOptionalTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02 15:04:05")
+}
+
+func apiKeyToProto(key apiKeyRecord) *proto.APIKey {
+	return &proto.APIKey{
+		KeyId:      int32(key.KeyID),
+		UserId:     int32(key.UserID),
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Role:       key.Role,
+		Scopes:     key.Scopes,
+		CreatedAt:  key.CreatedAt.Format("2006-01-02 15:04:05"),
+		LastUsedAt: formatOptionalTime(key.LastUsedAt),
+		ExpiresAt:  formatOptionalTime(key.ExpiresAt),
+		RevokedAt:  formatOptionalTime(key.RevokedAt),
+	}
+}
+
+func (s *server) CreateAPIKey(ctx context.Context, req *proto.APIKeyRequest) (*proto.APIKeyCreated, error) {
+	caller := callerFromContext(ctx)
+
+	if req.Name == "" || len(req.Scopes) == 0 {
+		logger.LogThis("[ERROR] name and scopes are required [Insufficient Input]")
+		return nil, fmt.Errorf("name and scopes are required [Insufficient Input]")
+	}
+
+	role := req.Role
+	if role == "" {
+		role = caller.Role
+	}
+	if !isValidRole(role) {
+		logger.LogThis(fmt.Sprintf("[ERROR] invalid role: %s", role))
+		return nil, fmt.Errorf("invalid role: %s", role)
+	}
+	if roleRank[role] > roleRank[caller.Role] {
+		logger.LogThis(fmt.Sprintf("[ERROR] %s tried to create a %s API key", caller.Username, role))
+		return nil, status.Errorf(codes.PermissionDenied, "cannot create an API key with role %s", role)
+	}
+
+	for _, scope := range req.Scopes {
+		if strings.Contains(scope, ",") || !isValidScope(scope) {
+			logger.LogThis(fmt.Sprintf("[ERROR] invalid scope: %s", scope))
+			return nil, fmt.Errorf("invalid scope: %s", scope)
+		}
+	}
+
+	var expiresAt time.Time
+	if req.ExpiresAt != "" {
+		parsed, err := time.Parse("2006-01-02", req.ExpiresAt)
+		if err != nil {
+			logger.LogThis(fmt.Sprintf("[ERROR] failed to parse expires_at: %v", err))
+			return nil, fmt.Errorf("failed to parse expires_at: %v", err)
+		}
+		if !parsed.After(time.Now().UTC()) {
+			logger.LogThis("[ERROR] expires_at must be in the future")
+			return nil, fmt.Errorf("expires_at must be in the future")
+		}
+		expiresAt = parsed
+	}
+
+	secret, _, err := newOpaqueToken()
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to generate API key: %v", err))
+		return nil, fmt.Errorf("failed to generate API key: %v", err)
+	}
+	apiKey := apiKeyPrefix + secret
+
+	key := apiKeyRecord{
+		UserID:    caller.UserID,
+		Name:      req.Name,
+		Prefix:    apiKey[:apiKeyShownChar],
+		Role:      role,
+		Scopes:    req.Scopes,
+		CreatedAt: time.Now().UTC(),
+		ExpiresAt: expiresAt,
+	}
+	key.KeyID, err = s.apiKeys.Create(ctx, key, hashOpaqueToken(apiKey))
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to insert API key: %v", err))
+		return nil, fmt.Errorf("failed to insert API key: %v", err)
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] %s created API key %d (%s), role: %s, scopes: %s", caller.Username, key.KeyID, req.Name, role, strings.Join(req.Scopes, ",")))
+
+	return &proto.APIKeyCreated{Key: apiKeyToProto(key), ApiKey: apiKey}, nil
+}
+
+func (s *server) ListAPIKeys(ctx context.Context, req *emptypb.Empty) (*proto.APIKeys, error) {
+	caller := callerFromContext(ctx)
+
+	records, err := s.apiKeys.List(ctx, caller.UserID)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to get API keys: %v", err))
+		return nil, fmt.Errorf("failed to get API keys: %v", err)
+	}
+	var keys []*proto.APIKey
+	for _, record := range records {
+		keys = append(keys, apiKeyToProto(record))
+	}
+
+	return &proto.APIKeys{Keys: keys}, nil
+}
+
+func (s *server) RevokeAPIKey(ctx context.Context, req *proto.IntRequest) (*proto.StringResponse, error) {
+	caller := callerFromContext(ctx)
+
+	key, err := s.apiKeys.Get(ctx, int(req.RequestInt))
+	if err == errNotFound {
+		logger.LogThis(fmt.Sprintf("[ERROR] API key %d not found", req.RequestInt))
+		return nil, status.Errorf(codes.NotFound, "API key %d not found", req.RequestInt)
+	} else if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to get API key: %v", err))
+		return nil, fmt.Errorf("failed to get API key: %v", err)
+	}
+
+	// only the owner or an admin can revoke a key
+	if err := requireSelfOrRole(caller, key.UserID, adminRoles); err != nil {
+		return nil, err
+	}
+
+	if err := s.apiKeys.Revoke(ctx, key.KeyID, time.Now().UTC()); err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to revoke API key: %v", err))
+		return nil, fmt.Errorf("failed to revoke API key: %v", err)
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] %s revoked API key %d", caller.Username, req.RequestInt))
+
+	return &proto.StringResponse{ResponseStr: "API key revoked successfully"}, nil
+}
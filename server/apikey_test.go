@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	"gogrpc-rpc-boiler/server/models"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// a server with API keys in memory, and the member owning the keys
+func newTestAPIKeyServer(t *testing.T) (*server, *principal) {
+	m := newMemoryDB()
+	s := &server{users: &memoryUserStore{m: m}, apiKeys: &memoryAPIKeyStore{m: m}}
+	userID, err := s.users.Create(context.Background(), models.UserSensitive{Username: "batch", Email: "batch@example.com", Role: RoleMember})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, &principal{UserID: userID, Username: "batch", Role: RoleMember, SessionID: 1}
+}
+
+// runs the interceptor for the method with the metadata, the error it answers with
+func callWithMetadata(s *server, md metadata.MD, fullMethod string) error {
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	_, err := s.authInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: fullMethod}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	return err
+}
+
+func TestAPIKeyIsScopedAndRevoked(t *testing.T) {
+	s, owner := newTestAPIKeyServer(t)
+	ownerCtx := context.WithValue(context.Background(), principalKey{}, owner)
+
+	created, err := s.CreateAPIKey(ownerCtx, &proto.APIKeyRequest{Name: "nightly", Scopes: []string{"BookAndBorrowService/CreateBorrow"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	md := metadata.Pairs("authorization", "ApiKey "+created.ApiKey)
+
+	if err := callWithMetadata(s, md, proto.BookAndBorrowService_CreateBorrow_FullMethodName); err != nil {
+		t.Fatalf("call in scope: %v", err)
+	}
+	if err := callWithMetadata(s, md, proto.UserService_DoesUserExist_FullMethodName); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("call out of scope: got %v, want PermissionDenied", err)
+	}
+	// CreateBorrow asks UserService about the borrower with the caller's authorization
+	if err := callWithMetadata(s, internalCallMetadata(md), proto.UserService_DoesUserExist_FullMethodName); err != nil {
+		t.Fatalf("inter-service call for the key: %v", err)
+	}
+	forged := metadata.Join(md, metadata.Pairs(internalCallHeader, "forged"))
+	if err := callWithMetadata(s, forged, proto.UserService_DoesUserExist_FullMethodName); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("call with a forged internal header: got %v, want PermissionDenied", err)
+	}
+	if err := callWithMetadata(s, internalCallMetadata(md), proto.UserService_ChangePassword_FullMethodName); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("inter-service call of a session-only method: got %v, want PermissionDenied", err)
+	}
+
+	if _, err := s.RevokeAPIKey(ownerCtx, &proto.IntRequest{RequestInt: created.Key.KeyId}); err != nil {
+		t.Fatal(err)
+	}
+	if err := callWithMetadata(s, md, proto.BookAndBorrowService_CreateBorrow_FullMethodName); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("call with a revoked key: got %v, want Unauthenticated", err)
+	}
+	if err := callWithMetadata(s, internalCallMetadata(md), proto.UserService_DoesUserExist_FullMethodName); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("inter-service call with a revoked key: got %v, want Unauthenticated", err)
+	}
+}
+
+func TestAPIKeyScopedForBorrowingCanBorrow(t *testing.T) {
+	useTestKeyring(t)
+	s, _, userID := newTestServer(t)
+	useTestInterService(t, s)
+	ctx := context.Background()
+
+	if _, err := s.branches.Create(ctx, "main", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.books.Create(ctx, models.Book{Title: "batch"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.books.AddItem(ctx, &proto.Item{BookId: 1, Barcode: "K1", Condition: ConditionGood, BranchId: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.users.VerifyEmail(ctx, userID, "reader@example.com", time.Now().UTC()); err != nil {
+		t.Fatal(err)
+	}
+
+	owner := &principal{UserID: userID, Username: "reader", Role: RoleMember, SessionID: 1}
+	created, err := s.CreateAPIKey(context.WithValue(ctx, principalKey{}, owner), &proto.APIKeyRequest{Name: "kiosk", Scopes: []string{"BookAndBorrowService/CreateBorrow"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// CreateBorrow asks UserService about the borrower, the key has no UserService scope
+	client := proto.NewBookAndBorrowServiceClient(interServiceConn)
+	keyCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "ApiKey "+created.ApiKey)
+	if _, err := client.CreateBorrow(keyCtx, &proto.Borrow{UserId: int32(userID), Barcode: "K1"}); err != nil {
+		t.Fatalf("borrow with a key scoped for CreateBorrow = %v", err)
+	}
+	if _, err := client.GetBorrowingsByUserID(keyCtx, &proto.IntRequest{RequestInt: int32(userID)}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("call out of the key's scope = %v, want PermissionDenied", err)
+	}
+}
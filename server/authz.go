@@ -0,0 +1,210 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	proto "gogrpc-rpc-boiler/proto"
+	logger "gogrpc-rpc-boiler/server/log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// roles stored in users.role
+const (
+	RoleAdmin     = "admin"
+	RoleLibrarian = "librarian"
+	RoleMember    = "user" // default users.role, a regular library member
+)
+
+var (
+	anyRole    = []string{RoleAdmin, RoleLibrarian, RoleMember}
+	staffRoles = []string{RoleAdmin, RoleLibrarian}
+	adminRoles = []string{RoleAdmin}
+)
+
+// higher ranks include the permissions of lower ones
+var roleRank = map[string]int{
+	RoleMember:    1,
+	RoleLibrarian: 2,
+	RoleAdmin:     3,
+}
+
+func isValidRole(role string) bool {
+	return hasRole(anyRole, role)
+}
+
+func hasRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// methods that can be called without a token
+var publicMethods = map[string]bool{
+	proto.UserService_CreateUser_FullMethodName:           true,
+	proto.UserService_LoginAuth_FullMethodName:            true,
+	proto.UserService_RefreshToken_FullMethodName:         true,
+	proto.UserService_RequestPasswordReset_FullMethodName: true,
+	proto.UserService_ConfirmPasswordReset_FullMethodName: true,
+	proto.UserService_VerifyEmail_FullMethodName:          true,
+	proto.UtilService_GetJWKS_FullMethodName:              true,
+}
+
+// per-RPC policy, roles allowed to call each method.
+// methods missing from this table are denied.
+// ownership rules (e.g. members only touching their own borrowings) are checked in the handlers.
+var rpcPolicy = map[string][]string{
+	// util
+	proto.UtilService_HelloWorld_FullMethodName:             anyRole,
+	proto.UtilService_Ping_FullMethodName:                   anyRole,
+	proto.UtilService_AuthWithoutCredentials_FullMethodName: adminRoles,
+
+	// user
+	proto.UserService_Logout_FullMethodName:             anyRole,
+	proto.UserService_ChangePassword_FullMethodName:     anyRole, // self, or admin
+	proto.UserService_DeleteUser_FullMethodName:         anyRole, // self, or admin
+	proto.UserService_GetUser_FullMethodName:            anyRole, // self, or staff
+	proto.UserService_DoesUserExist_FullMethodName:      anyRole,
+	proto.UserService_UnlockAccount_FullMethodName:      adminRoles,
+	proto.UserService_ResendVerification_FullMethodName: anyRole,
+	proto.UserService_IsEmailVerified_FullMethodName:    anyRole, // self, or staff
+	proto.UserService_CreateAPIKey_FullMethodName:       anyRole, // own keys, role at most the caller's
+	proto.UserService_ListAPIKeys_FullMethodName:        anyRole, // own keys
+	proto.UserService_RevokeAPIKey_FullMethodName:       anyRole, // own keys, or admin
+
+	// category
+	proto.CategoryService_CreateCategory_FullMethodName:      staffRoles,
+	proto.CategoryService_GetCategories_FullMethodName:       anyRole,
+	proto.CategoryService_GetCategoriesByName_FullMethodName: anyRole,
+	proto.CategoryService_GetCategoryByID_FullMethodName:     anyRole,
+	proto.CategoryService_EditCategory_FullMethodName:        staffRoles,
+	proto.CategoryService_DeleteCategory_FullMethodName:      staffRoles,
+	proto.CategoryService_DoesCategoryExist_FullMethodName:   anyRole,
+
+	// author
+	proto.AuthorService_CreateAuthor_FullMethodName:     staffRoles,
+	proto.AuthorService_GetAuthors_FullMethodName:       anyRole,
+	proto.AuthorService_GetAuthorsByName_FullMethodName: anyRole,
+	proto.AuthorService_GetAuthorByID_FullMethodName:    anyRole,
+	proto.AuthorService_EditAuthor_FullMethodName:       staffRoles,
+	proto.AuthorService_DeleteAuthor_FullMethodName:     staffRoles,
+	proto.AuthorService_DoesAuthorExist_FullMethodName:  anyRole,
+
+	// book
+	proto.BookAndBorrowService_IsAuthorInUseByBook_FullMethodName:    anyRole,
+	proto.BookAndBorrowService_IsCategoryInUseByBook_FullMethodName:  anyRole,
+	proto.BookAndBorrowService_CreateBook_FullMethodName:             staffRoles,
+	proto.BookAndBorrowService_GetBooks_FullMethodName:               anyRole,
+	proto.BookAndBorrowService_GetBooksByDate_FullMethodName:         anyRole,
+	proto.BookAndBorrowService_GetBooksByName_FullMethodName:         anyRole,
+	proto.BookAndBorrowService_GetBookByID_FullMethodName:            anyRole,
+	proto.BookAndBorrowService_EditBook_FullMethodName:               staffRoles,
+	proto.BookAndBorrowService_DeleteBook_FullMethodName:             staffRoles,
+	proto.BookAndBorrowService_GetBookRecommendations_FullMethodName: anyRole,
+
+	// borrow
+	proto.BookAndBorrowService_DoesUserStillBorrow_FullMethodName:     anyRole,
+	proto.BookAndBorrowService_CreateBorrow_FullMethodName:            anyRole, // members borrow for themselves
+	proto.BookAndBorrowService_CreateReturn_FullMethodName:            staffRoles,
+	proto.BookAndBorrowService_GetBorrowings_FullMethodName:           staffRoles,
+	proto.BookAndBorrowService_GetBorrowingsByDate_FullMethodName:     staffRoles,
+	proto.BookAndBorrowService_GetBorrowingsByUserID_FullMethodName:   anyRole, // self, or staff
+	proto.BookAndBorrowService_GetReturns_FullMethodName:              staffRoles,
+	proto.BookAndBorrowService_GetReturnsByDate_FullMethodName:        staffRoles,
+	proto.BookAndBorrowService_GetReturnsByUserID_FullMethodName:      anyRole, // self, or staff
+	proto.BookAndBorrowService_GetOverdues_FullMethodName:             staffRoles,
+	proto.BookAndBorrowService_EditBorrow_FullMethodName:              staffRoles,
+	proto.BookAndBorrowService_DeleteBorrow_FullMethodName:            staffRoles,
+	proto.BookAndBorrowService_RenewBorrow_FullMethodName:             anyRole, // own borrows, or staff
+	proto.BookAndBorrowService_GetUserFines_FullMethodName:            anyRole, // self, or staff
+	proto.BookAndBorrowService_PayFine_FullMethodName:                 staffRoles,
+	proto.BookAndBorrowService_WaiveFine_FullMethodName:               staffRoles,
+	proto.BookAndBorrowService_CreateItem_FullMethodName:              staffRoles,
+	proto.BookAndBorrowService_ListItems_FullMethodName:               anyRole,
+	proto.BookAndBorrowService_MarkItemLost_FullMethodName:            staffRoles,
+	proto.BookAndBorrowService_MarkItemDamaged_FullMethodName:         staffRoles,
+	proto.BookAndBorrowService_GetNotificationHistory_FullMethodName:  anyRole,
+	proto.BookAndBorrowService_CreateBranch_FullMethodName:            adminRoles,
+	proto.BookAndBorrowService_GetCalendar_FullMethodName:             anyRole,
+	proto.BookAndBorrowService_SetOpeningHours_FullMethodName:         adminRoles,
+	proto.BookAndBorrowService_AddHoliday_FullMethodName:              adminRoles,
+	proto.BookAndBorrowService_DeleteHoliday_FullMethodName:           adminRoles,
+	proto.BookAndBorrowService_GetBranches_FullMethodName:             anyRole,
+	proto.BookAndBorrowService_RequestTransfer_FullMethodName:         staffRoles,
+	proto.BookAndBorrowService_ShipTransfer_FullMethodName:            staffRoles,
+	proto.BookAndBorrowService_ReceiveTransfer_FullMethodName:         staffRoles,
+	proto.BookAndBorrowService_ListTransfers_FullMethodName:           staffRoles,
+	proto.BookAndBorrowService_GetCirculationPolicies_FullMethodName:  anyRole,
+	proto.BookAndBorrowService_SetCirculationPolicy_FullMethodName:    adminRoles,
+	proto.BookAndBorrowService_DeleteCirculationPolicy_FullMethodName: adminRoles,
+	proto.BookAndBorrowService_PlaceHold_FullMethodName:               anyRole, // self, or staff
+	proto.BookAndBorrowService_CancelHold_FullMethodName:              anyRole, // own holds, or staff
+	proto.BookAndBorrowService_ListHolds_FullMethodName:               anyRole, // own holds, or staff
+}
+
+// checks the caller's role against rpcPolicy
+func authorize(method string, role string) error {
+	roles, ok := rpcPolicy[method]
+	if !ok || !hasRole(roles, role) {
+		return status.Errorf(codes.PermissionDenied, "role %q is not allowed to call %s", role, method)
+	}
+	return nil
+}
+
+type principalKey struct{}
+
+// returns the caller authenticated by authInterceptor
+func callerFromContext(ctx context.Context) *principal {
+	caller, _ := ctx.Value(principalKey{}).(*principal)
+	return caller
+}
+
+// unary interceptor, authenticates every non-public call, puts the caller into the context
+// and rejects calls the caller's role is not allowed to make before the handler runs
+func (s *server) authInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := checkStoreSupports(info.FullMethod); err != nil {
+		return nil, err
+	}
+
+	if publicMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	caller, err := s.validateJWT(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if err := authorize(info.FullMethod, caller.Role); err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] permission denied, user: %s, role: %s, method: %s", caller.Username, caller.Role, info.FullMethod))
+		return nil, err
+	}
+
+	if caller.APIKeyID != 0 {
+		if err := authorizeAPIKey(info.FullMethod, caller, isInternalCall(ctx)); err != nil {
+			logger.LogThis(fmt.Sprintf("[ERROR] permission denied, API key %d of %s, method: %s", caller.APIKeyID, caller.Username, info.FullMethod))
+			return nil, err
+		}
+	}
+
+	if caller.Impersonator != "" {
+		logger.LogThis(fmt.Sprintf("[INFO] impersonated call, %s as %s, method: %s", caller.Impersonator, caller.Username, info.FullMethod))
+	}
+
+	return handler(context.WithValue(ctx, principalKey{}, caller), req)
+}
+
+// ownership check, members may only act on their own user_id
+func requireSelfOrRole(caller *principal, userID int, roles []string) error {
+	if caller.UserID == userID || hasRole(roles, caller.Role) {
+		return nil
+	}
+	logger.LogThis(fmt.Sprintf("[ERROR] permission denied, user %s tried to access user_id %d", caller.Username, userID))
+	return status.Errorf(codes.PermissionDenied, "not allowed to access user_id %d", userID)
+}
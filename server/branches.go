@@ -0,0 +1,349 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	logger "gogrpc-rpc-boiler/server/log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// transfer lifecycle: requested (the copy is set aside at the sending branch) --> in_transit (shipped)
+// --> received (on the receiving branch's shelf, or its hold shelf when sent for a hold).
+// a copy lost on the way cancels its transfer
+const (
+	TransferRequested = "requested"
+	TransferInTransit = "in_transit"
+	TransferReceived  = "received"
+	TransferCancelled = "cancelled"
+)
+
+var (
+	errBranchNotFound   = status.Error(codes.NotFound, "branch does not exist")
+	errTransferNotFound = status.Error(codes.NotFound, "transfer does not exist")
+)
+
+// errBranchNotFound unless the branch exists
+func checkBranch(ctx context.Context, q interface {
+	QueryRowContext(context.Context, string, ...any) *sql.Row
+}, branchID int) error {
+	var scan int
+	err := q.QueryRowContext(ctx, "SELECT 1 FROM branches WHERE branch_id = $1", branchID).Scan(&scan)
+	if err == sql.ErrNoRows {
+		return errBranchNotFound
+	} else if err != nil {
+		return fmt.Errorf("failed to check branch: %v", err)
+	}
+	return nil
+}
+
+// records that a copy now sits at the branch, the book must already be locked
+func moveItem(ctx context.Context, tx *sql.Tx, itemID int, branchID int) error {
+	_, err := tx.ExecContext(ctx, "UPDATE items SET branch_id = $1, updated_at = $2 WHERE item_id = $3", branchID, time.Now().UTC(), itemID)
+	if err != nil {
+		return fmt.Errorf("failed to move item: %v", err)
+	}
+	return nil
+}
+
+// opens a transfer of the copy, holdID is 0 unless the copy is sent for a hold.
+// the caller sets the copy in_transit
+func createTransfer(ctx context.Context, tx *sql.Tx, itemID int, fromBranchID int, toBranchID int, holdID int) (int, error) {
+	var transferID int
+	err := tx.QueryRowContext(ctx, "INSERT INTO transfers (item_id, from_branch_id, to_branch_id, hold_id, status, requested_at) VALUES ($1, $2, $3, NULLIF($4, 0), $5, $6) RETURNING transfer_id",
+		itemID, fromBranchID, toBranchID, holdID, TransferRequested, time.Now().UTC()).Scan(&transferID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create transfer: %v", err)
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] transfer %d of item %d requested from branch %d to branch %d", transferID, itemID, fromBranchID, toBranchID))
+	return transferID, nil
+}
+
+// sends the copy to the pickup branch of the hold, the hold waits in transit until the copy is received
+func routeToHold(ctx context.Context, tx *sql.Tx, holdID int, itemID int, fromBranchID int, toBranchID int) error {
+	_, err := tx.ExecContext(ctx, "UPDATE holds SET status = $1, item_id = $2 WHERE hold_id = $3", HoldInTransit, itemID, holdID)
+	if err != nil {
+		return fmt.Errorf("failed to update hold: %v", err)
+	}
+	_, err = createTransfer(ctx, tx, itemID, fromBranchID, toBranchID, holdID)
+	return err
+}
+
+// cancels the open transfers of a copy that left circulation
+func cancelTransfers(ctx context.Context, tx *sql.Tx, itemID int) error {
+	_, err := tx.ExecContext(ctx, "UPDATE transfers SET status = $1 WHERE item_id = $2 AND status IN ($3, $4)",
+		TransferCancelled, itemID, TransferRequested, TransferInTransit)
+	if err != nil {
+		return fmt.Errorf("failed to cancel transfers: %v", err)
+	}
+	return nil
+}
+
+const transferColumns = "t.transfer_id, t.item_id, i.barcode, i.book_id, t.from_branch_id, t.to_branch_id, COALESCE(t.hold_id, 0), t.status, t.requested_at, t.shipped_at, t.received_at"
+
+func scanTransfer(scanner interface{ Scan(...any) error }) (*proto.Transfer, error) {
+	var transfer proto.Transfer
+	var requestedAt time.Time
+	var shippedAt, receivedAt sql.NullTime
+	err := scanner.Scan(&transfer.TransferId, &transfer.ItemId, &transfer.Barcode, &transfer.BookId, &transfer.FromBranchId, &transfer.ToBranchId,
+		&transfer.HoldId, &transfer.Status, &requestedAt, &shippedAt, &receivedAt)
+	if err != nil {
+		return nil, err
+	}
+	transfer.RequestedAt = requestedAt.Format("2006-01-02 15:04:05")
+	transfer.ShippedAt = formatNullTime(shippedAt)
+	transfer.ReceivedAt = formatNullTime(receivedAt)
+	return &transfer, nil
+}
+
+func getTransfer(ctx context.Context, tx *sql.Tx, transferID int) (*proto.Transfer, error) {
+	transfer, err := scanTransfer(tx.QueryRowContext(ctx, "SELECT "+transferColumns+" FROM transfers t JOIN items i ON i.item_id = t.item_id WHERE t.transfer_id = $1", transferID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transfer: %v", err)
+	}
+	return transfer, nil
+}
+
+// marks a requested transfer shipped
+func shipTransfer(ctx context.Context, db *sql.DB, transferID int) (*proto.Transfer, error) {
+	var transfer *proto.Transfer
+	err := inTx(ctx, db, func(tx *sql.Tx) error {
+		var transferStatus string
+		err := tx.QueryRowContext(ctx, "SELECT status FROM transfers WHERE transfer_id = $1 FOR UPDATE", transferID).Scan(&transferStatus)
+		if err == sql.ErrNoRows {
+			return errTransferNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to get transfer: %v", err)
+		}
+		if transferStatus != TransferRequested {
+			return status.Errorf(codes.FailedPrecondition, "transfer is %s, not %s", transferStatus, TransferRequested)
+		}
+		_, err = tx.ExecContext(ctx, "UPDATE transfers SET status = $1, shipped_at = $2 WHERE transfer_id = $3", TransferInTransit, time.Now().UTC(), transferID)
+		if err != nil {
+			return fmt.Errorf("failed to ship transfer: %v", err)
+		}
+		transfer, err = getTransfer(ctx, tx, transferID)
+		return err
+	})
+	return transfer, err
+}
+
+// checks a shipped copy in at the receiving branch. a copy sent for a hold that is still waiting for it
+// goes on the hold shelf, any other copy is put back there like a returned one
+func receiveTransfer(ctx context.Context, db *sql.DB, transferID int) (*proto.Transfer, error) {
+	var transfer *proto.Transfer
+	err := inTx(ctx, db, func(tx *sql.Tx) error {
+		var bookID int
+		err := tx.QueryRowContext(ctx, "SELECT i.book_id FROM transfers t JOIN items i ON i.item_id = t.item_id WHERE t.transfer_id = $1", transferID).Scan(&bookID)
+		if err == sql.ErrNoRows {
+			return errTransferNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to get transfer: %v", err)
+		}
+		if err := lockBook(ctx, tx, bookID); err != nil {
+			return err
+		}
+
+		var itemID, toBranchID int
+		var holdID sql.NullInt64
+		var transferStatus string
+		err = tx.QueryRowContext(ctx, "SELECT item_id, to_branch_id, hold_id, status FROM transfers WHERE transfer_id = $1 FOR UPDATE", transferID).Scan(&itemID, &toBranchID, &holdID, &transferStatus)
+		if err != nil {
+			return fmt.Errorf("failed to get transfer: %v", err)
+		}
+		if transferStatus != TransferInTransit {
+			return status.Errorf(codes.FailedPrecondition, "transfer is %s, not %s", transferStatus, TransferInTransit)
+		}
+		_, err = tx.ExecContext(ctx, "UPDATE transfers SET status = $1, received_at = $2 WHERE transfer_id = $3", TransferReceived, time.Now().UTC(), transferID)
+		if err != nil {
+			return fmt.Errorf("failed to receive transfer: %v", err)
+		}
+
+		var condition string
+		if err := tx.QueryRowContext(ctx, "SELECT condition FROM items WHERE item_id = $1 FOR UPDATE", itemID).Scan(&condition); err != nil {
+			return fmt.Errorf("failed to get item: %v", err)
+		}
+		if err := moveItem(ctx, tx, itemID, toBranchID); err != nil {
+			return err
+		}
+
+		ready := false
+		if holdID.Valid && condition != ConditionDamaged {
+			if ready, err = readyHold(ctx, tx, int(holdID.Int64), itemID); err != nil {
+				return err
+			}
+		}
+		if ready {
+			if err := setItemStatus(ctx, tx, itemID, ItemOnHold); err != nil {
+				return err
+			}
+			if err := syncStock(ctx, tx, bookID); err != nil {
+				return err
+			}
+		} else {
+			// the hold was cancelled or fulfilled on the way, or the copy arrived damaged
+			if err := unreserveItem(ctx, tx, itemID); err != nil {
+				return err
+			}
+			if err := putBackCopy(ctx, tx, bookID, itemID); err != nil {
+				return err
+			}
+		}
+
+		transfer, err = getTransfer(ctx, tx, transferID)
+		return err
+	})
+	return transfer, err
+}
+
+func (s *server) CreateBranch(ctx context.Context, req *proto.Branch) (*proto.Branch, error) {
+	caller := callerFromContext(ctx)
+
+	if req.Name == "" {
+		logger.LogThis("[ERROR] name is required [Insufficient Input]")
+		return nil, fmt.Errorf("name is required [Insufficient Input]")
+	}
+
+	branch, err := s.branches.Create(ctx, req.Name, req.Address)
+	if err == errDuplicate {
+		logger.LogThis(fmt.Sprintf("[ERROR] branch %s already exists", req.Name))
+		return nil, status.Errorf(codes.AlreadyExists, "branch %s already exists", req.Name)
+	} else if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to create branch: %v", err))
+		return nil, fmt.Errorf("failed to create branch: %v", err)
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] %s created branch %d (%s)", caller.Username, branch.BranchId, branch.Name))
+
+	return branch, nil
+}
+
+func (s *server) GetBranches(ctx context.Context, req *emptypb.Empty) (*proto.Branches, error) {
+	branches, err := s.branches.List(ctx)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to get branches: %v", err))
+		return nil, fmt.Errorf("failed to get branches: %v", err)
+	}
+
+	return &proto.Branches{Branches: branches}, nil
+}
+
+// sets an available copy aside to be shipped to another branch
+func requestTransfer(ctx context.Context, db *sql.DB, barcode string, toBranchID int) (*proto.Transfer, error) {
+	var transfer *proto.Transfer
+	err := inTx(ctx, db, func(tx *sql.Tx) error {
+		if err := checkBranch(ctx, tx, toBranchID); err != nil {
+			return err
+		}
+		var bookID int
+		err := tx.QueryRowContext(ctx, "SELECT book_id FROM items WHERE barcode = $1", barcode).Scan(&bookID)
+		if err == sql.ErrNoRows {
+			return errItemNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to get item: %v", err)
+		}
+		if err := lockBook(ctx, tx, bookID); err != nil {
+			return err
+		}
+
+		var itemID int
+		var itemStatus string
+		var branchID sql.NullInt64
+		err = tx.QueryRowContext(ctx, "SELECT item_id, status, branch_id FROM items WHERE barcode = $1 FOR UPDATE", barcode).Scan(&itemID, &itemStatus, &branchID)
+		if err != nil {
+			return fmt.Errorf("failed to get item: %v", err)
+		}
+		if itemStatus != ItemAvailable {
+			return errItemUnavailable
+		}
+		if !branchID.Valid {
+			return status.Error(codes.FailedPrecondition, "item is not assigned to a branch")
+		}
+		if int(branchID.Int64) == toBranchID {
+			return status.Error(codes.FailedPrecondition, "item is already at that branch")
+		}
+
+		transferID, err := createTransfer(ctx, tx, itemID, int(branchID.Int64), toBranchID, 0)
+		if err != nil {
+			return err
+		}
+		if err := setItemStatus(ctx, tx, itemID, ItemInTransit); err != nil {
+			return err
+		}
+		if err := syncStock(ctx, tx, bookID); err != nil {
+			return err
+		}
+		transfer, err = getTransfer(ctx, tx, transferID)
+		return err
+	})
+	return transfer, err
+}
+
+// sets a copy aside for another branch by hand, e.g. to rebalance stock
+func (s *server) RequestTransfer(ctx context.Context, req *proto.TransferRequest) (*proto.Transfer, error) {
+	caller := callerFromContext(ctx)
+
+	if req.Barcode == "" || req.ToBranchId <= 0 {
+		logger.LogThis("[ERROR] barcode and to_branch_id are required [Insufficient Input]")
+		return nil, fmt.Errorf("barcode and to_branch_id are required [Insufficient Input]")
+	}
+
+	transfer, err := s.branches.RequestTransfer(ctx, req.Barcode, int(req.ToBranchId))
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to request transfer of %s: %v", req.Barcode, err))
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to request transfer: %v", err)
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] %s requested transfer %d of item %s to branch %d", caller.Username, transfer.TransferId, req.Barcode, req.ToBranchId))
+
+	return transfer, nil
+}
+
+func (s *server) ShipTransfer(ctx context.Context, req *proto.IntRequest) (*proto.Transfer, error) {
+	caller := callerFromContext(ctx)
+
+	transfer, err := s.branches.ShipTransfer(ctx, int(req.RequestInt))
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to ship transfer %d: %v", req.RequestInt, err))
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to ship transfer: %v", err)
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] %s shipped transfer %d", caller.Username, req.RequestInt))
+
+	return transfer, nil
+}
+
+func (s *server) ReceiveTransfer(ctx context.Context, req *proto.IntRequest) (*proto.Transfer, error) {
+	caller := callerFromContext(ctx)
+
+	transfer, err := s.branches.ReceiveTransfer(ctx, int(req.RequestInt))
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to receive transfer %d: %v", req.RequestInt, err))
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to receive transfer: %v", err)
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] %s received transfer %d at branch %d", caller.Username, req.RequestInt, transfer.ToBranchId))
+
+	return transfer, nil
+}
+
+func (s *server) ListTransfers(ctx context.Context, req *proto.TransferQuery) (*proto.Transfers, error) {
+	transfers, err := s.branches.ListTransfers(ctx, int(req.BranchId), req.Status)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to get transfers: %v", err))
+		return nil, fmt.Errorf("failed to get transfers: %v", err)
+	}
+
+	return &proto.Transfers{Transfers: transfers}, nil
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	logger "gogrpc-rpc-boiler/server/log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// opening calendar of the branches: weekly opening hours and holidays, branch_id 0 holds the defaults
+// for every branch. a weekday without hours counts as open, so a library without a calendar is always open
+
+// dates and weekdays of the calendar are in LIBRARY_TIMEZONE (default UTC)
+var libraryLocation = locationFromEnv("LIBRARY_TIMEZONE")
+
+func locationFromEnv(key string) *time.Location {
+	location, err := time.LoadLocation(os.Getenv(key))
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] invalid %s, using UTC: %v", key, err))
+		return time.UTC
+	}
+	return location
+}
+
+var errHolidayNotFound = status.Error(codes.NotFound, "holiday does not exist")
+
+type libraryCalendar struct {
+	closedWeekdays map[time.Weekday]bool
+	holidays       map[string]bool // YYYY-MM-DD
+}
+
+// the calendar of a branch, its own hours override the defaults per weekday and
+// both its holidays and the ones of every branch apply. branch 0 gets the defaults only
+func loadCalendar(ctx context.Context, q interface {
+	QueryContext(context.Context, string, ...any) (*sql.Rows, error)
+}, branchID int) (*libraryCalendar, error) {
+	calendar := &libraryCalendar{closedWeekdays: map[time.Weekday]bool{}, holidays: map[string]bool{}}
+
+	rows, err := q.QueryContext(ctx, "SELECT DISTINCT ON (weekday) weekday, closed FROM opening_hours WHERE branch_id IN (0, $1) ORDER BY weekday, branch_id DESC", branchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get opening hours: %v", err)
+	}
+	for rows.Next() {
+		var weekday int
+		var closed bool
+		if err := rows.Scan(&weekday, &closed); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan opening hours: %v", err)
+		}
+		calendar.closedWeekdays[time.Weekday(weekday)] = closed
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get opening hours: %v", err)
+	}
+
+	rows, err = q.QueryContext(ctx, "SELECT holiday_date FROM holidays WHERE branch_id IN (0, $1)", branchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get holidays: %v", err)
+	}
+	for rows.Next() {
+		var date time.Time
+		if err := rows.Scan(&date); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan holiday: %v", err)
+		}
+		calendar.holidays[date.Format("2006-01-02")] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get holidays: %v", err)
+	}
+	return calendar, nil
+}
+
+func (c *libraryCalendar) isOpen(t time.Time) bool {
+	local := t.In(libraryLocation)
+	return !c.closedWeekdays[local.Weekday()] && !c.holidays[local.Format("2006-01-02")]
+}
+
+// moves a due date on a closed day to the same time on the next open day,
+// unchanged when the library is not open on any day of the coming year
+func (c *libraryCalendar) nextOpenDay(due time.Time) time.Time {
+	for i := 0; i <= 366; i++ {
+		if day := due.AddDate(0, 0, i); c.isOpen(day) {
+			return day
+		}
+	}
+	return due
+}
+
+// whole days late like daysLate, counting only the days the library was open
+func (c *libraryCalendar) openDaysLate(returnDate time.Time, returnedDate time.Time) int {
+	late := daysLate(returnDate, returnedDate)
+	open := 0
+	for i := 1; i <= late; i++ {
+		if c.isOpen(returnDate.AddDate(0, 0, i)) {
+			open++
+		}
+	}
+	return open
+}
+
+// branch 0 is the default for every branch, any other branch must exist
+func checkCalendarBranch(ctx context.Context, branches BranchStore, branchID int) error {
+	if branchID < 0 {
+		return status.Error(codes.InvalidArgument, "branch_id must be 0 or a branch")
+	}
+	if branchID == 0 {
+		return nil
+	}
+	exists, err := branches.Exists(ctx, branchID)
+	if err != nil {
+		return fmt.Errorf("failed to check branch: %v", err)
+	}
+	if !exists {
+		return errBranchNotFound
+	}
+	return nil
+}
+
+func (s *server) GetCalendar(ctx context.Context, req *proto.IntRequest) (*proto.Calendar, error) {
+	branchID := int(req.RequestInt)
+	if err := checkCalendarBranch(ctx, s.branches, branchID); err != nil {
+		return nil, err
+	}
+	calendar, err := s.calendars.Get(ctx, branchID)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to get calendar: %v", err))
+		return nil, fmt.Errorf("failed to get calendar: %v", err)
+	}
+
+	return calendar, nil
+}
+
+// creates or replaces the hours of a weekday at a branch, branch 0 for the default of every branch
+func (s *server) SetOpeningHours(ctx context.Context, req *proto.OpeningHours) (*proto.OpeningHours, error) {
+	caller := callerFromContext(ctx)
+
+	if req.Weekday < 0 || req.Weekday > 6 {
+		logger.LogThis(fmt.Sprintf("[ERROR] invalid weekday: %d", req.Weekday))
+		return nil, status.Error(codes.InvalidArgument, "weekday must be 0 (Sunday) to 6 (Saturday)")
+	}
+	if req.Closed {
+		req.OpensAt, req.ClosesAt = "", ""
+	} else {
+		opensAt, err := time.Parse("15:04", req.OpensAt)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "opens_at must be HH:MM")
+		}
+		closesAt, err := time.Parse("15:04", req.ClosesAt)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "closes_at must be HH:MM")
+		}
+		if !closesAt.After(opensAt) {
+			return nil, status.Error(codes.InvalidArgument, "closes_at must be after opens_at")
+		}
+	}
+	if err := checkCalendarBranch(ctx, s.branches, int(req.BranchId)); err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] branch %d: %v", req.BranchId, err))
+		return nil, err
+	}
+
+	err := s.calendars.SetHours(ctx, req)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to set opening hours: %v", err))
+		return nil, fmt.Errorf("failed to set opening hours: %v", err)
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] %s set opening hours of branch %d, weekday %d: %s-%s closed=%t", caller.Username, req.BranchId, req.Weekday, req.OpensAt, req.ClosesAt, req.Closed))
+
+	return req, nil
+}
+
+// closes a branch, or every branch with branch 0, for a day
+func (s *server) AddHoliday(ctx context.Context, req *proto.Holiday) (*proto.Holiday, error) {
+	caller := callerFromContext(ctx)
+
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to parse date: %v", err))
+		return nil, status.Error(codes.InvalidArgument, "date must be YYYY-MM-DD")
+	}
+	if err := checkCalendarBranch(ctx, s.branches, int(req.BranchId)); err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] branch %d: %v", req.BranchId, err))
+		return nil, err
+	}
+
+	holiday := proto.Holiday{BranchId: req.BranchId, Date: date.Format("2006-01-02"), Name: req.Name}
+	holidayID, err := s.calendars.AddHoliday(ctx, &holiday)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to add holiday: %v", err))
+		return nil, fmt.Errorf("failed to add holiday: %v", err)
+	}
+	holiday.HolidayId = int32(holidayID)
+	logger.LogThis(fmt.Sprintf("[INFO] %s closed branch %d on %s (%s)", caller.Username, req.BranchId, holiday.Date, req.Name))
+
+	return &holiday, nil
+}
+
+func (s *server) DeleteHoliday(ctx context.Context, req *proto.IntRequest) (*proto.StringResponse, error) {
+	caller := callerFromContext(ctx)
+
+	err := s.calendars.DeleteHoliday(ctx, int(req.RequestInt))
+	if err == errNotFound {
+		return nil, errHolidayNotFound
+	} else if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to delete holiday: %v", err))
+		return nil, fmt.Errorf("failed to delete holiday: %v", err)
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] %s deleted holiday %d", caller.Username, req.RequestInt))
+
+	return &proto.StringResponse{ResponseStr: "successfully deleted holiday"}, nil
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	proto "gogrpc-rpc-boiler/proto"
+	"gogrpc-rpc-boiler/server/env"
+	logger "gogrpc-rpc-boiler/server/log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// policies with role "*" apply to every role, category_id 0 to every category
+const (
+	anyPolicyRole     = "*"
+	anyPolicyCategory = 0
+)
+
+// circulation rules of one role and category, the most specific row in circulation_policies wins:
+// role and category, then any role and the category, then the role and any category, then any role and any category
+type circulationPolicy struct {
+	PolicyID   int
+	Role       string
+	CategoryID int
+	MaxLoans   int // concurrent loans, counted in the policy's category, or across all categories for category 0
+	LoanDays   int // due date of a new borrow, and what a renewal adds
+	Renewals   int // renewals per borrow
+	MaxHolds   int // active holds, counted like MaxLoans
+}
+
+// used when no row matches, overridable with LOAN_MAX_CONCURRENT, LOAN_PERIOD_DAYS, LOAN_RENEWAL_LIMIT and HOLD_MAX_ACTIVE
+var defaultCirculationPolicy = circulationPolicy{
+	Role:       anyPolicyRole,
+	CategoryID: anyPolicyCategory,
+	MaxLoans:   env.Int("LOAN_MAX_CONCURRENT", 5),
+	LoanDays:   env.Int("LOAN_PERIOD_DAYS", 14),
+	Renewals:   env.Int("LOAN_RENEWAL_LIMIT", 2),
+	MaxHolds:   env.Int("HOLD_MAX_ACTIVE", 5),
+}
+
+var (
+	errLoanLimit = status.Error(codes.FailedPrecondition, "loan limit reached")
+	errHoldLimit = status.Error(codes.FailedPrecondition, "hold limit reached")
+)
+
+const circulationPolicyColumns = "policy_id, role, category_id, max_loans, loan_days, renewals, max_holds"
+
+// finds the policy of a role for a book in categoryID
+func policyFor(ctx context.Context, tx *sql.Tx, role string, categoryID int) (circulationPolicy, error) {
+	var policy circulationPolicy
+	err := tx.QueryRowContext(ctx, `SELECT `+circulationPolicyColumns+` FROM circulation_policies
+        WHERE role IN ($1, $2) AND category_id IN ($3, $4)
+        ORDER BY category_id = $4, role = $2 LIMIT 1`,
+		role, anyPolicyRole, categoryID, anyPolicyCategory).Scan(&policy.PolicyID, &policy.Role, &policy.CategoryID, &policy.MaxLoans, &policy.LoanDays, &policy.Renewals, &policy.MaxHolds)
+	if err == sql.ErrNoRows {
+		return defaultCirculationPolicy, nil
+	} else if err != nil {
+		return policy, fmt.Errorf("failed to get circulation policy: %v", err)
+	}
+	return policy, nil
+}
+
+// finds the policy of a role for a book, the book must exist
+func policyForBook(ctx context.Context, tx *sql.Tx, role string, bookID int) (circulationPolicy, error) {
+	var categoryID int
+	err := tx.QueryRowContext(ctx, "SELECT category_id FROM books WHERE book_id = $1", bookID).Scan(&categoryID)
+	if err == sql.ErrNoRows {
+		return circulationPolicy{}, errBookNotFound
+	} else if err != nil {
+		return circulationPolicy{}, fmt.Errorf("failed to get book: %v", err)
+	}
+	return policyFor(ctx, tx, role, categoryID)
+}
+
+// serializes the borrows and holds of one user so limits cannot be raced past, released on commit or rollback
+func lockPatron(ctx context.Context, tx *sql.Tx, userID int) error {
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", userID); err != nil {
+		return fmt.Errorf("failed to lock user: %v", err)
+	}
+	return nil
+}
+
+// refuses a new borrow when the user already has the policy's maximum of loans out
+func checkLoanLimit(ctx context.Context, tx *sql.Tx, userID int, policy circulationPolicy) error {
+	var loans int
+	err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM borrowing b JOIN books k ON k.book_id = b.book_id WHERE b.user_id = $1 AND b.returned = FALSE AND ($2 = 0 OR k.category_id = $2)",
+		userID, policy.CategoryID).Scan(&loans)
+	if err != nil {
+		return fmt.Errorf("failed to count loans: %v", err)
+	}
+	if loans >= policy.MaxLoans {
+		return errLoanLimit
+	}
+	return nil
+}
+
+// refuses a new hold when the user already has the policy's maximum of active holds
+func checkHoldLimit(ctx context.Context, tx *sql.Tx, userID int, policy circulationPolicy) error {
+	var holds int
+	err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM holds h JOIN books k ON k.book_id = h.book_id WHERE h.user_id = $1 AND h.status IN ($2, $3, $5) AND ($4 = 0 OR k.category_id = $4)",
+		userID, HoldWaiting, HoldReady, policy.CategoryID, HoldInTransit).Scan(&holds)
+	if err != nil {
+		return fmt.Errorf("failed to count holds: %v", err)
+	}
+	if holds >= policy.MaxHolds {
+		return errHoldLimit
+	}
+	return nil
+}
+
+// the role of a borrower, inter-service call to userservice
+func borrowerRole(ctx context.Context, userID int) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", fmt.Errorf("failed to get metadata")
+	}
+	outCtx := metadata.NewOutgoingContext(ctx, internalCallMetadata(md))
+	userServiceClient := proto.NewUserServiceClient(interServiceConn)
+	doesUserExist, err := userServiceClient.DoesUserExist(outCtx, &proto.IntRequest{RequestInt: int32(userID)})
+	if err != nil {
+		return "", fmt.Errorf("failed to check user: %v", err)
+	}
+	if !doesUserExist.ResponseBool {
+		return "", fmt.Errorf("user does not exist")
+	}
+	user, err := userServiceClient.GetUser(outCtx, &proto.IntRequest{RequestInt: int32(userID)})
+	if err != nil {
+		return "", fmt.Errorf("failed to get user: %v", err)
+	}
+	return user.Role, nil
+}
+
+func circulationPolicyToProto(policy circulationPolicy) *proto.CirculationPolicy {
+	return &proto.CirculationPolicy{
+		PolicyId:   int32(policy.PolicyID),
+		Role:       policy.Role,
+		CategoryId: int32(policy.CategoryID),
+		MaxLoans:   int32(policy.MaxLoans),
+		LoanDays:   int32(policy.LoanDays),
+		Renewals:   int32(policy.Renewals),
+		MaxHolds:   int32(policy.MaxHolds),
+	}
+}
+
+func (s *server) GetCirculationPolicies(ctx context.Context, req *emptypb.Empty) (*proto.CirculationPolicies, error) {
+	list, err := s.policies.List(ctx)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to get circulation policies: %v", err))
+		return nil, fmt.Errorf("failed to get circulation policies: %v", err)
+	}
+	var policies []*proto.CirculationPolicy
+	for _, policy := range list {
+		policies = append(policies, circulationPolicyToProto(policy))
+	}
+
+	return &proto.CirculationPolicies{Policies: policies, Default: circulationPolicyToProto(defaultCirculationPolicy)}, nil
+}
+
+// creates or replaces the policy of a role and category
+func (s *server) SetCirculationPolicy(ctx context.Context, req *proto.CirculationPolicy) (*proto.CirculationPolicy, error) {
+	caller := callerFromContext(ctx)
+
+	if req.Role != anyPolicyRole && !isValidRole(req.Role) {
+		logger.LogThis(fmt.Sprintf("[ERROR] invalid role: %s", req.Role))
+		return nil, fmt.Errorf("invalid role: %s", req.Role)
+	}
+	if req.CategoryId < 0 || req.MaxLoans < 0 || req.LoanDays <= 0 || req.Renewals < 0 || req.MaxHolds < 0 {
+		logger.LogThis("[ERROR] category_id, max_loans, renewals and max_holds cannot be negative, loan_days must be positive")
+		return nil, status.Error(codes.InvalidArgument, "category_id, max_loans, renewals and max_holds cannot be negative, loan_days must be positive")
+	}
+
+	// check if category id exists, inter-service call to categoryservice
+	if req.CategoryId != anyPolicyCategory {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			logger.LogThis("[ERROR] failed to get metadata")
+			return nil, fmt.Errorf("failed to get metadata")
+		}
+		outCtx := metadata.NewOutgoingContext(ctx, internalCallMetadata(md))
+		categoryServiceClient := proto.NewCategoryServiceClient(interServiceConn)
+		doesCategoryExist, err := categoryServiceClient.DoesCategoryExist(outCtx, &proto.IntRequest{RequestInt: req.CategoryId})
+		if err != nil {
+			logger.LogThis(fmt.Sprintf("[ERROR] failed to check category existence: %v", err))
+			return nil, fmt.Errorf("failed to check category existence: %v", err)
+		}
+		if !doesCategoryExist.ResponseBool {
+			logger.LogThis("[ERROR] category id is unavailable")
+			return nil, fmt.Errorf("category id is unavailable")
+		}
+	}
+
+	policy := circulationPolicy{
+		Role:       req.Role,
+		CategoryID: int(req.CategoryId),
+		MaxLoans:   int(req.MaxLoans),
+		LoanDays:   int(req.LoanDays),
+		Renewals:   int(req.Renewals),
+		MaxHolds:   int(req.MaxHolds),
+	}
+	policyID, err := s.policies.Set(ctx, policy)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to save circulation policy: %v", err))
+		return nil, fmt.Errorf("failed to save circulation policy: %v", err)
+	}
+	policy.PolicyID = policyID
+	logger.LogThis(fmt.Sprintf("[INFO] %s set circulation policy %d for role %s, category_id %d: %d loans, %d days, %d renewals, %d holds",
+		caller.Username, policy.PolicyID, policy.Role, policy.CategoryID, policy.MaxLoans, policy.LoanDays, policy.Renewals, policy.MaxHolds))
+
+	return circulationPolicyToProto(policy), nil
+}
+
+func (s *server) DeleteCirculationPolicy(ctx context.Context, req *proto.IntRequest) (*proto.StringResponse, error) {
+	caller := callerFromContext(ctx)
+
+	err := s.policies.Delete(ctx, int(req.RequestInt))
+	if err == errNotFound {
+		logger.LogThis(fmt.Sprintf("[ERROR] circulation policy %d not found", req.RequestInt))
+		return nil, status.Errorf(codes.NotFound, "circulation policy %d not found", req.RequestInt)
+	} else if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to delete circulation policy: %v", err))
+		return nil, fmt.Errorf("failed to delete circulation policy: %v", err)
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] %s deleted circulation policy %d", caller.Username, req.RequestInt))
+
+	return &proto.StringResponse{ResponseStr: "circulation policy deleted successfully"}, nil
+}
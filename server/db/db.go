@@ -7,7 +7,10 @@ import (
 	"os"
 	"time"
 
+	"gogrpc-rpc-boiler/server/env"
+
 	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite" // pure Go, no cgo
 )
 
 // Database connections
@@ -16,11 +19,20 @@ var (
 	AuthorDB   *sql.DB
 	CategoryDB *sql.DB
 	UserDB     *sql.DB
+	SQLiteDB   *sql.DB // STORE=sqlite, one file instead of the four Postgres databases
 )
 
-const (
-	maxRetries = 5           // max retries
-	retryDelay = 5 * time.Second // 5sec
+// pool and retry settings of the Postgres connections, from the environment.
+// 0 leaves the database/sql default (unlimited open connections, connections never expire)
+var (
+	maxOpenConns    = env.Int("DB_MAX_OPEN_CONNS", 0)
+	maxIdleConns    = env.Int("DB_MAX_IDLE_CONNS", 2)
+	connMaxLifetime = env.Duration("DB_CONN_MAX_LIFETIME", 0)
+	connMaxIdleTime = env.Duration("DB_CONN_MAX_IDLE_TIME", 0)
+
+	maxRetries    = max(env.Int("DB_CONNECT_RETRIES", 5), 1)           // attempts, at least one
+	retryDelay    = env.Duration("DB_RETRY_DELAY", 5*time.Second)      // doubled after every failed attempt
+	retryMaxDelay = env.Duration("DB_RETRY_MAX_DELAY", 30*time.Second) // up to this
 )
 
 // dsn generator
@@ -33,11 +45,15 @@ func createDSN(dbNameEnvVar string) string {
 	fmt.Println("DB_NAME:", os.Getenv(dbNameEnvVar))
 	fmt.Println("DB_SSLMODE:", os.Getenv("DB_SSLMODE"))
 	
+	return postgresDSN(os.Getenv(dbNameEnvVar))
+}
+
+// dsn of a database on the server of DB_HOST
+func postgresDSN(dbName string) string {
 	dbUser := os.Getenv("DB_USER")
 	dbPassword := os.Getenv("DB_PASSWORD")
 	dbHost := os.Getenv("DB_HOST")
 	dbPort := os.Getenv("DB_PORT")
-	dbName := os.Getenv(dbNameEnvVar)
 	sslMode := os.Getenv("DB_SSLMODE")
 
 	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s", dbUser, dbPassword, dbHost, dbPort, dbName, sslMode)
@@ -46,6 +62,7 @@ func createDSN(dbNameEnvVar string) string {
 func connectWithRetry(dsn string, dbName string) (*sql.DB, error) {
 	var db *sql.DB
 	var err error
+	delay := retryDelay
 
 	for i := 0; i < maxRetries; i++ {
 		db, err = sql.Open("postgres", dsn)
@@ -53,13 +70,21 @@ func connectWithRetry(dsn string, dbName string) (*sql.DB, error) {
 			log.Printf("Attempt %d: Failed to connect to %s database: %v", i+1, dbName, err)
 		} else if err = db.Ping(); err != nil {
 			log.Printf("Attempt %d: Failed to ping %s database: %v", i+1, dbName, err)
+			db.Close()
 		} else {
+			db.SetMaxOpenConns(maxOpenConns)
+			db.SetMaxIdleConns(maxIdleConns)
+			db.SetConnMaxLifetime(connMaxLifetime)
+			db.SetConnMaxIdleTime(connMaxIdleTime)
 			log.Printf("Connected to %s database", dbName)
 			return db, nil
 		}
 
-		// Wait before retrying
-		time.Sleep(retryDelay)
+		// Wait before retrying, longer after every failure
+		if i < maxRetries-1 {
+			time.Sleep(delay)
+			delay = min(delay*2, retryMaxDelay)
+		}
 	}
 
 	return nil, fmt.Errorf("failed to connect to %s database after %d attempts: %v", dbName, maxRetries, err)
@@ -100,3 +125,36 @@ func ConnectUserDB() {
 		log.Fatalf("%v", err)
 	}
 }
+
+// opens SQLITE_PATH (default library.db)
+func openSQLite() (*sql.DB, error) {
+	path := os.Getenv("SQLITE_PATH")
+	if path == "" {
+		path = "library.db"
+	}
+	db, err := sql.Open("sqlite", "file:"+path+"?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_time_format=sqlite")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open SQLite database %s: %v", path, err)
+	}
+	// SQLite has a single writer, one connection keeps transactions from failing with SQLITE_BUSY
+	db.SetMaxOpenConns(1)
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to open SQLite database %s: %v", path, err)
+	}
+	log.Printf("Connected to SQLite database %s", path)
+	return db, nil
+}
+
+// opens SQLITE_PATH and brings its schema up to date
+func ConnectSQLite() {
+	db, err := openSQLite()
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	if MigrateOnStart {
+		if err := MigrateUp(db, "sqlite"); err != nil {
+			log.Fatalf("%v", err)
+		}
+	}
+	SQLiteDB = db
+}
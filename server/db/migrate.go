@@ -0,0 +1,363 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// schema of every database as versioned migrations embedded in the binary:
+// migrations/<database>/<version>_<name>.up.sql and .down.sql, the applied versions are kept
+// in a schema_migrations table of each database. databases are author, category, user and book
+// on Postgres and sqlite for STORE=sqlite
+//
+//go:embed migrations
+var migrationFiles embed.FS
+
+// the Postgres databases, by the name of their migrations directory
+var postgresDatabases = []struct {
+	Name   string
+	EnvVar string
+	Label  string
+	DB     **sql.DB
+}{
+	{"author", "DB_NAME_AUTHOR", "Author Service", &AuthorDB},
+	{"category", "DB_NAME_CATEGORY", "Category Service", &CategoryDB},
+	{"user", "DB_NAME_USER", "User Service", &UserDB},
+	{"book", "DB_NAME_BOOK", "Book Service", &BookDB},
+}
+
+// pending migrations are applied at startup unless DB_MIGRATE_ON_START=false,
+// they can be run by hand with the migrate subcommand instead
+var MigrateOnStart = os.Getenv("DB_MIGRATE_ON_START") != "false"
+
+type Migration struct {
+	Version int
+	Name    string
+	up      string
+	down    string
+}
+
+// a migration and whether it was applied to the database
+type MigrationState struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// names of the Postgres databases in the order they are migrated
+func PostgresDatabases() []string {
+	names := make([]string, 0, len(postgresDatabases))
+	for _, database := range postgresDatabases {
+		names = append(names, database.Name)
+	}
+	return names
+}
+
+// the migrations of a database ordered by version
+func loadMigrations(database string) ([]Migration, error) {
+	files, err := fs.Glob(migrationFiles, "migrations/"+database+"/*.sql")
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no migrations for database %s", database)
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, file := range files {
+		base := path.Base(file)
+		name, direction, ok := strings.Cut(strings.TrimSuffix(base, ".sql"), ".")
+		if !ok || (direction != "up" && direction != "down") {
+			return nil, fmt.Errorf("migration %s is neither .up.sql nor .down.sql", file)
+		}
+		prefix, _, _ := strings.Cut(name, "_")
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s has no version: %v", file, err)
+		}
+		script, err := migrationFiles.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		} else if migration.Name != name {
+			return nil, fmt.Errorf("migrations %s and %s share version %d", migration.Name, name, version)
+		}
+		if direction == "up" {
+			migration.up = string(script)
+		} else {
+			migration.down = string(script)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.up == "" {
+			return nil, fmt.Errorf("migration %s of database %s has no .up.sql", migration.Name, database)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// key of the Postgres advisory lock held while migrations are applied or reverted,
+// every database has its own locks so one key serves them all
+const migrationLockKey = 0x6d696772 // "migr"
+
+// a connection of its own for one run of migrations, holding the migration lock on Postgres
+// so servers starting together apply each migration once. SQLite is one file for one server
+func lockMigrations(db *sql.DB, database string) (*sql.Conn, func(), error) {
+	ctx := context.Background()
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get a connection: %v", err)
+	}
+	if database == "sqlite" {
+		return conn, func() { conn.Close() }, nil
+	}
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockKey); err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("failed to lock migrations of database %s: %v", database, err)
+	}
+	unlock := func() {
+		if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", migrationLockKey); err != nil {
+			log.Printf("Failed to unlock migrations of %s database: %v", database, err)
+		}
+		conn.Close()
+	}
+	return conn, unlock, nil
+}
+
+func createMigrationsTable(conn *sql.Conn) error {
+	_, err := conn.ExecContext(context.Background(), "CREATE TABLE IF NOT EXISTS schema_migrations (version INTEGER PRIMARY KEY, applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP)")
+	if err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %v", err)
+	}
+	return nil
+}
+
+// whether schema_migrations exists, without creating it
+func migrationsTableExists(db *sql.DB, database string) (bool, error) {
+	query := "SELECT to_regclass('schema_migrations') IS NOT NULL"
+	if database == "sqlite" {
+		query = "SELECT EXISTS (SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = 'schema_migrations')"
+	}
+	var exists bool
+	if err := db.QueryRow(query).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check schema_migrations: %v", err)
+	}
+	return exists, nil
+}
+
+// applied versions of the database and when they were applied
+func appliedMigrations(q interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}) (map[int]time.Time, error) {
+	rows, err := q.QueryContext(context.Background(), "SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get applied migrations: %v", err)
+	}
+	defer rows.Close()
+
+	applied := map[int]time.Time{}
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan applied migration: %v", err)
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
+
+// runs a migration script and records it in one transaction, the version is an int so it is
+// written into the statement, which keeps it free of the $1 / ? placeholder difference
+func runMigration(conn *sql.Conn, script string, record string) error {
+	tx, err := conn.BeginTx(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	if _, err := tx.Exec(script); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.Exec(record); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to record migration: %v", err)
+	}
+	return tx.Commit()
+}
+
+// applies every migration of the database that is not applied yet, in version order
+func MigrateUp(db *sql.DB, database string) error {
+	migrations, err := loadMigrations(database)
+	if err != nil {
+		return err
+	}
+	conn, unlock, err := lockMigrations(db, database)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if err := createMigrationsTable(conn); err != nil {
+		return err
+	}
+	// read under the lock, another server may have applied them while this one waited
+	applied, err := appliedMigrations(conn)
+	if err != nil {
+		return err
+	}
+	for _, migration := range migrations {
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+		record := fmt.Sprintf("INSERT INTO schema_migrations (version) VALUES (%d)", migration.Version)
+		if err := runMigration(conn, migration.up, record); err != nil {
+			return fmt.Errorf("migration %s of database %s failed: %v", migration.Name, database, err)
+		}
+		log.Printf("Applied migration %s to %s database", migration.Name, database)
+	}
+	return nil
+}
+
+// reverts the latest applied migration of the database, returns false when none is applied
+func MigrateDown(db *sql.DB, database string) (bool, error) {
+	migrations, err := loadMigrations(database)
+	if err != nil {
+		return false, err
+	}
+	conn, unlock, err := lockMigrations(db, database)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+	if err := createMigrationsTable(conn); err != nil {
+		return false, err
+	}
+	applied, err := appliedMigrations(conn)
+	if err != nil {
+		return false, err
+	}
+	for i := len(migrations) - 1; i >= 0; i-- {
+		migration := migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+		if migration.down == "" {
+			return false, fmt.Errorf("migration %s of database %s has no .down.sql", migration.Name, database)
+		}
+		record := fmt.Sprintf("DELETE FROM schema_migrations WHERE version = %d", migration.Version)
+		if err := runMigration(conn, migration.down, record); err != nil {
+			return false, fmt.Errorf("reverting migration %s of database %s failed: %v", migration.Name, database, err)
+		}
+		log.Printf("Reverted migration %s of %s database", migration.Name, database)
+		return true, nil
+	}
+	return false, nil
+}
+
+// every migration of the database with whether it is applied, only reads:
+// a database without schema_migrations has none applied
+func MigrationStatus(db *sql.DB, database string) ([]MigrationState, error) {
+	migrations, err := loadMigrations(database)
+	if err != nil {
+		return nil, err
+	}
+	applied := map[int]time.Time{}
+	exists, err := migrationsTableExists(db, database)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		if applied, err = appliedMigrations(db); err != nil {
+			return nil, err
+		}
+	}
+	states := make([]MigrationState, 0, len(migrations))
+	for _, migration := range migrations {
+		appliedAt, ok := applied[migration.Version]
+		states = append(states, MigrationState{Migration: migration, Applied: ok, AppliedAt: appliedAt})
+	}
+	return states, nil
+}
+
+// creates the Postgres database named by envVar when it does not exist yet,
+// through the postgres maintenance database of the same server
+func createPostgresDatabase(envVar string) error {
+	dbName := os.Getenv(envVar)
+	db, err := sql.Open("postgres", postgresDSN("postgres"))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	var exists bool
+	if err := db.QueryRow("SELECT EXISTS (SELECT 1 FROM pg_database WHERE datname = $1)", dbName).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check database %s: %v", dbName, err)
+	}
+	if exists {
+		return nil
+	}
+	if _, err := db.Exec("CREATE DATABASE " + pq.QuoteIdentifier(dbName)); err != nil {
+		return fmt.Errorf("failed to create database %s: %v", dbName, err)
+	}
+	log.Printf("Created database %s", dbName)
+	return nil
+}
+
+// opens a database by the name of its migrations directory for the migrate subcommand,
+// a missing Postgres database is created first when create is set
+func OpenForMigrations(database string, create bool) (*sql.DB, error) {
+	if database == "sqlite" {
+		return openSQLite()
+	}
+	for _, pg := range postgresDatabases {
+		if pg.Name != database {
+			continue
+		}
+		if create {
+			if err := createPostgresDatabase(pg.EnvVar); err != nil {
+				return nil, err
+			}
+		}
+		return connectWithRetry(createDSN(pg.EnvVar), pg.Label)
+	}
+	return nil, fmt.Errorf("unknown database %s, want one of %s or sqlite", database, strings.Join(PostgresDatabases(), ", "))
+}
+
+// creates the missing Postgres databases, before ConnectBookDB and the others at startup.
+// a failure is only logged, the connection retries report a database that is really missing
+func CreatePostgresDatabases() {
+	for _, pg := range postgresDatabases {
+		if err := createPostgresDatabase(pg.EnvVar); err != nil {
+			log.Printf("Could not create %s database: %v", pg.Label, err)
+		}
+	}
+}
+
+// applies the pending migrations of the four connected Postgres databases
+func MigratePostgres() {
+	for _, pg := range postgresDatabases {
+		if err := MigrateUp(*pg.DB, pg.Name); err != nil {
+			log.Fatalf("%v", err)
+		}
+	}
+}
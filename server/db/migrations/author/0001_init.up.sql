@@ -0,0 +1,11 @@
+-- the authors table as the former pgseed.sh created it, IF NOT EXISTS so databases it set up are taken over as they are
+
+CREATE TABLE IF NOT EXISTS authors (
+    author_id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    birthdate DATE,
+    nationality VARCHAR(100),
+    biography TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
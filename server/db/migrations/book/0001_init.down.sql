@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS borrowing;
+DROP TABLE IF EXISTS books;
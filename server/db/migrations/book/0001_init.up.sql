@@ -0,0 +1,24 @@
+-- the tables of syn_book as the former pgseed.sh created them, IF NOT EXISTS so databases it set up are taken over as they are
+
+CREATE TABLE IF NOT EXISTS books (
+    book_id SERIAL PRIMARY KEY,
+    title VARCHAR(255) NOT NULL,
+    category_id INTEGER NOT NULL,
+    author_id INTEGER NOT NULL,
+    published_date DATE,
+    isbn VARCHAR(13) UNIQUE,
+    total_stock INTEGER DEFAULT 0,
+    available_stock INTEGER DEFAULT 0 CHECK (available_stock >= 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS borrowing (
+    borrowing_id SERIAL PRIMARY KEY,
+    book_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    borrowed_date TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    return_date TIMESTAMP,
+    returned BOOLEAN DEFAULT FALSE,
+    returned_date TIMESTAMP
+);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS holds (
+    hold_id SERIAL PRIMARY KEY,
+    book_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'waiting',
+    placed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ready_at TIMESTAMP,
+    pickup_expires_at TIMESTAMP,
+    closed_at TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS holds_queue_idx ON holds (book_id, status, placed_at);
+-- one active hold per patron and book
+CREATE UNIQUE INDEX IF NOT EXISTS holds_one_active_idx ON holds (book_id, user_id) WHERE status IN ('waiting', 'ready');
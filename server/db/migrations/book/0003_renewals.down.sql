@@ -0,0 +1,2 @@
+ALTER TABLE borrowing DROP COLUMN IF EXISTS last_renewed_at;
+ALTER TABLE borrowing DROP COLUMN IF EXISTS renewal_count;
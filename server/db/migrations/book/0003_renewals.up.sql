@@ -0,0 +1,2 @@
+ALTER TABLE borrowing ADD COLUMN IF NOT EXISTS renewal_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE borrowing ADD COLUMN IF NOT EXISTS last_renewed_at TIMESTAMP;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS fines (
+    fine_id SERIAL PRIMARY KEY,
+    borrowing_id INTEGER NOT NULL,
+    book_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    days_late INTEGER NOT NULL,
+    amount_cents INTEGER NOT NULL,
+    paid_cents INTEGER NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'outstanding',
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    closed_at TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS fines_user_idx ON fines (user_id, status);
@@ -0,0 +1,3 @@
+ALTER TABLE borrowing DROP COLUMN IF EXISTS renewals_allowed;
+ALTER TABLE borrowing DROP COLUMN IF EXISTS loan_days;
+DROP TABLE IF EXISTS circulation_policies;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS circulation_policies (
+    policy_id SERIAL PRIMARY KEY,
+    role VARCHAR(20) NOT NULL,
+    category_id INTEGER NOT NULL DEFAULT 0,
+    max_loans INTEGER NOT NULL CHECK (max_loans >= 0),
+    loan_days INTEGER NOT NULL CHECK (loan_days > 0),
+    renewals INTEGER NOT NULL CHECK (renewals >= 0),
+    max_holds INTEGER NOT NULL CHECK (max_holds >= 0),
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (role, category_id)
+);
+
+-- borrows made before circulation policies keep NULL and follow the default policy
+ALTER TABLE borrowing ADD COLUMN IF NOT EXISTS loan_days INTEGER;
+ALTER TABLE borrowing ADD COLUMN IF NOT EXISTS renewals_allowed INTEGER;
@@ -0,0 +1,3 @@
+ALTER TABLE holds DROP COLUMN IF EXISTS item_id;
+ALTER TABLE borrowing DROP COLUMN IF EXISTS item_id;
+DROP TABLE IF EXISTS items;
@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS items (
+    item_id SERIAL PRIMARY KEY,
+    book_id INTEGER NOT NULL,
+    barcode VARCHAR(64) NOT NULL UNIQUE,
+    condition VARCHAR(20) NOT NULL DEFAULT 'good',
+    status VARCHAR(20) NOT NULL DEFAULT 'available',
+    shelf_location VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS items_book_status_idx ON items (book_id, status);
+
+ALTER TABLE borrowing ADD COLUMN IF NOT EXISTS item_id INTEGER;
+ALTER TABLE holds ADD COLUMN IF NOT EXISTS item_id INTEGER;
+
+-- books stocked before items existed get one LEGACY-<book_id>-<n> item per copy:
+-- copies out on a borrow are matched to the open borrows, copies reserved for a ready hold to those holds
+INSERT INTO items (book_id, barcode, status)
+SELECT b.book_id, 'LEGACY-' || b.book_id || '-' || n, CASE WHEN n <= b.available_stock THEN 'available' ELSE 'on_loan' END
+FROM books b, generate_series(1, b.total_stock) n
+WHERE NOT EXISTS (SELECT 1 FROM items i WHERE i.book_id = b.book_id);
+UPDATE borrowing br SET item_id = i.item_id
+FROM (SELECT borrowing_id, book_id, ROW_NUMBER() OVER (PARTITION BY book_id ORDER BY borrowing_id) AS rn FROM borrowing WHERE returned = FALSE AND item_id IS NULL) lb
+JOIN (SELECT item_id, book_id, ROW_NUMBER() OVER (PARTITION BY book_id ORDER BY item_id) AS rn FROM items
+      WHERE status = 'on_loan' AND item_id NOT IN (SELECT item_id FROM borrowing WHERE item_id IS NOT NULL)) i
+  ON i.book_id = lb.book_id AND i.rn = lb.rn
+WHERE br.borrowing_id = lb.borrowing_id;
+UPDATE holds h SET item_id = i.item_id
+FROM (SELECT hold_id, book_id, ROW_NUMBER() OVER (PARTITION BY book_id ORDER BY hold_id) AS rn FROM holds WHERE status = 'ready' AND item_id IS NULL) rh
+JOIN (SELECT item_id, book_id, ROW_NUMBER() OVER (PARTITION BY book_id ORDER BY item_id) AS rn FROM items
+      WHERE status = 'on_loan' AND item_id NOT IN (SELECT item_id FROM borrowing WHERE item_id IS NOT NULL)) i
+  ON i.book_id = rh.book_id AND i.rn = rh.rn
+WHERE h.hold_id = rh.hold_id;
+UPDATE items SET status = 'on_hold' WHERE item_id IN (SELECT item_id FROM holds WHERE status = 'ready' AND item_id IS NOT NULL);
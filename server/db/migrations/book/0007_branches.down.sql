@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS holds_one_active_idx;
+CREATE UNIQUE INDEX holds_one_active_idx ON holds (book_id, user_id) WHERE status IN ('waiting', 'ready');
+
+ALTER TABLE holds DROP COLUMN IF EXISTS pickup_branch_id;
+ALTER TABLE borrowing DROP COLUMN IF EXISTS return_branch_id;
+ALTER TABLE borrowing DROP COLUMN IF EXISTS branch_id;
+ALTER TABLE items DROP COLUMN IF EXISTS branch_id;
+DROP TABLE IF EXISTS transfers;
+DROP TABLE IF EXISTS branches;
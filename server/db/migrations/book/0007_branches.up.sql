@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS branches (
+    branch_id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    address VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS transfers (
+    transfer_id SERIAL PRIMARY KEY,
+    item_id INTEGER NOT NULL,
+    from_branch_id INTEGER NOT NULL,
+    to_branch_id INTEGER NOT NULL,
+    hold_id INTEGER,
+    status VARCHAR(20) NOT NULL DEFAULT 'requested',
+    requested_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    shipped_at TIMESTAMP,
+    received_at TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS transfers_status_idx ON transfers (status, from_branch_id, to_branch_id);
+
+ALTER TABLE items ADD COLUMN IF NOT EXISTS branch_id INTEGER;
+ALTER TABLE borrowing ADD COLUMN IF NOT EXISTS branch_id INTEGER;
+ALTER TABLE borrowing ADD COLUMN IF NOT EXISTS return_branch_id INTEGER;
+ALTER TABLE holds ADD COLUMN IF NOT EXISTS pickup_branch_id INTEGER;
+
+-- a hold waiting for a transfer is still active
+DROP INDEX IF EXISTS holds_one_active_idx;
+CREATE UNIQUE INDEX holds_one_active_idx ON holds (book_id, user_id) WHERE status IN ('waiting', 'in_transit', 'ready');
+
+-- copies from before branches existed are placed at a Main branch
+INSERT INTO branches (name) SELECT 'Main' WHERE EXISTS (SELECT 1 FROM items WHERE branch_id IS NULL) ON CONFLICT (name) DO NOTHING;
+UPDATE items SET branch_id = (SELECT branch_id FROM branches WHERE name = 'Main') WHERE branch_id IS NULL;
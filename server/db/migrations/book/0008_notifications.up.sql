@@ -0,0 +1,13 @@
+-- due date notices already sent, at most one per borrow, kind and due date
+CREATE TABLE IF NOT EXISTS notifications (
+    notification_id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    borrowing_id INTEGER NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    due_date TIMESTAMP NOT NULL,
+    recipient VARCHAR(255) NOT NULL,
+    subject TEXT NOT NULL,
+    sent_at TIMESTAMP NOT NULL,
+    UNIQUE (borrowing_id, kind, due_date)
+);
+CREATE INDEX IF NOT EXISTS notifications_user_idx ON notifications (user_id, sent_at);
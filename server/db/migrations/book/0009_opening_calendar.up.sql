@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS opening_hours (
+    branch_id INTEGER NOT NULL,
+    weekday INTEGER NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    opens_at VARCHAR(5) NOT NULL DEFAULT '',
+    closes_at VARCHAR(5) NOT NULL DEFAULT '',
+    closed BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (branch_id, weekday)
+);
+
+-- branch_id 0 holds the defaults of every branch
+CREATE TABLE IF NOT EXISTS holidays (
+    holiday_id SERIAL PRIMARY KEY,
+    branch_id INTEGER NOT NULL DEFAULT 0,
+    holiday_date DATE NOT NULL,
+    name VARCHAR(100) NOT NULL DEFAULT '',
+    UNIQUE (branch_id, holiday_date)
+);
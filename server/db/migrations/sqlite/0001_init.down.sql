@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS borrowing;
+DROP TABLE IF EXISTS books;
+DROP TABLE IF EXISTS categories;
+DROP TABLE IF EXISTS authors;
+DROP TABLE IF EXISTS users;
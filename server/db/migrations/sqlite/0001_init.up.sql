@@ -0,0 +1,55 @@
+-- users, authors, categories, books and borrowing as the former pgseed.sh created them on Postgres.
+-- ids are AUTOINCREMENT so, like SERIAL, they are never reused
+
+CREATE TABLE users (
+    user_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    username VARCHAR(50) NOT NULL UNIQUE,
+    password_hash VARCHAR(255) NOT NULL,
+    first_name VARCHAR(100),
+    last_name VARCHAR(100),
+    email VARCHAR(100) NOT NULL UNIQUE,
+    role VARCHAR(50) DEFAULT 'user',
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE authors (
+    author_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(255) NOT NULL,
+    birthdate DATE,
+    nationality VARCHAR(100),
+    biography TEXT,
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE categories (
+    category_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    description TEXT,
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE books (
+    book_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    title VARCHAR(255) NOT NULL,
+    category_id INTEGER NOT NULL,
+    author_id INTEGER NOT NULL,
+    published_date DATE,
+    isbn VARCHAR(13) UNIQUE,
+    total_stock INTEGER DEFAULT 0,
+    available_stock INTEGER DEFAULT 0 CHECK (available_stock >= 0),
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP
+);
+
+CREATE TABLE borrowing (
+    borrowing_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    book_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    borrowed_date TIMESTAMP,
+    return_date TIMESTAMP,
+    returned BOOLEAN DEFAULT FALSE,
+    returned_date TIMESTAMP
+);
@@ -0,0 +1,12 @@
+CREATE TABLE sessions (
+    session_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id INTEGER NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    refresh_token_hash VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    last_refreshed_at TIMESTAMP,
+    created_at TIMESTAMP
+);
+CREATE INDEX sessions_user_id_idx ON sessions (user_id);
+
+ALTER TABLE users ADD COLUMN password_changed_at TIMESTAMP;
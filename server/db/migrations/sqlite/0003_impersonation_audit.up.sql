@@ -0,0 +1,11 @@
+CREATE TABLE impersonation_audit (
+    audit_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    impersonator_id INTEGER NOT NULL,
+    impersonator_username VARCHAR(50) NOT NULL,
+    target_user_id INTEGER NOT NULL,
+    target_username VARCHAR(50) NOT NULL,
+    session_id INTEGER NOT NULL,
+    client_addr VARCHAR(255),
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP
+);
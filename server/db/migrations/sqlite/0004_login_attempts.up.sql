@@ -0,0 +1,6 @@
+CREATE TABLE login_attempts (
+    attempt_key VARCHAR(255) PRIMARY KEY,
+    failures INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP,
+    last_failure_at TIMESTAMP
+);
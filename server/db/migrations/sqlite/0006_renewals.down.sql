@@ -0,0 +1,2 @@
+ALTER TABLE borrowing DROP COLUMN last_renewed_at;
+ALTER TABLE borrowing DROP COLUMN renewal_count;
@@ -0,0 +1,2 @@
+ALTER TABLE borrowing ADD COLUMN renewal_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE borrowing ADD COLUMN last_renewed_at TIMESTAMP;
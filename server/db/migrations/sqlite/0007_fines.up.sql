@@ -0,0 +1,14 @@
+CREATE TABLE fines (
+    fine_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    borrowing_id INTEGER NOT NULL,
+    book_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    days_late INTEGER NOT NULL,
+    amount_cents INTEGER NOT NULL,
+    paid_cents INTEGER NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'outstanding',
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    closed_at TIMESTAMP
+);
+CREATE INDEX fines_user_idx ON fines (user_id, status);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS borrowing_user_idx;
+ALTER TABLE borrowing DROP COLUMN renewals_allowed;
+ALTER TABLE borrowing DROP COLUMN loan_days;
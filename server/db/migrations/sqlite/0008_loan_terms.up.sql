@@ -0,0 +1,4 @@
+-- the loan period and renewals a borrow was made with, the loan limit counts open borrows per user
+ALTER TABLE borrowing ADD COLUMN loan_days INTEGER;
+ALTER TABLE borrowing ADD COLUMN renewals_allowed INTEGER;
+CREATE INDEX borrowing_user_idx ON borrowing (user_id, returned);
@@ -0,0 +1,2 @@
+ALTER TABLE borrowing DROP COLUMN item_id;
+DROP TABLE IF EXISTS items;
@@ -0,0 +1,13 @@
+CREATE TABLE items (
+    item_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    book_id INTEGER NOT NULL,
+    barcode VARCHAR(64) NOT NULL UNIQUE,
+    condition VARCHAR(20) NOT NULL DEFAULT 'good',
+    status VARCHAR(20) NOT NULL DEFAULT 'available',
+    shelf_location VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+CREATE INDEX items_book_status_idx ON items (book_id, status);
+
+ALTER TABLE borrowing ADD COLUMN item_id INTEGER;
@@ -0,0 +1,3 @@
+ALTER TABLE borrowing DROP COLUMN return_branch_id;
+ALTER TABLE borrowing DROP COLUMN branch_id;
+ALTER TABLE items DROP COLUMN branch_id;
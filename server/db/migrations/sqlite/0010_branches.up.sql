@@ -0,0 +1,4 @@
+-- the branch of a copy and where a borrow was made and returned, as on Postgres
+ALTER TABLE items ADD COLUMN branch_id INTEGER;
+ALTER TABLE borrowing ADD COLUMN branch_id INTEGER;
+ALTER TABLE borrowing ADD COLUMN return_branch_id INTEGER;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS impersonation_audit (
+    audit_id SERIAL PRIMARY KEY,
+    impersonator_id INTEGER NOT NULL,
+    impersonator_username VARCHAR(50) NOT NULL,
+    target_user_id INTEGER NOT NULL,
+    target_username VARCHAR(50) NOT NULL,
+    session_id INTEGER NOT NULL,
+    client_addr VARCHAR(255),
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
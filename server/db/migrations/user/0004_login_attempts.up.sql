@@ -0,0 +1,8 @@
+-- failed logins per "user:<username>" and "ip:<address>" key for the lockout
+
+CREATE TABLE IF NOT EXISTS login_attempts (
+    attempt_key VARCHAR(255) PRIMARY KEY,
+    failures INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMP,
+    last_failure_at TIMESTAMP
+);
@@ -0,0 +1,27 @@
+package env
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// settings read from the environment, shared by the server and its packages
+
+// an int from the environment, fallback when unset, invalid, zero or negative
+func Int(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+	return value
+}
+
+// a duration like 30s or 5m from the environment, fallback when unset, invalid, zero or negative
+func Duration(key string, fallback time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+	return value
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	"gogrpc-rpc-boiler/server/env"
+	logger "gogrpc-rpc-boiler/server/log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fine lifecycle: outstanding --> paid (fully paid) or waived
+const (
+	FineOutstanding = "outstanding"
+	FinePaid        = "paid"
+	FineWaived      = "waived"
+)
+
+// fines policy, amounts in cents, overridable with FINE_DAILY_RATE, FINE_CAP_PER_ITEM, FINE_GRACE_DAYS and FINE_BLOCK_THRESHOLD
+var (
+	fineDailyRate      = env.Int("FINE_DAILY_RATE", 25)
+	fineCapPerItem     = env.Int("FINE_CAP_PER_ITEM", 1000)
+	fineGraceDays      = env.Int("FINE_GRACE_DAYS", 0)        // late days that are never charged
+	fineBlockThreshold = env.Int("FINE_BLOCK_THRESHOLD", 500) // CreateBorrow is refused above this balance
+)
+
+var errFineNotFound = status.Error(codes.NotFound, "fine does not exist")
+
+// whole days between the due date and the return, 0 when returned on time
+func daysLate(returnDate time.Time, returnedDate time.Time) int {
+	if !returnedDate.After(returnDate) {
+		return 0
+	}
+	return int(returnedDate.Sub(returnDate).Hours() / 24)
+}
+
+// the fine for a loan returned daysLate days late, grace days are free and the total is capped per item
+func fineAmount(daysLate int) int {
+	charged := daysLate - fineGraceDays
+	if charged <= 0 {
+		return 0
+	}
+	if amount := charged * fineDailyRate; amount < fineCapPerItem {
+		return amount
+	}
+	return fineCapPerItem
+}
+
+// writes the fine of a late return to the ledger, returns the amount charged
+func chargeOverdueFine(ctx context.Context, tx *sql.Tx, borrowingID int, bookID int, userID int, branchID int, returnDate time.Time, returnedDate time.Time) (int, error) {
+	// days the branch of the loan was closed are not charged
+	calendar, err := loadCalendar(ctx, tx, branchID)
+	if err != nil {
+		return 0, err
+	}
+	late := calendar.openDaysLate(returnDate, returnedDate)
+	amount := fineAmount(late)
+	if amount == 0 {
+		return 0, nil
+	}
+	_, err = tx.ExecContext(ctx, "INSERT INTO fines (borrowing_id, book_id, user_id, days_late, amount_cents, paid_cents, status, created_at) VALUES ($1, $2, $3, $4, $5, 0, $6, $7)",
+		borrowingID, bookID, userID, late, amount, FineOutstanding, time.Now().UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert fine: %v", err)
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] fined user_id %d %d cents for borrow %d, %d days late", userID, amount, borrowingID, late))
+	return amount, nil
+}
+
+// what the user still owes across all outstanding fines, in cents
+func outstandingFines(ctx context.Context, db *sql.DB, userID int) (int, error) {
+	var balance int
+	err := db.QueryRowContext(ctx, "SELECT COALESCE(SUM(amount_cents - paid_cents), 0) FROM fines WHERE user_id = $1 AND status = $2", userID, FineOutstanding).Scan(&balance)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get outstanding fines: %v", err)
+	}
+	return balance, nil
+}
+
+const fineColumns = "fine_id, borrowing_id, book_id, user_id, days_late, amount_cents, paid_cents, status, note, created_at, closed_at"
+
+func scanFine(scanner interface{ Scan(...any) error }) (*proto.Fine, error) {
+	var fine proto.Fine
+	var createdAt time.Time
+	var closedAt sql.NullTime
+	err := scanner.Scan(&fine.FineId, &fine.BorrowingId, &fine.BookId, &fine.UserId, &fine.DaysLate, &fine.AmountCents, &fine.PaidCents, &fine.Status, &fine.Note, &createdAt, &closedAt)
+	if err != nil {
+		return nil, err
+	}
+	fine.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+	fine.ClosedAt = formatNullTime(closedAt)
+	return &fine, nil
+}
+
+func (s *server) GetUserFines(ctx context.Context, req *proto.IntRequest) (*proto.Fines, error) {
+	// members can only see their own fines
+	if err := requireSelfOrRole(callerFromContext(ctx), int(req.RequestInt), staffRoles); err != nil {
+		return nil, err
+	}
+
+	fines, err := s.borrows.ListFines(ctx, int(req.RequestInt))
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to get fines: %v", err))
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get fines: %v", err)
+	}
+	balance := 0
+	for _, fine := range fines {
+		if fine.Status == FineOutstanding {
+			balance += int(fine.AmountCents - fine.PaidCents)
+		}
+	}
+
+	return &proto.Fines{Fines: fines, OutstandingCents: int32(balance)}, nil
+}
+
+// records a payment on an outstanding fine, partial payments keep it outstanding
+func payFine(ctx context.Context, db *sql.DB, fineID int, amountCents int) (*proto.Fine, error) {
+	var fine *proto.Fine
+	err := inTx(ctx, db, func(tx *sql.Tx) error {
+		var amount, paid int
+		var fineStatus string
+		err := tx.QueryRowContext(ctx, "SELECT amount_cents, paid_cents, status FROM fines WHERE fine_id = $1 FOR UPDATE", fineID).Scan(&amount, &paid, &fineStatus)
+		if err == sql.ErrNoRows {
+			return errFineNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to get fine: %v", err)
+		}
+		if fineStatus != FineOutstanding {
+			return status.Errorf(codes.FailedPrecondition, "fine is already %s", fineStatus)
+		}
+		if amountCents > amount-paid {
+			return status.Errorf(codes.InvalidArgument, "payment exceeds the %d cents still owed", amount-paid)
+		}
+
+		paid += amountCents
+		var closedAt sql.NullTime
+		if paid == amount {
+			fineStatus = FinePaid
+			closedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+		}
+		_, err = tx.ExecContext(ctx, "UPDATE fines SET paid_cents = $1, status = $2, closed_at = $3 WHERE fine_id = $4", paid, fineStatus, closedAt, fineID)
+		if err != nil {
+			return fmt.Errorf("failed to update fine: %v", err)
+		}
+
+		fine, err = scanFine(tx.QueryRowContext(ctx, "SELECT "+fineColumns+" FROM fines WHERE fine_id = $1", fineID))
+		if err != nil {
+			return fmt.Errorf("failed to get fine: %v", err)
+		}
+		return nil
+	})
+	return fine, err
+}
+
+// records a payment at the desk
+func (s *server) PayFine(ctx context.Context, req *proto.FinePayment) (*proto.Fine, error) {
+	caller := callerFromContext(ctx)
+
+	if req.FineId <= 0 || req.AmountCents <= 0 {
+		logger.LogThis("[ERROR] fine_id and a positive amount_cents are required [Insufficient Input]")
+		return nil, fmt.Errorf("fine_id and a positive amount_cents are required [Insufficient Input]")
+	}
+
+	fine, err := s.borrows.PayFine(ctx, int(req.FineId), int(req.AmountCents))
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to pay fine %d: %v", req.FineId, err))
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to pay fine: %v", err)
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] %s recorded a payment of %d cents on fine %d", caller.Username, req.AmountCents, req.FineId))
+
+	return fine, nil
+}
+
+// forgives what is left of an outstanding fine, the reason is kept in the ledger
+func (s *server) WaiveFine(ctx context.Context, req *proto.FineWaiver) (*proto.Fine, error) {
+	caller := callerFromContext(ctx)
+
+	if req.FineId <= 0 || req.Reason == "" {
+		logger.LogThis("[ERROR] fine_id and reason are required [Insufficient Input]")
+		return nil, fmt.Errorf("fine_id and reason are required [Insufficient Input]")
+	}
+
+	fine, err := s.borrows.WaiveFine(ctx, int(req.FineId), fmt.Sprintf("waived by %s: %s", caller.Username, req.Reason))
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to waive fine %d: %v", req.FineId, err))
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to waive fine: %v", err)
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] %s waived fine %d: %s", caller.Username, req.FineId, req.Reason))
+
+	return fine, nil
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	jwtgenerator "gogrpc-rpc-boiler/server/jwt"
+	"gogrpc-rpc-boiler/server/models"
+	"gogrpc-rpc-boiler/server/notify"
+	"gogrpc-rpc-boiler/server/password"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fixtures shared by the tests of the server package
+
+// swaps the lockout settings for the test
+func useTestLockout(t *testing.T, perUser int, perIP int, base time.Duration, max time.Duration) {
+	oldUser, oldIP, oldBase, oldMax := maxFailuresPerUser, maxFailuresPerIP, lockoutBase, lockoutMax
+	maxFailuresPerUser, maxFailuresPerIP, lockoutBase, lockoutMax = perUser, perIP, base, max
+	t.Cleanup(func() {
+		maxFailuresPerUser, maxFailuresPerIP, lockoutBase, lockoutMax = oldUser, oldIP, oldBase, oldMax
+	})
+}
+
+func contextFromPeer(addr string, forwardedFor string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 40000}})
+	if forwardedFor != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", forwardedFor))
+	}
+	return ctx
+}
+
+// swaps the password hasher and policy for cheap bcrypt and the default policy
+func useTestPasswords(t *testing.T) {
+	oldHasher, oldPolicy := passwordHasher, passwordPolicy
+	passwordHasher, passwordPolicy = password.BcryptHasher{Cost: 5}, &password.Policy{MinLength: 8}
+	t.Cleanup(func() { passwordHasher, passwordPolicy = oldHasher, oldPolicy })
+}
+
+// a server on the memory stores with one member
+func newTestServer(t *testing.T) (*server, *memoryDB, int) {
+	useTestPasswords(t)
+	useTestLockout(t, 5, 20, time.Minute, time.Hour)
+	m := newMemoryDB()
+
+	s := &server{
+		users:         &memoryUserStore{m: m},
+		authors:       &memoryAuthorStore{m: m},
+		categories:    &memoryCategoryStore{m: m},
+		books:         &memoryBookStore{m: m},
+		borrows:       &memoryBorrowStore{m: m},
+		holds:         &memoryHoldStore{m: m},
+		branches:      &memoryBranchStore{m: m},
+		calendars:     &memoryCalendarStore{m: m},
+		policies:      &memoryPolicyStore{m: m},
+		notifications: &memoryNotificationStore{m: m},
+		resets:        &memoryPasswordResetStore{m: m},
+		sessions:      &memorySessionStore{m: m},
+		loginAttempts: &memoryLoginAttemptStore{m: m},
+		apiKeys:       &memoryAPIKeyStore{m: m},
+	}
+	hash, err := passwordHasher.Hash("old password 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	userID, err := s.users.Create(context.Background(), models.UserSensitive{Username: "reader", Password: hash, FirstName: optionalString("Rita"), LastName: optionalString("Reader"), Email: "reader@example.com", Role: RoleMember})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, m, userID
+}
+
+// signs the tokens of the test with a fresh Ed25519 key
+func useTestKeyring(t *testing.T) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "test.pem"), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("JWT_KEYS_DIR", dir)
+	t.Setenv("JWT_ACTIVE_KID", "")
+	if err := jwtgenerator.InitKeyring(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// authenticates an access token the way authInterceptor does
+func validateBearer(s *server, token string) (*principal, error) {
+	return s.validateJWT(metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token)))
+}
+
+// serves srv in process and points the inter-service calls at it
+func useTestInterService(t *testing.T, srv *server) {
+	listener := bufconn.Listen(1 << 20)
+	s := grpc.NewServer(grpc.UnaryInterceptor(srv.authInterceptor))
+	proto.RegisterUtilServiceServer(s, srv)
+	proto.RegisterUserServiceServer(s, srv)
+	proto.RegisterAuthorServiceServer(s, srv)
+	proto.RegisterCategoryServiceServer(s, srv)
+	proto.RegisterBookAndBorrowServiceServer(s, srv)
+	go s.Serve(listener)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldConn := interServiceConn
+	interServiceConn = conn
+	t.Cleanup(func() {
+		interServiceConn = oldConn
+		conn.Close()
+		s.Stop()
+	})
+}
+
+type recordingNotifier struct {
+	mu   sync.Mutex
+	sent []notify.Message
+}
+
+func (n *recordingNotifier) Send(ctx context.Context, msg notify.Message) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.sent = append(n.sent, msg)
+	return nil
+}
+
+// messages sent to one of the addresses
+func (n *recordingNotifier) sentTo(addresses ...string) int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	count := 0
+	for _, msg := range n.sent {
+		for _, address := range addresses {
+			if msg.To == address {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// swaps the notifier for one that keeps the messages
+func useRecordingNotifier(t *testing.T) *recordingNotifier {
+	oldNotifier := notifier
+	recorder := &recordingNotifier{}
+	notifier = recorder
+	t.Cleanup(func() { notifier = oldNotifier })
+	return recorder
+}
@@ -0,0 +1,364 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	"gogrpc-rpc-boiler/server/env"
+	logger "gogrpc-rpc-boiler/server/log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// hold lifecycle: waiting (in the queue) --> [in_transit (a copy is on its way to the pickup branch)]
+// --> ready (a copy is reserved until pickup_expires_at) --> fulfilled (borrowed), or cancelled / expired
+const (
+	HoldWaiting   = "waiting"
+	HoldInTransit = "in_transit"
+	HoldReady     = "ready"
+	HoldFulfilled = "fulfilled"
+	HoldCancelled = "cancelled"
+	HoldExpired   = "expired"
+)
+
+// how long a reserved copy waits for its patron, overridable with HOLD_PICKUP_WINDOW
+var holdPickupWindow = env.Duration("HOLD_PICKUP_WINDOW", 72*time.Hour)
+
+var (
+	errBookNotFound = status.Error(codes.NotFound, "book does not exist")
+	errHoldNotFound = status.Error(codes.NotFound, "hold does not exist")
+)
+
+// serializes stock and queue changes of one book
+func lockBook(ctx context.Context, tx *sql.Tx, bookID int) error {
+	var scan int
+	err := tx.QueryRowContext(ctx, "SELECT 1 FROM books WHERE book_id = $1 FOR UPDATE", bookID).Scan(&scan)
+	if err == sql.ErrNoRows {
+		return errBookNotFound
+	} else if err != nil {
+		return fmt.Errorf("failed to lock book: %v", err)
+	}
+	return nil
+}
+
+// puts the copy on the hold shelf for an active hold, false when the hold is no longer active
+func readyHold(ctx context.Context, tx *sql.Tx, holdID int, itemID int) (bool, error) {
+	now := time.Now().UTC()
+	res, err := tx.ExecContext(ctx, "UPDATE holds SET status = $1, ready_at = $2, pickup_expires_at = $3, item_id = $4 WHERE hold_id = $5 AND status IN ($6, $7)",
+		HoldReady, now, now.Add(holdPickupWindow), itemID, holdID, HoldWaiting, HoldInTransit)
+	if err != nil {
+		return false, fmt.Errorf("failed to reserve copy: %v", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return false, nil
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] item %d reserved for hold %d until %s", itemID, holdID, now.Add(holdPickupWindow).Format("2006-01-02 15:04:05")))
+	return true, nil
+}
+
+// reserves the copy for the oldest waiting hold of the book and returns the copy's next status:
+// on_hold when it can be picked up where it is, in_transit when it is sent to the hold's pickup branch,
+// empty when nobody is waiting
+func reserveForNextHold(ctx context.Context, tx *sql.Tx, bookID int, itemID int) (string, error) {
+	if err := lockBook(ctx, tx, bookID); err != nil {
+		return "", err
+	}
+	var holdID int
+	var pickupBranchID sql.NullInt64
+	err := tx.QueryRowContext(ctx, "SELECT hold_id, pickup_branch_id FROM holds WHERE book_id = $1 AND status = $2 ORDER BY placed_at, hold_id LIMIT 1 FOR UPDATE",
+		bookID, HoldWaiting).Scan(&holdID, &pickupBranchID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to get next hold: %v", err)
+	}
+
+	var branchID sql.NullInt64
+	if err := tx.QueryRowContext(ctx, "SELECT branch_id FROM items WHERE item_id = $1", itemID).Scan(&branchID); err != nil {
+		return "", fmt.Errorf("failed to get item: %v", err)
+	}
+	if pickupBranchID.Valid && branchID.Valid && pickupBranchID.Int64 != branchID.Int64 {
+		return ItemInTransit, routeToHold(ctx, tx, holdID, itemID, int(branchID.Int64), int(pickupBranchID.Int64))
+	}
+	if _, err := readyHold(ctx, tx, holdID, itemID); err != nil {
+		return "", err
+	}
+	return ItemOnHold, nil
+}
+
+// expires ready holds past their pickup time, their copies go to the next hold or back on the shelf.
+// the book must already be locked
+func expireHolds(ctx context.Context, tx *sql.Tx, bookID int) error {
+	rows, err := tx.QueryContext(ctx, "UPDATE holds SET status = $1, closed_at = $2 WHERE book_id = $3 AND status = $4 AND pickup_expires_at < $2 RETURNING hold_id, item_id",
+		HoldExpired, time.Now().UTC(), bookID, HoldReady)
+	if err != nil {
+		return fmt.Errorf("failed to expire holds: %v", err)
+	}
+	type expiredHold struct {
+		holdID int
+		itemID sql.NullInt64
+	}
+	var expired []expiredHold
+	for rows.Next() {
+		var hold expiredHold
+		if err := rows.Scan(&hold.holdID, &hold.itemID); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to expire holds: %v", err)
+		}
+		expired = append(expired, hold)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to expire holds: %v", err)
+	}
+
+	for _, hold := range expired {
+		logger.LogThis(fmt.Sprintf("[INFO] hold %d on book_id %d expired without pickup", hold.holdID, bookID))
+		if err := putBackBorrowedCopy(ctx, tx, bookID, hold.itemID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// closes the borrower's active hold on the book, returns the item_id of the copy waiting for them on the hold shelf, 0 if none.
+// a copy still in transit for the hold goes to the next hold once it is received
+func fulfillHold(ctx context.Context, tx *sql.Tx, bookID int, userID int) (int, error) {
+	var holdID int
+	var holdStatus string
+	var itemID sql.NullInt64
+	err := tx.QueryRowContext(ctx, "SELECT hold_id, status, item_id FROM holds WHERE book_id = $1 AND user_id = $2 AND status IN ($3, $4, $5) LIMIT 1 FOR UPDATE",
+		bookID, userID, HoldWaiting, HoldInTransit, HoldReady).Scan(&holdID, &holdStatus, &itemID)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to get hold: %v", err)
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE holds SET status = $1, closed_at = $2 WHERE hold_id = $3", HoldFulfilled, time.Now().UTC(), holdID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fulfill hold: %v", err)
+	}
+	if holdStatus != HoldReady {
+		return 0, nil
+	}
+	return int(itemID.Int64), nil
+}
+
+// sends the hold a copy was reserved or in transit for back to the front of the waiting queue
+func unreserveItem(ctx context.Context, tx *sql.Tx, itemID int) error {
+	_, err := tx.ExecContext(ctx, "UPDATE holds SET status = $1, ready_at = NULL, pickup_expires_at = NULL, item_id = NULL WHERE item_id = $2 AND status IN ($3, $4)",
+		HoldWaiting, itemID, HoldReady, HoldInTransit)
+	if err != nil {
+		return fmt.Errorf("failed to release hold: %v", err)
+	}
+	return nil
+}
+
+// queues the user for the book under the circulation policy of role, a copy on the shelf of another branch
+// is sent to the pickup branch right away
+func placeHold(ctx context.Context, db *sql.DB, bookID int, userID int, pickupBranchID int, role string) (*proto.Hold, error) {
+	var hold proto.Hold
+	err := inTx(ctx, db, func(tx *sql.Tx) error {
+		if err := lockPatron(ctx, tx, userID); err != nil {
+			return err
+		}
+		if err := lockBook(ctx, tx, bookID); err != nil {
+			return err
+		}
+		policy, err := policyForBook(ctx, tx, role, bookID)
+		if err != nil {
+			return err
+		}
+		if err := checkHoldLimit(ctx, tx, userID, policy); err != nil {
+			return err
+		}
+		if err := expireHolds(ctx, tx, bookID); err != nil {
+			return err
+		}
+
+		// a copy on the shelf at the pickup branch, at any branch without one, is borrowed instead
+		var available int
+		err = tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM items WHERE book_id = $1 AND status = $2 AND ($3 = 0 OR branch_id = $3)",
+			bookID, ItemAvailable, pickupBranchID).Scan(&available)
+		if err != nil {
+			return fmt.Errorf("failed to check stock: %v", err)
+		}
+		if available > 0 {
+			return status.Error(codes.FailedPrecondition, "book is available, borrow it instead")
+		}
+
+		var scan int
+		err = tx.QueryRowContext(ctx, "SELECT 1 FROM borrowing WHERE book_id = $1 AND user_id = $2 AND returned = FALSE LIMIT 1", bookID, userID).Scan(&scan)
+		if err == nil {
+			return status.Error(codes.FailedPrecondition, "user already borrows this book")
+		} else if err != sql.ErrNoRows {
+			return fmt.Errorf("failed to check borrowings: %v", err)
+		}
+
+		err = tx.QueryRowContext(ctx, "SELECT 1 FROM holds WHERE book_id = $1 AND user_id = $2 AND status IN ($3, $4, $5) LIMIT 1",
+			bookID, userID, HoldWaiting, HoldInTransit, HoldReady).Scan(&scan)
+		if err == nil {
+			return status.Error(codes.AlreadyExists, "user already holds this book")
+		} else if err != sql.ErrNoRows {
+			return fmt.Errorf("failed to check holds: %v", err)
+		}
+
+		now := time.Now().UTC()
+		err = tx.QueryRowContext(ctx, "INSERT INTO holds (book_id, user_id, status, placed_at, pickup_branch_id) VALUES ($1, $2, $3, $4, NULLIF($5, 0)) RETURNING hold_id",
+			bookID, userID, HoldWaiting, now, pickupBranchID).Scan(&hold.HoldId)
+		if err != nil {
+			return fmt.Errorf("failed to insert hold: %v", err)
+		}
+		hold.BookId = int32(bookID)
+		hold.UserId = int32(userID)
+		hold.PickupBranchId = int32(pickupBranchID)
+		hold.PlacedAt = now.Format("2006-01-02 15:04:05")
+
+		// a copy on the shelf of another branch is sent over right away
+		if pickupBranchID != 0 {
+			var itemID, branchID int
+			err = tx.QueryRowContext(ctx, "SELECT item_id, branch_id FROM items WHERE book_id = $1 AND status = $2 AND branch_id IS NOT NULL ORDER BY item_id LIMIT 1 FOR UPDATE",
+				bookID, ItemAvailable).Scan(&itemID, &branchID)
+			if err == nil {
+				if err := routeToHold(ctx, tx, int(hold.HoldId), itemID, branchID, pickupBranchID); err != nil {
+					return err
+				}
+				if err := setItemStatus(ctx, tx, itemID, ItemInTransit); err != nil {
+					return err
+				}
+				if err := syncStock(ctx, tx, bookID); err != nil {
+					return err
+				}
+				hold.Status = HoldInTransit
+				return tx.QueryRowContext(ctx, "SELECT barcode FROM items WHERE item_id = $1", itemID).Scan(&hold.Barcode)
+			} else if err != sql.ErrNoRows {
+				return fmt.Errorf("failed to check stock: %v", err)
+			}
+		}
+
+		err = tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM holds WHERE book_id = $1 AND status = $2", bookID, HoldWaiting).Scan(&hold.Position)
+		if err != nil {
+			return fmt.Errorf("failed to get queue position: %v", err)
+		}
+		hold.Status = HoldWaiting
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &hold, nil
+}
+
+func (s *server) PlaceHold(ctx context.Context, req *proto.HoldRequest) (*proto.Hold, error) {
+	caller := callerFromContext(ctx)
+
+	if req.BookId <= 0 || req.UserId <= 0 {
+		logger.LogThis("[ERROR] book_id and user_id are required [Insufficient Input]")
+		return nil, fmt.Errorf("book_id and user_id are required [Insufficient Input]")
+	}
+
+	// members can only place holds for themselves
+	if err := requireSelfOrRole(caller, int(req.UserId), staffRoles); err != nil {
+		return nil, err
+	}
+
+	// check if user exists and get the role its circulation policy is keyed by
+	role, err := borrowerRole(ctx, int(req.UserId))
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+		return nil, err
+	}
+
+	hold, err := s.holds.Place(ctx, int(req.BookId), int(req.UserId), int(req.PickupBranchId), role)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to place hold: %v", err))
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to place hold: %v", err)
+	}
+
+	return hold, nil
+}
+
+// cancels an active hold, checkAccess sees the holder before anything changes.
+// the reserved copy goes to the next patron, a copy in transit once it is received
+func cancelHold(ctx context.Context, db *sql.DB, holdID int, checkAccess func(userID int) error) error {
+	var bookID, userID int
+	err := db.QueryRowContext(ctx, "SELECT book_id, user_id FROM holds WHERE hold_id = $1", holdID).Scan(&bookID, &userID)
+	if err == sql.ErrNoRows {
+		return errHoldNotFound
+	} else if err != nil {
+		return fmt.Errorf("failed to get hold: %v", err)
+	}
+	if err := checkAccess(userID); err != nil {
+		return err
+	}
+
+	return inTx(ctx, db, func(tx *sql.Tx) error {
+		if err := lockBook(ctx, tx, bookID); err != nil {
+			return err
+		}
+		var previous string
+		var itemID sql.NullInt64
+		err := tx.QueryRowContext(ctx, "SELECT status, item_id FROM holds WHERE hold_id = $1 FOR UPDATE", holdID).Scan(&previous, &itemID)
+		if err != nil {
+			return fmt.Errorf("failed to get hold: %v", err)
+		}
+		if previous != HoldWaiting && previous != HoldInTransit && previous != HoldReady {
+			return status.Error(codes.FailedPrecondition, "hold is no longer active")
+		}
+		_, err = tx.ExecContext(ctx, "UPDATE holds SET status = $1, closed_at = $2 WHERE hold_id = $3", HoldCancelled, time.Now().UTC(), holdID)
+		if err != nil {
+			return fmt.Errorf("failed to cancel hold: %v", err)
+		}
+		if previous == HoldReady {
+			return putBackBorrowedCopy(ctx, tx, bookID, itemID)
+		}
+		return nil
+	})
+}
+
+func (s *server) CancelHold(ctx context.Context, req *proto.IntRequest) (*proto.StringResponse, error) {
+	caller := callerFromContext(ctx)
+
+	// members can only cancel their own holds
+	err := s.holds.Cancel(ctx, int(req.RequestInt), func(userID int) error {
+		return requireSelfOrRole(caller, userID, staffRoles)
+	})
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to cancel hold: %v", err))
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to cancel hold: %v", err)
+	}
+
+	return &proto.StringResponse{ResponseStr: "successfully cancelled hold"}, nil
+}
+
+func (s *server) ListHolds(ctx context.Context, req *proto.HoldRequest) (*proto.Holds, error) {
+	caller := callerFromContext(ctx)
+
+	// members only see their own holds
+	userID := int(req.UserId)
+	if !hasRole(staffRoles, caller.Role) {
+		if userID != 0 && userID != caller.UserID {
+			return nil, requireSelfOrRole(caller, userID, staffRoles)
+		}
+		userID = caller.UserID
+	}
+
+	holds, err := s.holds.List(ctx, int(req.BookId), userID)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to get holds: %v", err))
+		return nil, fmt.Errorf("failed to get holds: %v", err)
+	}
+
+	return &proto.Holds{Holds: holds}, nil
+}
@@ -0,0 +1,227 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	logger "gogrpc-rpc-boiler/server/log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// item status: available (on the shelf) --> on_loan --> available, or on_hold (reserved for a ready hold),
+// or in_transit (shipped to another branch). lost and damaged copies are out of circulation and not counted in books.total_stock
+const (
+	ItemAvailable = "available"
+	ItemOnLoan    = "on_loan"
+	ItemOnHold    = "on_hold"
+	ItemInTransit = "in_transit"
+	ItemLost      = "lost"
+	ItemDamaged   = "damaged"
+)
+
+// physical condition of a copy, a damaged copy leaves circulation as soon as it is back on the shelf
+const (
+	ConditionGood    = "good"
+	ConditionWorn    = "worn"
+	ConditionDamaged = "damaged"
+)
+
+var (
+	errItemNotFound    = status.Error(codes.NotFound, "item does not exist")
+	errItemUnavailable = status.Error(codes.FailedPrecondition, "item is not available")
+	errItemReserved    = status.Error(codes.FailedPrecondition, "item is reserved for another patron")
+	errItemElsewhere   = status.Error(codes.FailedPrecondition, "item is at another branch")
+)
+
+func isValidCondition(condition string) bool {
+	return condition == ConditionGood || condition == ConditionWorn || condition == ConditionDamaged
+}
+
+func setItemStatus(ctx context.Context, tx *sql.Tx, itemID int, itemStatus string) error {
+	_, err := tx.ExecContext(ctx, "UPDATE items SET status = $1, updated_at = $2 WHERE item_id = $3", itemStatus, time.Now().UTC(), itemID)
+	if err != nil {
+		return fmt.Errorf("failed to update item: %v", err)
+	}
+	return nil
+}
+
+const itemColumns = "item_id, book_id, barcode, condition, status, shelf_location, created_at, updated_at, COALESCE(branch_id, 0)"
+
+func scanItem(scanner interface{ Scan(...any) error }) (*proto.Item, error) {
+	var item proto.Item
+	var createdAt, updatedAt time.Time
+	err := scanner.Scan(&item.ItemId, &item.BookId, &item.Barcode, &item.Condition, &item.Status, &item.ShelfLocation, &createdAt, &updatedAt, &item.BranchId)
+	if err != nil {
+		return nil, err
+	}
+	item.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+	item.UpdatedAt = updatedAt.Format("2006-01-02 15:04:05")
+	return &item, nil
+}
+
+// adds a copy of a book at a branch, it goes to the first waiting hold or on the shelf
+func (s *server) CreateItem(ctx context.Context, req *proto.Item) (*proto.Item, error) {
+	caller := callerFromContext(ctx)
+
+	if req.BookId <= 0 || req.Barcode == "" || req.BranchId <= 0 {
+		logger.LogThis("[ERROR] book_id, barcode and branch_id are required [Insufficient Input]")
+		return nil, fmt.Errorf("book_id, barcode and branch_id are required [Insufficient Input]")
+	}
+	condition := req.Condition
+	if condition == "" {
+		condition = ConditionGood
+	}
+	if !isValidCondition(condition) {
+		logger.LogThis(fmt.Sprintf("[ERROR] invalid condition: %s", condition))
+		return nil, status.Errorf(codes.InvalidArgument, "invalid condition: %s", condition)
+	}
+
+	item, err := s.books.AddItem(ctx, &proto.Item{
+		BookId:        req.BookId,
+		Barcode:       req.Barcode,
+		Condition:     condition,
+		ShelfLocation: req.ShelfLocation,
+		BranchId:      req.BranchId,
+	})
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to create item: %v", err))
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to create item: %v", err)
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] %s added item %d (%s) to book_id %d", caller.Username, item.ItemId, item.Barcode, item.BookId))
+
+	return item, nil
+}
+
+func (s *server) ListItems(ctx context.Context, req *proto.ItemQuery) (*proto.Items, error) {
+	items, err := s.books.ListItems(ctx, int(req.BookId), req.Status, int(req.BranchId))
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to get items: %v", err))
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get items: %v", err)
+	}
+
+	return &proto.Items{Items: items}, nil
+}
+
+// applies change to the locked item with the barcode and recomputes the book's stock
+func changeItem(ctx context.Context, db *sql.DB, barcode string, change func(tx *sql.Tx, itemID int, itemStatus string) error) (*proto.Item, error) {
+	var item *proto.Item
+	err := inTx(ctx, db, func(tx *sql.Tx) error {
+		var bookID int
+		err := tx.QueryRowContext(ctx, "SELECT book_id FROM items WHERE barcode = $1", barcode).Scan(&bookID)
+		if err == sql.ErrNoRows {
+			return errItemNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to get item: %v", err)
+		}
+		if err := lockBook(ctx, tx, bookID); err != nil {
+			return err
+		}
+
+		var itemID int
+		var itemStatus string
+		if err := tx.QueryRowContext(ctx, "SELECT item_id, status FROM items WHERE barcode = $1 FOR UPDATE", barcode).Scan(&itemID, &itemStatus); err != nil {
+			return fmt.Errorf("failed to get item: %v", err)
+		}
+		if err := change(tx, itemID, itemStatus); err != nil {
+			return err
+		}
+		if err := syncStock(ctx, tx, bookID); err != nil {
+			return err
+		}
+
+		item, err = scanItem(tx.QueryRowContext(ctx, "SELECT "+itemColumns+" FROM items WHERE item_id = $1", itemID))
+		if err != nil {
+			return fmt.Errorf("failed to get item: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update item: %v", err)
+	}
+	return item, nil
+}
+
+// a lost copy on loan stays on the borrow, returning it later puts it back in circulation
+func markItemLost(ctx context.Context, db *sql.DB, barcode string) (*proto.Item, error) {
+	return changeItem(ctx, db, barcode, func(tx *sql.Tx, itemID int, itemStatus string) error {
+		if itemStatus == ItemLost {
+			return status.Error(codes.FailedPrecondition, "item is already lost")
+		}
+		// a copy lost on the hold shelf or on its way to another branch sends its hold back to the queue
+		if itemStatus == ItemOnHold || itemStatus == ItemInTransit {
+			if err := unreserveItem(ctx, tx, itemID); err != nil {
+				return err
+			}
+		}
+		if itemStatus == ItemInTransit {
+			if err := cancelTransfers(ctx, tx, itemID); err != nil {
+				return err
+			}
+		}
+		return setItemStatus(ctx, tx, itemID, ItemLost)
+	})
+}
+
+// a damaged copy on loan stays on the borrow and leaves circulation when it is returned,
+// one in transit when it is received
+func markItemDamaged(ctx context.Context, db *sql.DB, barcode string) (*proto.Item, error) {
+	return changeItem(ctx, db, barcode, func(tx *sql.Tx, itemID int, itemStatus string) error {
+		if itemStatus == ItemDamaged {
+			return status.Error(codes.FailedPrecondition, "item is already damaged")
+		}
+		_, err := tx.ExecContext(ctx, "UPDATE items SET condition = $1, updated_at = $2 WHERE item_id = $3", ConditionDamaged, time.Now().UTC(), itemID)
+		if err != nil {
+			return fmt.Errorf("failed to update item: %v", err)
+		}
+		switch itemStatus {
+		case ItemOnHold:
+			if err := unreserveItem(ctx, tx, itemID); err != nil {
+				return err
+			}
+			return setItemStatus(ctx, tx, itemID, ItemDamaged)
+		case ItemAvailable:
+			return setItemStatus(ctx, tx, itemID, ItemDamaged)
+		}
+		return nil
+	})
+}
+
+func (s *server) MarkItemLost(ctx context.Context, req *proto.StringRequest) (*proto.Item, error) {
+	caller := callerFromContext(ctx)
+
+	item, err := s.books.MarkItemLost(ctx, req.RequestStr)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to mark item %s lost: %v", req.RequestStr, err))
+		return nil, err
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] %s marked item %s lost", caller.Username, req.RequestStr))
+
+	return item, nil
+}
+
+func (s *server) MarkItemDamaged(ctx context.Context, req *proto.StringRequest) (*proto.Item, error) {
+	caller := callerFromContext(ctx)
+
+	item, err := s.books.MarkItemDamaged(ctx, req.RequestStr)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to mark item %s damaged: %v", req.RequestStr, err))
+		return nil, err
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] %s marked item %s damaged", caller.Username, req.RequestStr))
+
+	return item, nil
+}
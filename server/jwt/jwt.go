@@ -1,18 +1,139 @@
 package interceptor
 
 import (
-	"os"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	"gogrpc-rpc-boiler/server/env"
+
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func GenerateJWT(username string) (string, error) {
-    secret := []byte(os.Getenv("JWT_SECRET"))
+// token lifetimes, overridable with ACCESS_TOKEN_TTL / REFRESH_TOKEN_TTL (e.g. "15m", "168h")
+var (
+    AccessTokenTTL  = env.Duration("ACCESS_TOKEN_TTL", 15*time.Minute)
+    RefreshTokenTTL = env.Duration("REFRESH_TOKEN_TTL", 7*24*time.Hour)
+)
+
+// every kind of token is signed by the keyring published through the JWKS, the aud claim and typ header
+// keep them apart for any verifier: only access tokens are for the API
+const (
+    AccessTokenAudience = "library-api"
+    accessTokenType     = "at+jwt"
+
+    verifyEmailAudience = "library-verify-email"
+    verifyEmailType     = "verify-email+jwt"
+)
+
+// short-lived access token, bound to a session (sid) so it can be revoked server-side
+func GenerateJWT(userID int, username string, role string, sessionID int) (string, error) {
+    now := time.Now()
     claims := jwt.MapClaims{
+        "user_id":  userID,
         "username": username,
-        "exp":      time.Now().Add(time.Hour * 24).Unix(), // 24h
+        "role":     role,
+        "sid":      sessionID,
+        "aud":      AccessTokenAudience,
+        "iat":      now.Unix(),
+        "exp":      now.Add(AccessTokenTTL).Unix(),
+    }
+    return sign(claims, accessTokenType)
+}
+
+// impersonation token lifetime, overridable with IMPERSONATION_TOKEN_TTL
+var ImpersonationTokenTTL = env.Duration("IMPERSONATION_TOKEN_TTL", 10*time.Minute)
+
+// access token for targetUsername minted by an admin, the admin is kept in the "act" claim
+// and the token is bound to the admin's session, so logging the admin out revokes it too
+func GenerateImpersonationJWT(userID int, username string, role string, impersonatorID int, impersonator string, sessionID int) (string, time.Time, error) {
+    now := time.Now()
+    expiresAt := now.Add(ImpersonationTokenTTL)
+    claims := jwt.MapClaims{
+        "user_id":  userID,
+        "username": username,
+        "role":     role,
+        "sid":      sessionID,
+        "act": map[string]interface{}{
+            "user_id":  impersonatorID,
+            "username": impersonator,
+        },
+        "aud": AccessTokenAudience,
+        "iat": now.Unix(),
+        "exp": expiresAt.Unix(),
+    }
+    signed, err := sign(claims, accessTokenType)
+    return signed, expiresAt, err
+}
+
+// random refresh secret, only its hash is stored in the sessions table
+func NewRefreshSecret() (secret string, hash string, err error) {
+    buf := make([]byte, 32)
+    if _, err := rand.Read(buf); err != nil {
+        return "", "", err
+    }
+    secret = base64.RawURLEncoding.EncodeToString(buf)
+    return secret, HashRefreshSecret(secret), nil
+}
+
+func HashRefreshSecret(secret string) string {
+    sum := sha256.Sum256([]byte(secret))
+    return hex.EncodeToString(sum[:])
+}
+
+// refresh token format: <session_id>.<secret>
+func FormatRefreshToken(sessionID int, secret string) string {
+    return fmt.Sprintf("%d.%s", sessionID, secret)
+}
+
+func ParseRefreshToken(token string) (int, string, error) {
+    parts := strings.SplitN(token, ".", 2)
+    if len(parts) != 2 || parts[1] == "" {
+        return 0, "", fmt.Errorf("malformed refresh token")
+    }
+    sessionID, err := strconv.Atoi(parts[0])
+    if err != nil {
+        return 0, "", fmt.Errorf("malformed refresh token")
+    }
+    return sessionID, parts[1], nil
+}
+
+// email verification token lifetime, overridable with EMAIL_VERIFICATION_TTL
+var EmailVerificationTTL = env.Duration("EMAIL_VERIFICATION_TTL", 48*time.Hour)
+
+// marks tokens that are not access tokens
+const purposeVerifyEmail = "verify_email"
+
+// signed proof that user_id owns email, sent to that address after registration
+func GenerateEmailVerificationToken(userID int, email string) (string, error) {
+    now := time.Now()
+    claims := jwt.MapClaims{
+        "user_id": userID,
+        "email":   email,
+        "purpose": purposeVerifyEmail,
+        "aud":     verifyEmailAudience,
+        "iat":     now.Unix(),
+        "exp":     now.Add(EmailVerificationTTL).Unix(),
+    }
+    return sign(claims, verifyEmailType)
+}
+
+func ParseEmailVerificationToken(tokenString string) (int, string, error) {
+    token, err := parse(tokenString, verifyEmailType, verifyEmailAudience)
+    if err != nil || !token.Valid {
+        return 0, "", fmt.Errorf("invalid verification token")
+    }
+    claims := token.Claims.(jwt.MapClaims)
+    purpose, _ := claims["purpose"].(string)
+    userID, _ := claims["user_id"].(float64)
+    email, _ := claims["email"].(string)
+    if purpose != purposeVerifyEmail || userID == 0 || email == "" {
+        return 0, "", fmt.Errorf("invalid verification token")
     }
-    token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-    return token.SignedString(secret)
-}
\ No newline at end of file
+    return int(userID), email, nil
+}
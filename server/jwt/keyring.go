@@ -0,0 +1,203 @@
+package interceptor
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// one signing/verification key, identified by its kid
+type key struct {
+	kid    string
+	method jwt.SigningMethod
+	signer crypto.Signer // nil for verify-only (retired) keys
+	public crypto.PublicKey
+}
+
+// set of keys valid at the same time, only the active one signs
+type Keyring struct {
+	keys   map[string]*key
+	active *key
+}
+
+// public key in JWKS format (RFC 7517)
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n,omitempty"`   // RSA modulus
+	E   string `json:"e,omitempty"`   // RSA exponent
+	Crv string `json:"crv,omitempty"` // OKP curve
+	X   string `json:"x,omitempty"`   // OKP public key
+}
+
+var keyring *Keyring
+
+// loads the keyring from JWT_KEYS_DIR, must run before any token is issued or verified.
+// every <kid>.pem file holds one RSA or Ed25519 key: a private key can sign and verify,
+// a public key only verifies (keep retired keys around until their tokens have expired).
+// JWT_ACTIVE_KID picks the signing key, by default the last private key in name order.
+func InitKeyring() error {
+	ring, err := LoadKeyring(os.Getenv("JWT_KEYS_DIR"), os.Getenv("JWT_ACTIVE_KID"))
+	if err != nil {
+		return err
+	}
+	keyring = ring
+	return nil
+}
+
+func LoadKeyring(dir string, activeKid string) (*Keyring, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("JWT_KEYS_DIR is not set")
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	ring := &Keyring{keys: map[string]*key{}}
+	for _, file := range files {
+		kid := strings.TrimSuffix(filepath.Base(file), ".pem")
+		k, err := loadKey(file, kid)
+		if err != nil {
+			return nil, err
+		}
+		ring.keys[kid] = k
+		if activeKid == "" && k.signer != nil {
+			ring.active = k
+		}
+	}
+
+	if activeKid != "" {
+		ring.active = ring.keys[activeKid]
+		if ring.active == nil {
+			return nil, fmt.Errorf("active key %q not found in %s", activeKid, dir)
+		}
+	}
+	if ring.active == nil || ring.active.signer == nil {
+		return nil, fmt.Errorf("no private signing key found in %s", dir)
+	}
+
+	return ring, nil
+}
+
+func loadKey(file string, kid string) (*key, error) {
+	raw, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, fmt.Errorf("%s: no PEM block found", file)
+	}
+
+	var parsed interface{}
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%s: unsupported PEM block %q", file, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", file, err)
+	}
+
+	k := &key{kid: kid}
+	switch v := parsed.(type) {
+	case *rsa.PrivateKey:
+		k.method, k.signer, k.public = jwt.SigningMethodRS256, v, &v.PublicKey
+	case *rsa.PublicKey:
+		k.method, k.public = jwt.SigningMethodRS256, v
+	case ed25519.PrivateKey:
+		k.method, k.signer, k.public = jwt.SigningMethodEdDSA, v, v.Public()
+	case ed25519.PublicKey:
+		k.method, k.public = jwt.SigningMethodEdDSA, v
+	default:
+		return nil, fmt.Errorf("%s: unsupported key type %T, use RSA or Ed25519", file, parsed)
+	}
+	return k, nil
+}
+
+// signs claims with the active key, the kid and the token type go into the header
+func sign(claims jwt.Claims, typ string) (string, error) {
+	if keyring == nil {
+		return "", fmt.Errorf("keyring is not initialized")
+	}
+	token := jwt.NewWithClaims(keyring.active.method, claims)
+	token.Header["kid"] = keyring.active.kid
+	token.Header["typ"] = typ
+	return token.SignedString(keyring.active.signer)
+}
+
+// parses and verifies an access token, tokens of any other type or audience are rejected
+func ParseAccessToken(tokenString string) (*jwt.Token, error) {
+	return parse(tokenString, accessTokenType, AccessTokenAudience)
+}
+
+// parses and verifies a token of the type and audience against the keyring, picking the key by its kid header
+func parse(tokenString string, typ string, audience string) (*jwt.Token, error) {
+	if keyring == nil {
+		return nil, fmt.Errorf("keyring is not initialized")
+	}
+	return jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if header, _ := token.Header["typ"].(string); header != typ {
+			return nil, fmt.Errorf("token type %q is not %q", header, typ)
+		}
+		kid, _ := token.Header["kid"].(string)
+		k, ok := keyring.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown kid %q", kid)
+		}
+		if token.Method.Alg() != k.method.Alg() {
+			return nil, fmt.Errorf("alg %s does not match key %q", token.Method.Alg(), kid)
+		}
+		return k.public, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}), jwt.WithAudience(audience))
+}
+
+// public half of every key in the keyring
+func PublicJWKS() []JWK {
+	if keyring == nil {
+		return nil
+	}
+	kids := make([]string, 0, len(keyring.keys))
+	for kid := range keyring.keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	jwks := make([]JWK, 0, len(kids))
+	for _, kid := range kids {
+		k := keyring.keys[kid]
+		jwk := JWK{Kid: kid, Alg: k.method.Alg(), Use: "sig"}
+		switch pub := k.public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		}
+		jwks = append(jwks, jwk)
+	}
+	return jwks
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	"gogrpc-rpc-boiler/server/env"
+	logger "gogrpc-rpc-boiler/server/log"
+	"gogrpc-rpc-boiler/server/password"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// failed-attempt tracking, every key (user:<username>, ip:<addr>) is locked out
+// once it reaches its limit, the lockout doubles with every further failure
+var (
+	maxFailuresPerUser = env.Int("LOGIN_MAX_FAILURES_PER_USER", 5)
+	maxFailuresPerIP   = env.Int("LOGIN_MAX_FAILURES_PER_IP", 20)
+	lockoutBase        = env.Duration("LOGIN_LOCKOUT_BASE", time.Minute)
+	lockoutMax         = env.Duration("LOGIN_LOCKOUT_MAX", time.Hour)
+	failureWindow      = env.Duration("LOGIN_FAILURE_WINDOW", 24*time.Hour) // counters reset after this long without failures
+)
+
+// same error for unknown user and wrong password
+var errInvalidCredentials = status.Error(codes.Unauthenticated, "invalid credentials")
+
+// peers whose x-forwarded-for is believed, TRUSTED_PROXIES is a comma separated list of IPs or CIDRs.
+// unset it is loopback, where the bundled Fiber gateway connects from; set but empty trusts no peer
+var trustedProxies = parseTrustedProxies()
+
+func parseTrustedProxies() []*net.IPNet {
+	value, ok := os.LookupEnv("TRUSTED_PROXIES")
+	if !ok {
+		value = "127.0.0.0/8,::1/128"
+	}
+	var proxies []*net.IPNet
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			if ip := net.ParseIP(entry); ip != nil && ip.To4() != nil {
+				entry += "/32"
+			} else {
+				entry += "/128"
+			}
+		}
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			logger.LogThis(fmt.Sprintf("[ERROR] ignoring invalid TRUSTED_PROXIES entry %q: %v", entry, err))
+			continue
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies
+}
+
+func isTrustedProxy(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// client address. a trusted proxy (the Fiber gateway) forwards the real one in x-forwarded-for,
+// its last entry is the address the proxy saw. anyone else could put any address there, so for
+// other peers the header is ignored
+func clientIP(ctx context.Context) string {
+	addr := "unknown"
+	if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			addr = host
+		}
+	}
+	if !isTrustedProxy(addr) {
+		return addr
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+			entries := strings.Split(forwarded[len(forwarded)-1], ",")
+			if last := strings.TrimSpace(entries[len(entries)-1]); last != "" {
+				return last
+			}
+		}
+	}
+	return addr
+}
+
+func attemptKeys(ctx context.Context, username string) []string {
+	keys := []string{"ip:" + clientIP(ctx)}
+	if username != "" {
+		keys = append(keys, "user:"+username)
+	}
+	return keys
+}
+
+// rejects the call while any of the keys is locked out
+func (s *server) checkLockout(ctx context.Context, keys []string) error {
+	for _, key := range keys {
+		lockedUntil, err := s.loginAttempts.LockedUntil(ctx, key)
+		if err != nil {
+			logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+			return err
+		}
+		if time.Now().UTC().Before(lockedUntil) {
+			logger.LogThis(fmt.Sprintf("[ERROR] login locked out for %s until %s", key, lockedUntil.Format("2006-01-02 15:04:05")))
+			return status.Error(codes.ResourceExhausted, "too many failed attempts, try again later")
+		}
+	}
+	return nil
+}
+
+func (s *server) recordLoginFailure(ctx context.Context, keys []string) {
+	now := time.Now().UTC()
+	for _, key := range keys {
+		failures, err := s.loginAttempts.RecordFailure(ctx, key, now, failureWindow)
+		if err != nil {
+			logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+			continue
+		}
+
+		limit := maxFailuresPerUser
+		if strings.HasPrefix(key, "ip:") {
+			limit = maxFailuresPerIP
+		}
+		if failures < limit {
+			continue
+		}
+
+		// exponential backoff: base, 2*base, 4*base, ... capped at lockoutMax
+		lockout := lockoutMax
+		if shift := failures - limit; shift < 30 {
+			if backoff := lockoutBase << uint(shift); backoff > 0 && backoff < lockoutMax {
+				lockout = backoff
+			}
+		}
+		if err := s.loginAttempts.Lock(ctx, key, now.Add(lockout)); err != nil {
+			logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+			continue
+		}
+		logger.LogThis(fmt.Sprintf("[INFO] %s locked out for %s after %d failed attempts", key, lockout, failures))
+	}
+}
+
+func (s *server) clearLoginFailures(ctx context.Context, key string) error {
+	if err := s.loginAttempts.Clear(ctx, key); err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+		return err
+	}
+	return nil
+}
+
+// checks a password with lockout and a uniform error, found is false when the user lookup failed.
+// the IP counter is not cleared on success, a valid account must not reset an attacker's budget
+func (s *server) checkCredentials(ctx context.Context, username string, storedHash string, found bool, plain string) error {
+	keys := attemptKeys(ctx, username)
+	if err := s.checkLockout(ctx, keys); err != nil {
+		return err
+	}
+
+	if !found {
+		password.Verify(dummyPasswordHash, plain)
+		s.recordLoginFailure(ctx, keys)
+		logger.LogThis(fmt.Sprintf("[ERROR] invalid credentials, unknown user: %s", username))
+		return errInvalidCredentials
+	}
+
+	if !password.Verify(storedHash, plain) {
+		s.recordLoginFailure(ctx, keys)
+		logger.LogThis(fmt.Sprintf("[ERROR] invalid credentials, user: %s", username))
+		return errInvalidCredentials
+	}
+
+	s.clearLoginFailures(ctx, "user:"+username)
+	return nil
+}
+
+// admin-only, lifts the lockout of a username
+func (s *server) UnlockAccount(ctx context.Context, req *proto.StringRequest) (*proto.StringResponse, error) {
+	if req.RequestStr == "" {
+		logger.LogThis("[ERROR] username is required [Insufficient Input]")
+		return nil, fmt.Errorf("username is required [Insufficient Input]")
+	}
+
+	if err := s.clearLoginFailures(ctx, "user:"+req.RequestStr); err != nil {
+		return nil, err
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] %s unlocked account %s", callerFromContext(ctx).Username, req.RequestStr))
+
+	return &proto.StringResponse{ResponseStr: fmt.Sprintf("account %s unlocked", req.RequestStr)}, nil
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"gogrpc-rpc-boiler/server/password"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClientIPTrustsForwardedForOnlyFromProxies(t *testing.T) {
+	for _, tc := range []struct {
+		peer, forwardedFor, want string
+	}{
+		{"127.0.0.1", "203.0.113.7", "203.0.113.7"},
+		{"127.0.0.1", "198.51.100.1, 203.0.113.7", "203.0.113.7"},
+		{"127.0.0.1", "", "127.0.0.1"},
+		{"::1", "203.0.113.7", "203.0.113.7"},
+		{"198.51.100.9", "203.0.113.7", "198.51.100.9"},
+	} {
+		if got := clientIP(contextFromPeer(tc.peer, tc.forwardedFor)); got != tc.want {
+			t.Errorf("clientIP from %s with x-forwarded-for %q = %s, want %s", tc.peer, tc.forwardedFor, got, tc.want)
+		}
+	}
+}
+
+func TestTrustedProxiesFromEnv(t *testing.T) {
+	t.Setenv("TRUSTED_PROXIES", "10.0.0.0/8, 192.0.2.10, not-an-ip")
+	old := trustedProxies
+	trustedProxies = parseTrustedProxies()
+	t.Cleanup(func() { trustedProxies = old })
+
+	for addr, want := range map[string]bool{"10.1.2.3": true, "192.0.2.10": true, "192.0.2.11": false, "127.0.0.1": false} {
+		if got := isTrustedProxy(addr); got != want {
+			t.Errorf("isTrustedProxy(%s) = %v, want %v", addr, got, want)
+		}
+	}
+}
+
+func TestLockoutAfterRepeatedFailures(t *testing.T) {
+	useTestLockout(t, 3, 100, time.Minute, time.Hour)
+	s := &server{loginAttempts: &memoryLoginAttemptStore{m: newMemoryDB()}}
+	hash, err := password.BcryptHasher{Cost: 4}.Hash("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := contextFromPeer("198.51.100.9", "")
+
+	for i := 0; i < 3; i++ {
+		if err := s.checkCredentials(ctx, "alice", hash, true, "wrong"); err != errInvalidCredentials {
+			t.Fatalf("attempt %d = %v, want %v", i+1, err, errInvalidCredentials)
+		}
+	}
+	// locked out, even with the right password
+	if err := s.checkCredentials(ctx, "alice", hash, true, "correct horse"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("login while locked out = %v, want ResourceExhausted", err)
+	}
+	// other users from the same address are not affected below the IP limit
+	if err := s.checkCredentials(ctx, "bob", hash, true, "correct horse"); err != nil {
+		t.Fatalf("login of another user = %v, want success", err)
+	}
+
+	if err := s.clearLoginFailures(ctx, "user:alice"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.checkCredentials(ctx, "alice", hash, true, "correct horse"); err != nil {
+		t.Fatalf("login after unlock = %v, want success", err)
+	}
+}
+
+func TestLockoutBacksOffExponentially(t *testing.T) {
+	useTestLockout(t, 2, 100, time.Minute, 5*time.Minute)
+	s := &server{loginAttempts: &memoryLoginAttemptStore{m: newMemoryDB()}}
+	ctx := contextFromPeer("198.51.100.9", "")
+	keys := []string{"user:alice"}
+
+	// the limit-th failure locks for base, every further one doubles it up to the max
+	for i, want := range []time.Duration{0, time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute} {
+		before := time.Now().UTC()
+		s.recordLoginFailure(ctx, keys)
+		lockedUntil, err := s.loginAttempts.LockedUntil(ctx, "user:alice")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want == 0 {
+			if !lockedUntil.IsZero() {
+				t.Fatalf("failure %d locked until %s, want no lockout", i+1, lockedUntil)
+			}
+			continue
+		}
+		if got := lockedUntil.Sub(before); got < want || got > want+time.Second {
+			t.Fatalf("failure %d locked for %s, want %s", i+1, got, want)
+		}
+	}
+}
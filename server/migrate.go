@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	database "gogrpc-rpc-boiler/server/db"
+)
+
+const migrateUsage = `usage: server migrate up [database...]
+       server migrate down <database>
+       server migrate status [database...]
+databases: author, category, user, book (STORE=postgres) or sqlite (STORE=sqlite), all of the store by default`
+
+// the migrate subcommand: applies, reverts (one at a time) or lists the schema migrations of the databases
+func runMigrate(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("%s", migrateUsage)
+	}
+	command, databases := args[0], args[1:]
+	if len(databases) == 0 {
+		switch storeBackend {
+		case StoreMemory:
+			return fmt.Errorf("STORE=memory has no database to migrate")
+		case StoreSQLite:
+			databases = []string{"sqlite"}
+		default:
+			databases = database.PostgresDatabases()
+		}
+	}
+
+	switch command {
+	case "up":
+		for _, name := range databases {
+			db, err := database.OpenForMigrations(name, true)
+			if err != nil {
+				return err
+			}
+			err = database.MigrateUp(db, name)
+			db.Close()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case "down":
+		// one database at a time, reverting a schema is never a default
+		if len(args) != 2 {
+			return fmt.Errorf("%s", migrateUsage)
+		}
+		db, err := database.OpenForMigrations(databases[0], false)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		reverted, err := database.MigrateDown(db, databases[0])
+		if err != nil {
+			return err
+		}
+		if !reverted {
+			fmt.Printf("%s has no applied migration\n", databases[0])
+		}
+		return nil
+	case "status":
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "DATABASE\tMIGRATION\tAPPLIED AT")
+		for _, name := range databases {
+			db, err := database.OpenForMigrations(name, false)
+			if err != nil {
+				return err
+			}
+			states, err := database.MigrationStatus(db, name)
+			db.Close()
+			if err != nil {
+				return err
+			}
+			applied := false
+			for _, state := range states {
+				applied = applied || state.Applied
+			}
+			if !applied {
+				fmt.Fprintf(w, "%s\t\tno migrations applied\n", name)
+			}
+			for _, state := range states {
+				appliedAt := "pending"
+				if state.Applied {
+					appliedAt = state.AppliedAt.Format("2006-01-02 15:04:05")
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\n", name, state.Name, appliedAt)
+			}
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown migrate command %s\n%s", command, migrateUsage)
+	}
+}
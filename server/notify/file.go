@@ -0,0 +1,38 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	logger "gogrpc-rpc-boiler/server/log"
+)
+
+// writes messages to a local file instead of sending them
+type FileNotifier struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+func (n *FileNotifier) Send(ctx context.Context, msg Message) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	file, err := os.OpenFile(n.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %v", n.Path, err)
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(file, "Date: %s\nTo: %s\nSubject: %s\n\n%s\n\n----\n",
+		time.Now().Format(time.RFC1123Z), msg.To, msg.Subject, msg.Body)
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %v", n.Path, err)
+	}
+
+	logger.LogThis(fmt.Sprintf("[INFO] message %q to %s written to %s", msg.Subject, msg.To, n.Path))
+	return nil
+}
@@ -0,0 +1,43 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// one outgoing message to a user
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// delivers messages to users, e.g. password reset links and due date reminders
+type Notifier interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// picks the notifier from NOTIFIER:
+// "file" (default) appends every message to NOTIFY_FILE, for local development,
+// "log" writes it to the server log,
+// "smtp" sends mail through SMTP_HOST:SMTP_PORT,
+// "webhook" posts it to NOTIFY_WEBHOOK_URL
+func FromEnv() (Notifier, error) {
+	switch os.Getenv("NOTIFIER") {
+	case "", "file":
+		path := os.Getenv("NOTIFY_FILE")
+		if path == "" {
+			path = "outbox.log"
+		}
+		return &FileNotifier{Path: path}, nil
+	case "log":
+		return LogNotifier{}, nil
+	case "smtp":
+		return SMTPFromEnv()
+	case "webhook":
+		return WebhookFromEnv()
+	default:
+		return nil, fmt.Errorf("unknown NOTIFIER %q, use file, log, smtp or webhook", os.Getenv("NOTIFIER"))
+	}
+}
@@ -0,0 +1,99 @@
+package notify
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"os"
+	"strings"
+	"time"
+)
+
+// sends mail through an SMTP relay, STARTTLS is used when the server offers it.
+// plain auth is only attempted when a username is set, so a local fake server
+// (e.g. MailHog on localhost:1025) works without credentials
+type SMTPNotifier struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+	Timeout  time.Duration
+}
+
+func SMTPFromEnv() (*SMTPNotifier, error) {
+	n := &SMTPNotifier{
+		Host:     os.Getenv("SMTP_HOST"),
+		Port:     os.Getenv("SMTP_PORT"),
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     os.Getenv("SMTP_FROM"),
+		Timeout:  10 * time.Second,
+	}
+	if n.Host == "" || n.From == "" {
+		return nil, fmt.Errorf("SMTP_HOST and SMTP_FROM are required for the smtp notifier")
+	}
+	if n.Port == "" {
+		n.Port = "25"
+	}
+	return n, nil
+}
+
+func (n *SMTPNotifier) Send(ctx context.Context, msg Message) error {
+	if strings.ContainsAny(msg.To, "\r\n") || strings.ContainsAny(msg.Subject, "\r\n") {
+		return fmt.Errorf("invalid header value")
+	}
+
+	dialer := net.Dialer{Timeout: n.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(n.Host, n.Port))
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server: %v", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	} else {
+		conn.SetDeadline(time.Now().Add(n.Timeout))
+	}
+
+	client, err := smtp.NewClient(conn, n.Host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start SMTP session: %v", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: n.Host}); err != nil {
+			return fmt.Errorf("failed to start TLS: %v", err)
+		}
+	}
+	if n.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", n.Username, n.Password, n.Host)); err != nil {
+			return fmt.Errorf("SMTP auth failed: %v", err)
+		}
+	}
+
+	if err := client.Mail(n.From); err != nil {
+		return fmt.Errorf("SMTP MAIL FROM failed: %v", err)
+	}
+	if err := client.Rcpt(msg.To); err != nil {
+		return fmt.Errorf("SMTP RCPT TO failed: %v", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("SMTP DATA failed: %v", err)
+	}
+	_, err = fmt.Fprintf(w, "From: %s\r\nTo: %s\r\nSubject: %s\r\nDate: %s\r\nMIME-Version: 1.0\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n%s\r\n",
+		n.From, msg.To, msg.Subject, time.Now().Format(time.RFC1123Z), strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	if err != nil {
+		w.Close()
+		return fmt.Errorf("failed to write message: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("SMTP server rejected message: %v", err)
+	}
+
+	return client.Quit()
+}
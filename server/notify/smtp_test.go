@@ -0,0 +1,151 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// a one connection SMTP server on localhost, returns its port and the DATA it received.
+// with cert set it offers STARTTLS and switches to TLS with that certificate
+func fakeSMTPServer(t *testing.T, cert *tls.Certificate) (string, <-chan string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	received := make(chan string, 1)
+	go func() {
+		defer close(received)
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+		r, w := bufio.NewReader(conn), bufio.NewWriter(conn)
+		reply := func(lines ...string) {
+			for _, line := range lines {
+				w.WriteString(line + "\r\n")
+			}
+			w.Flush()
+		}
+		reply("220 fake ESMTP")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			command := strings.ToUpper(strings.Fields(line + " x")[0])
+			switch command {
+			case "EHLO":
+				if cert != nil {
+					reply("250-fake", "250 STARTTLS")
+				} else {
+					reply("250 fake")
+				}
+			case "STARTTLS":
+				reply("220 ready to start TLS")
+				tlsConn := tls.Server(conn, &tls.Config{Certificates: []tls.Certificate{*cert}})
+				if err := tlsConn.Handshake(); err != nil {
+					return
+				}
+				r, w = bufio.NewReader(tlsConn), bufio.NewWriter(tlsConn)
+			case "MAIL", "RCPT":
+				reply("250 ok")
+			case "DATA":
+				reply("354 go ahead")
+				var data strings.Builder
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if line == ".\r\n" {
+						break
+					}
+					data.WriteString(line)
+				}
+				reply("250 queued")
+				received <- data.String()
+			case "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("502 not implemented")
+			}
+		}
+	}()
+
+	_, port, _ := net.SplitHostPort(ln.Addr().String())
+	return port, received
+}
+
+// a self-signed certificate no client trusts
+func selfSignedCert(t *testing.T) *tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func TestSMTPNotifierSendsMessage(t *testing.T) {
+	port, received := fakeSMTPServer(t, nil)
+	n := &SMTPNotifier{Host: "127.0.0.1", Port: port, From: "library@example.com", Timeout: 5 * time.Second}
+
+	err := n.Send(context.Background(), Message{To: "reader@example.com", Subject: "Due soon", Body: "line one\nline two"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := <-received
+	for _, want := range []string{"From: library@example.com\r\n", "To: reader@example.com\r\n", "Subject: Due soon\r\n", "\r\n\r\nline one\r\nline two\r\n"} {
+		if !strings.Contains(data, want) {
+			t.Fatalf("message %q does not contain %q", data, want)
+		}
+	}
+}
+
+func TestSMTPNotifierVerifiesSTARTTLSCertificate(t *testing.T) {
+	port, received := fakeSMTPServer(t, selfSignedCert(t))
+	n := &SMTPNotifier{Host: "127.0.0.1", Port: port, From: "library@example.com", Timeout: 5 * time.Second}
+
+	err := n.Send(context.Background(), Message{To: "reader@example.com", Subject: "Due soon", Body: "hello"})
+	if err == nil || !strings.Contains(err.Error(), "failed to start TLS") {
+		t.Fatalf("send to an untrusted server = %v, want a TLS error", err)
+	}
+	if data, ok := <-received; ok {
+		t.Fatalf("message was sent over an untrusted connection: %q", data)
+	}
+}
+
+func TestSMTPNotifierRejectsHeaderInjection(t *testing.T) {
+	n := &SMTPNotifier{Host: "127.0.0.1", Port: "1", From: "library@example.com", Timeout: time.Second}
+	err := n.Send(context.Background(), Message{To: "reader@example.com\r\nBcc: someone@example.com", Subject: "hi"})
+	if err == nil || !strings.Contains(err.Error(), "invalid header value") {
+		t.Fatalf("send with a CRLF in To = %v, want invalid header value", err)
+	}
+}
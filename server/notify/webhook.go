@@ -0,0 +1,62 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// posts every message as JSON {"to", "subject", "body"} to a URL.
+// with a secret the body is signed, X-Signature: sha256=<hex hmac of the body>
+type WebhookNotifier struct {
+	URL    string
+	Secret string
+	Client *http.Client
+}
+
+func WebhookFromEnv() (*WebhookNotifier, error) {
+	n := &WebhookNotifier{
+		URL:    os.Getenv("NOTIFY_WEBHOOK_URL"),
+		Secret: os.Getenv("NOTIFY_WEBHOOK_SECRET"),
+		Client: &http.Client{Timeout: 10 * time.Second},
+	}
+	if n.URL == "" {
+		return nil, fmt.Errorf("NOTIFY_WEBHOOK_URL is required for the webhook notifier")
+	}
+	return n, nil
+}
+
+func (n *WebhookNotifier) Send(ctx context.Context, msg Message) error {
+	body, err := json.Marshal(map[string]string{"to": msg.To, "subject": msg.Subject, "body": msg.Body})
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if n.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(n.Secret))
+		mac.Write(body)
+		req.Header.Set("X-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	res, err := n.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook: %v", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook answered %s", res.Status)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"gogrpc-rpc-boiler/server/env"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// hashes new passwords with the configured algorithm and parameters,
+// NeedsRehash reports stored hashes made with another algorithm or weaker parameters
+type Hasher interface {
+	Hash(password string) (string, error)
+	NeedsRehash(hash string) bool
+}
+
+type BcryptHasher struct {
+	Cost int
+}
+
+func (h BcryptHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.Cost)
+	return string(hash), err
+}
+
+func (h BcryptHasher) NeedsRehash(hash string) bool {
+	if !isBcrypt(hash) {
+		return true
+	}
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost < h.Cost
+}
+
+// argon2id, stored in the PHC string format: $argon2id$v=19$m=<KiB>,t=<iterations>,p=<threads>$<salt>$<key>
+type Argon2idHasher struct {
+	Time    uint32
+	Memory  uint32 // KiB
+	Threads uint8
+	KeyLen  uint32
+	SaltLen uint32
+}
+
+type argon2Params struct {
+	time, memory uint32
+	threads      uint8
+	salt, key    []byte
+}
+
+func (h Argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, h.Time, h.Memory, h.Threads, h.KeyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, h.Memory, h.Time, h.Threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+func (h Argon2idHasher) NeedsRehash(hash string) bool {
+	p, err := parseArgon2id(hash)
+	if err != nil {
+		return true
+	}
+	return p.time < h.Time || p.memory < h.Memory || p.threads < h.Threads || uint32(len(p.key)) < h.KeyLen
+}
+
+func parseArgon2id(hash string) (*argon2Params, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return nil, fmt.Errorf("not an argon2id hash")
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, fmt.Errorf("unsupported argon2 version")
+	}
+	p := &argon2Params{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.time, &p.threads); err != nil {
+		return nil, fmt.Errorf("malformed argon2id parameters")
+	}
+	var err error
+	if p.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, fmt.Errorf("malformed argon2id salt")
+	}
+	if p.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(p.key) == 0 {
+		return nil, fmt.Errorf("malformed argon2id key")
+	}
+	return p, nil
+}
+
+func isBcrypt(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+// checks a password against a stored hash of any supported algorithm
+func Verify(hash string, password string) bool {
+	if isBcrypt(hash) {
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+	}
+	p, err := parseArgon2id(hash)
+	if err != nil {
+		return false
+	}
+	key := argon2.IDKey([]byte(password), p.salt, p.time, p.memory, p.threads, uint32(len(p.key)))
+	return subtle.ConstantTimeCompare(key, p.key) == 1
+}
+
+// picks the hasher from PASSWORD_HASH: "bcrypt" (default, cost BCRYPT_COST)
+// or "argon2id" (ARGON2_TIME, ARGON2_MEMORY in KiB, ARGON2_THREADS)
+func HasherFromEnv() (Hasher, error) {
+	switch os.Getenv("PASSWORD_HASH") {
+	case "", "bcrypt":
+		cost := env.Int("BCRYPT_COST", bcrypt.DefaultCost)
+		if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+			return nil, fmt.Errorf("BCRYPT_COST must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+		}
+		return BcryptHasher{Cost: cost}, nil
+	case "argon2id":
+		threads := env.Int("ARGON2_THREADS", 2)
+		if threads > 255 {
+			return nil, fmt.Errorf("ARGON2_THREADS must be at most 255")
+		}
+		return Argon2idHasher{
+			Time:    uint32(env.Int("ARGON2_TIME", 3)),
+			Memory:  uint32(env.Int("ARGON2_MEMORY", 64*1024)),
+			Threads: uint8(threads),
+			KeyLen:  32,
+			SaltLen: 16,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown PASSWORD_HASH %q, use bcrypt or argon2id", os.Getenv("PASSWORD_HASH"))
+	}
+}
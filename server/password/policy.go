@@ -0,0 +1,85 @@
+package password
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"gogrpc-rpc-boiler/server/env"
+)
+
+// bcrypt only reads the first 72 bytes of a password and refuses longer ones,
+// keep this limit while bcrypt hashes can be made or verified
+const MaxLength = 72
+
+// rules a new password has to follow
+type Policy struct {
+	MinLength int
+	breached  map[string]struct{} // lowercased
+}
+
+// PASSWORD_MIN_LENGTH (default 8) and PASSWORD_BREACHED_LIST,
+// a local file with one known-breached password per line
+func PolicyFromEnv() (*Policy, error) {
+	policy := &Policy{MinLength: env.Int("PASSWORD_MIN_LENGTH", 8)}
+	if path := os.Getenv("PASSWORD_BREACHED_LIST"); path != "" {
+		if err := policy.LoadBreachedList(path); err != nil {
+			return nil, err
+		}
+	}
+	return policy, nil
+}
+
+func (p *Policy) LoadBreachedList(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open breached password list: %v", err)
+	}
+	defer file.Close()
+
+	breached := map[string]struct{}{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			breached[strings.ToLower(line)] = struct{}{}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read breached password list: %v", err)
+	}
+	p.breached = breached
+	return nil
+}
+
+// returns why the password is not acceptable, nil if it is
+func (p *Policy) Check(password string, username string, email string) error {
+	if len([]rune(password)) < p.MinLength {
+		return fmt.Errorf("password must be at least %d characters", p.MinLength)
+	}
+	if len(password) > MaxLength {
+		return fmt.Errorf("password must be at most %d bytes", MaxLength)
+	}
+
+	lower := strings.ToLower(password)
+	if containsIdentifier(lower, username) {
+		return fmt.Errorf("password must not contain the username")
+	}
+	if lower == strings.ToLower(email) || containsIdentifier(lower, strings.SplitN(email, "@", 2)[0]) {
+		return fmt.Errorf("password must not contain the email")
+	}
+
+	if _, ok := p.breached[lower]; ok {
+		return fmt.Errorf("password is too common, it appears in a list of breached passwords")
+	}
+	return nil
+}
+
+// very short identifiers only count when they are the whole password
+func containsIdentifier(lowerPassword string, identifier string) bool {
+	identifier = strings.ToLower(identifier)
+	if len(identifier) < 3 {
+		return identifier != "" && lowerPassword == identifier
+	}
+	return strings.Contains(lowerPassword, identifier)
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	"gogrpc-rpc-boiler/server/env"
+	logger "gogrpc-rpc-boiler/server/log"
+	"gogrpc-rpc-boiler/server/notify"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// delivers mail to users, set in main from NOTIFIER
+var notifier notify.Notifier
+
+// reset token lifetime, overridable with PASSWORD_RESET_TTL
+var passwordResetTTL = env.Duration("PASSWORD_RESET_TTL", 30*time.Minute)
+
+// same answer whether or not the email belongs to an account
+const passwordResetRequested = "If the email belongs to an account, a password reset token has been sent to it"
+
+var errInvalidResetToken = status.Error(codes.InvalidArgument, "invalid or expired reset token")
+
+// random opaque token (password resets, API keys), only its hash is stored
+func newOpaqueToken() (token string, hash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(buf)
+	return token, hashOpaqueToken(token), nil
+}
+
+func hashOpaqueToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func resetMessage(email string, username string, token string) notify.Message {
+	body := fmt.Sprintf("Hi %s,\n\nuse this token to reset your password, it expires in %s and can only be used once:\n\n%s\n", username, passwordResetTTL, token)
+	if url := os.Getenv("PASSWORD_RESET_URL"); url != "" {
+		body += fmt.Sprintf("\nor open %s?token=%s\n", url, token)
+	}
+	body += "\nIf you did not ask for a password reset, ignore this message.\n"
+	return notify.Message{To: email, Subject: "Password reset", Body: body}
+}
+
+// answers at once and the same whether or not the email belongs to an account,
+// the lookup, the token and the mail happen in the background
+func (s *server) RequestPasswordReset(ctx context.Context, req *proto.StringRequest) (*proto.StringResponse, error) {
+	if req.RequestStr == "" {
+		logger.LogThis("[ERROR] email is required [Insufficient Input]")
+		return nil, fmt.Errorf("email is required [Insufficient Input]")
+	}
+
+	s.resetMails.Add(1)
+	go func() {
+		defer s.resetMails.Done()
+		s.sendPasswordReset(context.WithoutCancel(ctx), req.RequestStr)
+	}()
+
+	return &proto.StringResponse{ResponseStr: passwordResetRequested}, nil
+}
+
+// stores a new reset token for the account of the email and mails it, failures are only logged
+func (s *server) sendPasswordReset(ctx context.Context, email string) {
+	user, err := s.users.GetCredentialsByEmail(ctx, email)
+	if err == errNotFound {
+		logger.LogThis(fmt.Sprintf("[INFO] password reset requested for unknown email %s", email))
+		return
+	} else if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to get user: %v", err))
+		return
+	}
+
+	token, hash, err := newOpaqueToken()
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to generate reset token: %v", err))
+		return
+	}
+
+	// only the newest token is valid
+	now := time.Now().UTC()
+	if err := s.resets.Create(ctx, user.UserID, hash, now.Add(passwordResetTTL), now); err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to store reset token: %v", err))
+		return
+	}
+
+	if err := notifier.Send(ctx, resetMessage(user.Email, user.Username, token)); err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to send password reset to user_id %d: %v", user.UserID, err))
+		return
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] password reset requested for user_id %d", user.UserID))
+}
+
+func (s *server) ConfirmPasswordReset(ctx context.Context, req *proto.PasswordReset) (*proto.StringResponse, error) {
+	if req.Token == "" || req.NewPassword == "" {
+		logger.LogThis("[ERROR] token and new password are required [Insufficient Input]")
+		return nil, fmt.Errorf("token and new password are required [Insufficient Input]")
+	}
+
+	// a rejected password does not use up the token, using it logs out every session
+	user, err := s.resets.Use(ctx, hashOpaqueToken(req.Token), time.Now().UTC(), func(user userCredentials) (string, error) {
+		return hashNewPassword(req.NewPassword, user.Username, user.Email)
+	})
+	if err == errNotFound {
+		logger.LogThis("[ERROR] password reset with unknown, used or expired token")
+		return nil, errInvalidResetToken
+	} else if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to reset password: %v", err))
+		return nil, fmt.Errorf("failed to reset password: %v", err)
+	}
+
+	// the owner proved access to the mailbox, lift any login lockout
+	s.clearLoginFailures(ctx, "user:"+user.Username)
+	logger.LogThis(fmt.Sprintf("[INFO] password reset for user_id %d", user.UserID))
+
+	return &proto.StringResponse{ResponseStr: "Password reset successfully, please log in"}, nil
+}
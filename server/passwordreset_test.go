@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	"gogrpc-rpc-boiler/server/notify"
+	"gogrpc-rpc-boiler/server/password"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// the token of the last reset message, on the line after "can only be used once:"
+func lastResetToken(t *testing.T, recorder *recordingNotifier) string {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	if len(recorder.sent) == 0 {
+		t.Fatal("no reset message was sent")
+	}
+	_, rest, ok := strings.Cut(recorder.sent[len(recorder.sent)-1].Body, "can only be used once:")
+	if !ok {
+		t.Fatal("reset message without a token")
+	}
+	return strings.Fields(rest)[0]
+}
+
+func TestPasswordResetTokenIsSingleUse(t *testing.T) {
+	s, _, userID := newTestServer(t)
+	recorder := useRecordingNotifier(t)
+	ctx := context.Background()
+
+	if _, err := s.RequestPasswordReset(ctx, &proto.StringRequest{RequestStr: "nobody@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	s.resetMails.Wait()
+	if sent := recorder.sentTo("nobody@example.com"); sent != 0 {
+		t.Fatalf("%d messages sent to an unknown email, want none", sent)
+	}
+
+	if _, err := s.RequestPasswordReset(ctx, &proto.StringRequest{RequestStr: "reader@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	s.resetMails.Wait()
+	first := lastResetToken(t, recorder)
+	if _, err := s.RequestPasswordReset(ctx, &proto.StringRequest{RequestStr: "reader@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	s.resetMails.Wait()
+	second := lastResetToken(t, recorder)
+	if _, err := s.ConfirmPasswordReset(ctx, &proto.PasswordReset{Token: first, NewPassword: "new password 1"}); err != errInvalidResetToken {
+		t.Fatalf("reset with a replaced token = %v, want %v", err, errInvalidResetToken)
+	}
+
+	_, refreshToken, err := s.createSession(ctx, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a rejected password leaves the token usable
+	if _, err := s.ConfirmPasswordReset(ctx, &proto.PasswordReset{Token: second, NewPassword: "short"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("reset with a short password = %v, want InvalidArgument", err)
+	}
+	if _, err := s.ConfirmPasswordReset(ctx, &proto.PasswordReset{Token: second, NewPassword: "new password 1"}); err != nil {
+		t.Fatalf("reset = %v", err)
+	}
+	if _, err := s.ConfirmPasswordReset(ctx, &proto.PasswordReset{Token: second, NewPassword: "new password 2"}); err != errInvalidResetToken {
+		t.Fatalf("second reset with the same token = %v, want %v", err, errInvalidResetToken)
+	}
+
+	user, err := s.users.GetCredentialsByID(ctx, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !password.Verify(user.PasswordHash, "new password 1") {
+		t.Fatal("password was not changed by the reset")
+	}
+	if _, err := s.RefreshToken(ctx, &proto.StringRequest{RequestStr: refreshToken}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("refresh of a session from before the reset = %v, want Unauthenticated", err)
+	}
+}
+
+type failingNotifier struct{}
+
+func (failingNotifier) Send(ctx context.Context, msg notify.Message) error {
+	return fmt.Errorf("relay refused %s", msg.To)
+}
+
+func TestPasswordResetAnswersTheSameForEveryEmail(t *testing.T) {
+	s, _, _ := newTestServer(t)
+	oldNotifier := notifier
+	notifier = failingNotifier{}
+	t.Cleanup(func() { notifier = oldNotifier })
+	ctx := context.Background()
+
+	// a known email whose mail cannot be sent answers like an unknown one
+	for _, email := range []string{"reader@example.com", "nobody@example.com"} {
+		resp, err := s.RequestPasswordReset(ctx, &proto.StringRequest{RequestStr: email})
+		if err != nil || resp.ResponseStr != passwordResetRequested {
+			t.Fatalf("reset for %s = %v, %v, want %q", email, resp, err, passwordResetRequested)
+		}
+	}
+	s.resetMails.Wait()
+}
+
+func TestExpiredPasswordResetTokenIsRejected(t *testing.T) {
+	s, _, userID := newTestServer(t)
+	ctx := context.Background()
+
+	token, hash, err := newOpaqueToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	issued := time.Now().UTC().Add(-passwordResetTTL - time.Minute)
+	if err := s.resets.Create(ctx, userID, hash, issued.Add(passwordResetTTL), issued); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.ConfirmPasswordReset(ctx, &proto.PasswordReset{Token: token, NewPassword: "new password 1"}); err != errInvalidResetToken {
+		t.Fatalf("reset with an expired token = %v, want %v", err, errInvalidResetToken)
+	}
+	user, err := s.users.GetCredentialsByID(ctx, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !password.Verify(user.PasswordHash, "old password 1") {
+		t.Fatal("password was changed by an expired token")
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	logger "gogrpc-rpc-boiler/server/log"
+	"gogrpc-rpc-boiler/server/password"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// set in main from PASSWORD_HASH / PASSWORD_* env vars
+var (
+	passwordHasher password.Hasher
+	passwordPolicy *password.Policy
+)
+
+// compared against when the user does not exist, so both paths cost one hash run
+var dummyPasswordHash string
+
+func initPasswords() error {
+	var err error
+	if passwordHasher, err = password.HasherFromEnv(); err != nil {
+		return err
+	}
+	if passwordPolicy, err = password.PolicyFromEnv(); err != nil {
+		return err
+	}
+	dummyPasswordHash, err = passwordHasher.Hash("dummy password")
+	return err
+}
+
+// checks a new password against the policy and hashes it
+func hashNewPassword(newPassword string, username string, email string) (string, error) {
+	if err := passwordPolicy.Check(newPassword, username, email); err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] password rejected for %s: %v", username, err))
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	hash, err := passwordHasher.Hash(newPassword)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to hash password: %v", err))
+		return "", fmt.Errorf("failed to hash password: %v", err)
+	}
+	return hash, nil
+}
+
+// upgrades a verified password whose stored hash uses an old algorithm or weaker parameters,
+// a failure is only logged, the login still succeeds. password_changed_at is left alone so
+// existing sessions stay valid
+func rehashIfNeeded(ctx context.Context, users UserStore, userID int, storedHash string, plain string) {
+	if !passwordHasher.NeedsRehash(storedHash) {
+		return
+	}
+	hash, err := passwordHasher.Hash(plain)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to rehash password of user_id %d: %v", userID, err))
+		return
+	}
+	// only replace the hash we verified against, a concurrent password change wins
+	if err := users.Rehash(ctx, userID, storedHash, hash); err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to store rehashed password of user_id %d: %v", userID, err))
+		return
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] rehashed password of user_id %d", userID))
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	"gogrpc-rpc-boiler/server/password"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLegacyBcryptHashIsUpgradedOnLogin(t *testing.T) {
+	useTestKeyring(t)
+	s, m, userID := newTestServer(t)
+	ctx := contextFromPeer("198.51.100.9", "")
+
+	// the member's hash was made with bcrypt, the server now hashes with argon2id
+	passwordHasher = password.Argon2idHasher{Time: 1, Memory: 64, Threads: 1, KeyLen: 32, SaltLen: 16}
+	m.mu.Lock()
+	legacyHash, changedAt := m.users[userID].Password, m.users[userID].PasswordChangedAt
+	m.mu.Unlock()
+
+	if _, err := s.LoginAuth(ctx, &proto.UserPassword{Username: "reader", Password: "wrong password"}); err == nil {
+		t.Fatal("login with a wrong password succeeded")
+	}
+	if user, _ := s.users.GetCredentialsByID(ctx, userID); user.PasswordHash != legacyHash {
+		t.Fatal("failed login rehashed the password")
+	}
+
+	pair, err := s.LoginAuth(ctx, &proto.UserPassword{Username: "reader", Password: "old password 1"})
+	if err != nil {
+		t.Fatalf("login with the legacy hash = %v", err)
+	}
+	user, err := s.users.GetCredentialsByID(ctx, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(user.PasswordHash, "$argon2id$") || !password.Verify(user.PasswordHash, "old password 1") {
+		t.Fatalf("hash after login %q, want an argon2id hash of the same password", user.PasswordHash)
+	}
+	// a rehash is not a password change, the session of this login stays valid
+	m.mu.Lock()
+	unchanged := m.users[userID].PasswordChangedAt.Equal(changedAt)
+	m.mu.Unlock()
+	if !unchanged {
+		t.Fatal("rehash moved password_changed_at")
+	}
+	if _, err := validateBearer(s, pair.AccessToken); err != nil {
+		t.Fatalf("access token after the rehash = %v", err)
+	}
+
+	upgraded := user.PasswordHash
+	if _, err := s.LoginAuth(ctx, &proto.UserPassword{Username: "reader", Password: "old password 1"}); err != nil {
+		t.Fatal(err)
+	}
+	if user, _ := s.users.GetCredentialsByID(ctx, userID); user.PasswordHash != upgraded {
+		t.Fatal("current hash was rehashed again")
+	}
+}
+
+func TestPasswordLongerThanBcryptReadsIsRejected(t *testing.T) {
+	s, _, userID := newTestServer(t)
+	ctx := context.Background()
+
+	token, hash, err := newOpaqueToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now().UTC()
+	if err := s.resets.Create(ctx, userID, hash, now.Add(passwordResetTTL), now); err != nil {
+		t.Fatal(err)
+	}
+
+	// bcrypt would only check the first 72 bytes, multibyte characters count in bytes
+	for _, newPassword := range []string{strings.Repeat("a", password.MaxLength) + "b", strings.Repeat("ü", password.MaxLength/2+1)} {
+		if _, err := s.ConfirmPasswordReset(ctx, &proto.PasswordReset{Token: token, NewPassword: newPassword}); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("reset to a %d byte password = %v, want InvalidArgument", len(newPassword), err)
+		}
+	}
+	if _, err := s.ConfirmPasswordReset(ctx, &proto.PasswordReset{Token: token, NewPassword: strings.Repeat("a", password.MaxLength)}); err != nil {
+		t.Fatalf("reset to a %d byte password = %v", password.MaxLength, err)
+	}
+}
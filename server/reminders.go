@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	"gogrpc-rpc-boiler/server/env"
+	logger "gogrpc-rpc-boiler/server/log"
+	"gogrpc-rpc-boiler/server/notify"
+)
+
+// notice kinds, each is sent once per borrow and due date, so a renewal re-arms both
+const (
+	NoticeDueSoon = "due_soon"
+	NoticeOverdue = "overdue"
+)
+
+// how often the borrowing table is scanned and how early a loan counts as due soon,
+// overridable with REMINDER_INTERVAL and REMINDER_DUE_SOON_DAYS. REMINDERS=off disables the scheduler
+var (
+	reminderInterval    = env.Duration("REMINDER_INTERVAL", 24*time.Hour)
+	reminderDueSoonDays = env.Int("REMINDER_DUE_SOON_DAYS", 3)
+)
+
+type dueNotice struct {
+	borrowingID int
+	userID      int
+	kind        string
+	title       string
+	returnDate  time.Time
+}
+
+// the scheduler has no caller token for the user service, it reads the address from the user store
+func borrowerEmail(users UserStore) func(ctx context.Context, userID int) (string, string, error) {
+	return func(ctx context.Context, userID int) (string, string, error) {
+		user, err := users.Get(ctx, userID)
+		if err == errNotFound {
+			return "", "", fmt.Errorf("user_id %d does not exist", userID)
+		} else if err != nil {
+			return "", "", fmt.Errorf("failed to get user: %v", err)
+		}
+		return user.Username, user.Email, nil
+	}
+}
+
+func noticeMessage(notice dueNotice, email string, username string, now time.Time) notify.Message {
+	due := notice.returnDate.Format("2006-01-02")
+	if notice.kind == NoticeOverdue {
+		body := fmt.Sprintf("Hi %s,\n\n%q (borrow %d) was due on %s.\nPlease bring it back, overdue loans are charged %d cents a day.\n",
+			username, notice.title, notice.borrowingID, due, fineDailyRate)
+		return notify.Message{To: email, Subject: fmt.Sprintf("Overdue: %s", notice.title), Body: body}
+	}
+
+	days := int(math.Ceil(notice.returnDate.Sub(now).Hours() / 24))
+	subject := fmt.Sprintf("Due in %d days: %s", days, notice.title)
+	if days <= 1 {
+		subject = fmt.Sprintf("Due within a day: %s", notice.title)
+	}
+	body := fmt.Sprintf("Hi %s,\n\n%q (borrow %d) is due on %s.\nRenew it or bring it back in time to avoid a fine.\n",
+		username, notice.title, notice.borrowingID, due)
+	return notify.Message{To: email, Subject: subject, Body: body}
+}
+
+// sends the due soon and overdue notices owed at now that were not sent yet, returns how many were sent.
+// a notice is claimed in the notification store before it goes out, so overlapping runs (several server
+// instances) never send it twice; a failed send releases the claim and the next run tries again
+func sendDueNotices(ctx context.Context, store NotificationStore, now time.Time, lookup func(ctx context.Context, userID int) (string, string, error), n notify.Notifier) (int, error) {
+	notices, err := store.Due(ctx, now, now.AddDate(0, 0, reminderDueSoonDays))
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, notice := range notices {
+		username, email, err := lookup(ctx, notice.userID)
+		if err != nil {
+			logger.LogThis(fmt.Sprintf("[ERROR] no %s notice for borrow %d: %v", notice.kind, notice.borrowingID, err))
+			continue
+		}
+		if email == "" {
+			logger.LogThis(fmt.Sprintf("[ERROR] no %s notice for borrow %d: user_id %d has no email", notice.kind, notice.borrowingID, notice.userID))
+			continue
+		}
+		msg := noticeMessage(notice, email, username, now)
+
+		notificationID, claimed, err := store.Claim(ctx, notice, email, msg.Subject, now)
+		if err != nil {
+			return sent, err
+		}
+		if !claimed {
+			// another run got there first
+			continue
+		}
+
+		if err := n.Send(ctx, msg); err != nil {
+			logger.LogThis(fmt.Sprintf("[ERROR] failed to send %s notice for borrow %d: %v", notice.kind, notice.borrowingID, err))
+			if err := store.Release(ctx, notificationID); err != nil {
+				logger.LogThis(fmt.Sprintf("[ERROR] failed to release notification %d: %v", notificationID, err))
+			}
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// scans for due notices at startup and then every reminderInterval until ctx is done
+func startReminderScheduler(ctx context.Context, notifications NotificationStore, users UserStore) {
+	if os.Getenv("REMINDERS") == "off" {
+		logger.LogThis("[INFO] reminder scheduler is off")
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(reminderInterval)
+		defer ticker.Stop()
+		for {
+			sent, err := sendDueNotices(ctx, notifications, time.Now().UTC(), borrowerEmail(users), notifier)
+			if err != nil {
+				logger.LogThis(fmt.Sprintf("[ERROR] reminder run failed after %d notices: %v", sent, err))
+			} else {
+				logger.LogThis(fmt.Sprintf("[INFO] reminder run sent %d notices, next in %s", sent, reminderInterval))
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *server) GetNotificationHistory(ctx context.Context, req *proto.IntRequest) (*proto.Notifications, error) {
+	caller := callerFromContext(ctx)
+
+	// members can only see their own notices
+	if err := requireSelfOrRole(caller, int(req.RequestInt), staffRoles); err != nil {
+		return nil, err
+	}
+
+	notifications, err := s.notifications.List(ctx, int(req.RequestInt))
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to get notifications: %v", err))
+		return nil, fmt.Errorf("failed to get notifications: %v", err)
+	}
+
+	return &proto.Notifications{Notifications: notifications}, nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	"gogrpc-rpc-boiler/server/env"
+	logger "gogrpc-rpc-boiler/server/log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// how late a loan can still be renewed, overridable with LOAN_RENEWAL_OVERDUE_GRACE.
+// the renewal period and limit come from the circulation policy the loan was made under
+var renewalOverdueGrace = env.Duration("LOAN_RENEWAL_OVERDUE_GRACE", 72*time.Hour)
+
+var (
+	errRenewalLimit   = status.Error(codes.FailedPrecondition, "renewal limit reached")
+	errRenewalOverdue = status.Error(codes.FailedPrecondition, "loan is too far overdue to renew")
+	errRenewalHolds   = status.Error(codes.FailedPrecondition, "book has pending holds")
+)
+
+// extends a borrow by one loan period, checkAccess sees the borrower before anything changes.
+// borrows made before circulation policies existed follow the default policy
+func renewBorrow(ctx context.Context, db *sql.DB, borrowingID int, checkAccess func(userID int) error) (*proto.RenewedBorrow, error) {
+	var renewed proto.RenewedBorrow
+	err := inTx(ctx, db, func(tx *sql.Tx) error {
+		var bookID, userID, branchID, renewalCount, loanDays, renewalLimit int
+		var returnDate time.Time
+		err := tx.QueryRowContext(ctx, "SELECT book_id, user_id, COALESCE(branch_id, 0), return_date, renewal_count, COALESCE(loan_days, $2), COALESCE(renewals_allowed, $3) FROM borrowing WHERE borrowing_id = $1 AND returned = FALSE FOR UPDATE",
+			borrowingID, defaultCirculationPolicy.LoanDays, defaultCirculationPolicy.Renewals).Scan(&bookID, &userID, &branchID, &returnDate, &renewalCount, &loanDays, &renewalLimit)
+		if err == sql.ErrNoRows {
+			return errBorrowNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to get borrow: %v", err)
+		}
+		if err := checkAccess(userID); err != nil {
+			return err
+		}
+
+		if renewalCount >= renewalLimit {
+			return errRenewalLimit
+		}
+		if time.Now().After(returnDate.Add(renewalOverdueGrace)) {
+			return errRenewalOverdue
+		}
+
+		// patrons in the queue get the copy back on time, also while a copy is travelling to their branch
+		var scan int
+		err = tx.QueryRowContext(ctx, "SELECT 1 FROM holds WHERE book_id = $1 AND status IN ($2, $3) LIMIT 1", bookID, HoldWaiting, HoldInTransit).Scan(&scan)
+		if err == nil {
+			return errRenewalHolds
+		} else if err != sql.ErrNoRows {
+			return fmt.Errorf("failed to check holds: %v", err)
+		}
+
+		// still due on a day the branch of the loan is open
+		calendar, err := loadCalendar(ctx, tx, branchID)
+		if err != nil {
+			return err
+		}
+		newReturnDate := calendar.nextOpenDay(returnDate.AddDate(0, 0, loanDays))
+		_, err = tx.ExecContext(ctx, "UPDATE borrowing SET return_date = $1, renewal_count = renewal_count + 1, last_renewed_at = $2 WHERE borrowing_id = $3",
+			newReturnDate, time.Now().UTC(), borrowingID)
+		if err != nil {
+			return fmt.Errorf("failed to renew borrow: %v", err)
+		}
+
+		renewed.BorrowingId = int32(borrowingID)
+		renewed.ReturnDate = newReturnDate.Format("2006-01-02 15:04:05")
+		renewed.RenewalCount = int32(renewalCount + 1)
+		renewed.RenewalsLeft = int32(renewalLimit - renewalCount - 1)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &renewed, nil
+}
+
+func (s *server) RenewBorrow(ctx context.Context, req *proto.IntRequest) (*proto.RenewedBorrow, error) {
+	caller := callerFromContext(ctx)
+
+	// members can only renew their own borrows
+	renewed, err := s.borrows.Renew(ctx, int(req.RequestInt), func(userID int) error {
+		return requireSelfOrRole(caller, userID, staffRoles)
+	})
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to renew borrow %d: %v", req.RequestInt, err))
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to renew borrow: %v", err)
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] %s renewed borrow %d until %s, %d renewals left", caller.Username, req.RequestInt, renewed.ReturnDate, renewed.RenewalsLeft))
+
+	return renewed, nil
+}
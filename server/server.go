@@ -5,26 +5,30 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 
 	proto "gogrpc-rpc-boiler/proto"
 	database "gogrpc-rpc-boiler/server/db"
 	jwtgenerator "gogrpc-rpc-boiler/server/jwt"
 	logger "gogrpc-rpc-boiler/server/log"
-	"gogrpc-api-boiler/server/models"
+	"gogrpc-rpc-boiler/server/models"
+	"gogrpc-rpc-boiler/server/notify"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/golang-jwt/jwt/v5"
 
 	// "github.com/joho/godotenv"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 
-	"database/sql"
 	"time"
 
-	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -34,6 +38,25 @@ type server struct {
     proto.UnimplementedAuthorServiceServer
     proto.UnimplementedCategoryServiceServer
     proto.UnimplementedBookAndBorrowServiceServer
+
+    // storage, see store.go
+    users         UserStore
+    authors       AuthorStore
+    categories    CategoryStore
+    books         BookStore
+    borrows       BorrowStore
+    holds         HoldStore
+    branches      BranchStore
+    calendars     CalendarStore
+    policies      PolicyStore
+    notifications NotificationStore
+    resets        PasswordResetStore
+    sessions      SessionStore
+    loginAttempts LoginAttemptStore
+    apiKeys       APIKeyStore
+
+    // password reset mails still being sent in the background
+    resetMails sync.WaitGroup
 }
 
 var interServiceConn *grpc.ClientConn
@@ -57,50 +80,107 @@ func CloseGRPCConnection() {
     logger.LogThis("[INFO] closed gRPC connection")
 }
 
+// authenticated caller, taken from the JWT claims
+type principal struct {
+    UserID    int
+    Username  string
+    Role      string
+    SessionID int
+
+    // set when the token was minted through AuthWithoutCredentials
+    ImpersonatorID int
+    Impersonator   string
+
+    // set when the caller authenticated with an API key instead of a session
+    APIKeyID int
+    Scopes   []string
+}
+
 // JWT protector
-func validateJWT(ctx context.Context) (string, error) {
+func (s *server) validateJWT(ctx context.Context) (*principal, error) {
     // extract metadata from context
     md, ok := metadata.FromIncomingContext(ctx)
     if !ok {
         logger.LogThis("[ERROR] missing metadata")
-        return "", fmt.Errorf("missing metadata")
+        return nil, fmt.Errorf("missing metadata")
     }
 
     // get token from "authorization" field in metadata
-    tokenString := md["authorization"]
-    if len(tokenString) == 0 {
+    authorization := md["authorization"]
+    if len(authorization) == 0 {
         logger.LogThis("[ERROR] authorization token missing")
-        return "", fmt.Errorf("authorization token missing")
+        return nil, fmt.Errorf("authorization token missing")
     }
 
-    // strip token from "Bearer"
-    if len(tokenString) > 0 {
-        parts := strings.Split(tokenString[0], " ")
-        if len(parts) != 2 || parts[0] != "Bearer" {
-            logger.LogThis("[ERROR] invalid token")
-            return "", fmt.Errorf("invalid token")
+    // strip token from "Bearer", without touching the incoming metadata (it is forwarded on inter-service calls)
+    parts := strings.Split(authorization[0], " ")
+    if len(parts) == 2 && parts[0] == "ApiKey" {
+        caller, err := s.validateAPIKey(ctx, parts[1])
+        if err != nil {
+            logger.LogThis(fmt.Sprintf("[ERROR] API key rejected: %v", err))
+            return nil, fmt.Errorf("invalid API key")
         }
-        tokenString[0] = parts[1]
+        return caller, nil
+    }
+    if len(parts) != 2 || parts[0] != "Bearer" {
+        logger.LogThis("[ERROR] invalid token")
+        return nil, fmt.Errorf("invalid token")
     }
+    tokenString := parts[1]
 
     // validate JWT
-    token, err := jwt.Parse(tokenString[0], func(token *jwt.Token) (interface{}, error) {
-        return []byte(os.Getenv("JWT_SECRET")), nil
-    })
+    token, err := jwtgenerator.ParseAccessToken(tokenString)
     if err != nil || !token.Valid {
-        logger.LogThis(fmt.Sprintf("[ERROR] invalid token, error: %v, received token: %s", err, tokenString[0]))
-        // return fmt.Errorf("invalid token, error: %v, received token: %s", err, tokenString[0])
+        logger.LogThis(fmt.Sprintf("[ERROR] invalid token, error: %v, received token: %s", err, tokenString))
+        // return fmt.Errorf("invalid token, error: %v, received token: %s", err, tokenString)
         logger.LogThis("[ERROR] invalid token")
-        return "", fmt.Errorf("invalid token")
+        return nil, fmt.Errorf("invalid token")
+    }
+
+    claims := token.Claims.(jwt.MapClaims)
+    username, _ := claims["username"].(string)
+    role, _ := claims["role"].(string)
+    userID, _ := claims["user_id"].(float64)
+    sessionID, _ := claims["sid"].(float64)
+    _, hasPurpose := claims["purpose"] // e.g. email verification tokens, never access tokens
+    issuedAt, err := claims.GetIssuedAt()
+    if username == "" || !isValidRole(role) || sessionID == 0 || hasPurpose || err != nil || issuedAt == nil {
+        logger.LogThis("[ERROR] invalid token claims")
+        return nil, fmt.Errorf("invalid token")
+    }
+
+    // impersonation tokens carry the admin in "act" and live on the admin's session
+    sessionUserID := int(userID)
+    var impersonatorID float64
+    var impersonator string
+    if act, ok := claims["act"].(map[string]interface{}); ok {
+        impersonatorID, _ = act["user_id"].(float64)
+        impersonator, _ = act["username"].(string)
+        if impersonator == "" || impersonatorID == 0 {
+            logger.LogThis("[ERROR] invalid impersonation claims")
+            return nil, fmt.Errorf("invalid token")
+        }
+        sessionUserID = int(impersonatorID)
     }
 
-    username := token.Claims.(jwt.MapClaims)["username"].(string)
+    // server-side revocation: logout, password change, deleted user
+    if err := s.checkSession(ctx, int(sessionID), sessionUserID, issuedAt.Time); err != nil {
+        logger.LogThis(fmt.Sprintf("[ERROR] token rejected, user: %s, error: %v", username, err))
+        return nil, fmt.Errorf("token has been revoked")
+    }
 
     // debug
     // logger.LogThis(fmt.Sprintf("[INFO] username: %s", username))
-    // logger.LogThis(fmt.Sprintf("[INFO] token: %s", tokenString[0]))
-
-    return username, nil
+    // logger.LogThis(fmt.Sprintf("[INFO] token: %s", tokenString))
+
+    return &principal{
+        UserID:         int(userID),
+        Username:       username,
+        Role:           role,
+        SessionID:      int(sessionID),
+        ImpersonatorID: int(impersonatorID),
+        Impersonator:   impersonator,
+    }, nil
 }
 
 // model struct validator
@@ -128,12 +208,7 @@ func (s *server) CreateUser(ctx context.Context, req *proto.UserSensitive) (*pro
 	}
 
     user.Username = req.Username
-    hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to hash password: %v", err))
-        return nil, fmt.Errorf("failed to hash password: %v", err)
-    }
-    user.Password = string(hashedPassword)
+    user.Password = req.Password
     user.FirstName = new(string)
     *user.FirstName = req.FirstName
     user.LastName = new(string)
@@ -149,45 +224,46 @@ func (s *server) CreateUser(ctx context.Context, req *proto.UserSensitive) (*pro
         return nil, fmt.Errorf("username, password, email, and role are required [Insufficient Input]")
     }
 
-    // check if user already exists [Duplicate Entry]
-    var exists int
-    err = database.UserDB.QueryRow("SELECT 1 AS exists FROM users WHERE username = $1", user.Username).Scan(&exists)
-    if err != sql.ErrNoRows && err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to check if user exists: %v", err))
-        return nil, fmt.Errorf("failed to check if user exists: %v", err)
+    // check role, only admins can create staff accounts
+    if !isValidRole(user.Role) {
+        logger.LogThis(fmt.Sprintf("[ERROR] invalid role: %s", user.Role))
+        return nil, fmt.Errorf("invalid role: %s", user.Role)
     }
-    if exists == 1 {
-        logger.LogThis("[ERROR] user already exists [Duplicate Entry]")
-        return nil, fmt.Errorf("user already exists [Duplicate Entry]")
+    if user.Role != RoleMember {
+        caller, err := s.validateJWT(ctx)
+        if err != nil || caller.Role != RoleAdmin {
+            logger.LogThis(fmt.Sprintf("[ERROR] only admins can create %s accounts", user.Role))
+            return nil, status.Errorf(codes.PermissionDenied, "only admins can create %s accounts", user.Role)
+        }
     }
 
-
-    // insert user into database
-    tx, err := database.UserDB.Begin()
+    // check password policy, then hash
+    hashedPassword, err := hashNewPassword(user.Password, user.Username, user.Email)
     if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to start transaction: %v [UserDB]", err))
-        return nil, fmt.Errorf("failed to start transaction: %v [UserDB]", err)
+        return nil, err
     }
-    
-    _, err = tx.Exec("INSERT INTO users (username, password_hash, first_name, last_name, email, role, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
-        user.Username, user.Password, user.FirstName, user.LastName, user.Email, user.Role, user.CreatedAt, user.UpdatedAt)
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to insert user: %v", err))
-        return nil, fmt.Errorf("failed to insert user: %v", err)
+    user.Password = hashedPassword
+
+    // insert user, usernames and emails are unique [Duplicate Entry]
+    userID, err := s.users.Create(ctx, user)
+    if err == errDuplicate {
+        logger.LogThis("[ERROR] user already exists [Duplicate Entry]")
+        return nil, fmt.Errorf("user already exists [Duplicate Entry]")
+    } else if err != nil {
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
-    
-    err = tx.Commit()
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to commit transaction: %v", err))
-        return nil, fmt.Errorf("failed to commit transaction: %v", err)
+
+    // the account exists either way, a failed mail can be retried with ResendVerification
+    if err := sendVerification(ctx, userID, user.Username, user.Email); err != nil {
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return &proto.StringResponse{ResponseStr: "User created successfully, but the verification email could not be sent"}, nil
     }
-    
-    return &proto.StringResponse{ResponseStr: "User created successfully"}, nil
+
+    return &proto.StringResponse{ResponseStr: "User created successfully, check your email to verify your address"}, nil
 }
 
-func (s *server) LoginAuth(ctx context.Context, req *proto.UserPassword) (*proto.StringResponse, error) {
+func (s *server) LoginAuth(ctx context.Context, req *proto.UserPassword) (*proto.TokenPair, error) {
 
 
     user := models.UserPassword{
@@ -198,37 +274,30 @@ func (s *server) LoginAuth(ctx context.Context, req *proto.UserPassword) (*proto
     user.Username = req.Username
     user.Password = req.Password
 
-    row := database.UserDB.QueryRow("SELECT password_hash FROM users WHERE username = $1", user.Username)
-    // check if user already exists [Duplicate Entry]
-    var storedPassword string
-    err := row.Scan(&storedPassword)
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] wrong username or password, error: %v", err))
-        return nil, fmt.Errorf("wrong username or password, error: %v", err)
-        // return logger.LogThis("[ERROR] wrong username or password")
-        // return nil, fmt.Errorf("wrong username or password")
+    stored, err := s.users.GetCredentials(ctx, user.Username)
+    if err != nil && err != errNotFound {
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
-    // compare password
-    err = bcrypt.CompareHashAndPassword([]byte(storedPassword), []byte(user.Password))
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] wrong username or password, error: %v", err))
-        return nil, fmt.Errorf("wrong username or password, error: %v", err)
-        // return logger.LogThis("[ERROR] wrong username or password")
-        // return nil, fmt.Errorf("wrong username or password")
+    // compare password, with lockout
+    if err := s.checkCredentials(ctx, user.Username, stored.PasswordHash, err == nil, user.Password); err != nil {
+        return nil, err
     }
 
-    token, err := jwtgenerator.GenerateJWT(user.Username)
-    if err != nil {
-        return nil, err
+    // upgrade hashes made with outdated parameters
+    rehashIfNeeded(ctx, s.users, stored.UserID, stored.PasswordHash, user.Password)
+
+    if !stored.EmailVerified && unverifiedEmailPolicy == UnverifiedBlockLogin {
+        logger.LogThis(fmt.Sprintf("[ERROR] login refused, email of %s is not verified", user.Username))
+        return nil, status.Error(codes.FailedPrecondition, "email address is not verified")
     }
-    return &proto.StringResponse{ResponseStr: "Bearer " + token}, nil
+
+    return s.issueTokenPair(ctx, stored.UserID, user.Username, stored.Role)
 }
 
 func (s *server) ChangePassword(ctx context.Context, req *proto.NewPassword) (*proto.StringResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
+    caller := callerFromContext(ctx)
 
     user := models.NewPassword{
         Username: "",
@@ -246,57 +315,40 @@ func (s *server) ChangePassword(ctx context.Context, req *proto.NewPassword) (*p
         return nil, fmt.Errorf("username, password, and new password are required [Insufficient Input]")
     }
 
-    row := database.UserDB.QueryRow("SELECT password_hash FROM users WHERE username = $1", user.Username)
-    // check if user already exists [Duplicate Entry]
-    var storedPassword string
-    err := row.Scan(&storedPassword)
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] wrong username or password, error: %v", err))
-        return nil, fmt.Errorf("wrong username or password, error: %v", err)
-        // return logger.LogThis("[ERROR] wrong username or password")
-        // return nil, fmt.Errorf("wrong username or password")
+    // only the account owner or an admin can change a password
+    if caller.Username != user.Username && caller.Role != RoleAdmin {
+        logger.LogThis(fmt.Sprintf("[ERROR] permission denied, user %s tried to change password of %s", caller.Username, user.Username))
+        return nil, status.Errorf(codes.PermissionDenied, "not allowed to change password of %s", user.Username)
     }
 
-    // compare password
-    err = bcrypt.CompareHashAndPassword([]byte(storedPassword), []byte(user.Password))
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] wrong username or password, error: %v", err))
-        return nil, fmt.Errorf("wrong username or password, error: %v", err)
-        // return logger.LogThis("[ERROR] wrong username or password")
-        // return nil, fmt.Errorf("wrong username or password")
+    stored, err := s.users.GetCredentials(ctx, user.Username)
+    if err != nil && err != errNotFound {
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
-    hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
-    if err != nil {
+    // compare password, with lockout
+    if err := s.checkCredentials(ctx, user.Username, stored.PasswordHash, err == nil, user.Password); err != nil {
         return nil, err
     }
-    tx, err := database.UserDB.Begin()
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to start transaction: %v [UserDB]", err))
-        return nil, fmt.Errorf("failed to start transaction: %v [UserDB]", err)
-    }
 
-    _, err = tx.Exec("UPDATE users SET password_hash = $1 WHERE username = $2", hashedPassword, user.Username)
+    hashedPassword, err := hashNewPassword(user.NewPassword, user.Username, stored.Email)
     if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to update password: %v", err))
-        return nil, fmt.Errorf("failed to update password: %v", err)
+        return nil, err
     }
-    
-    err = tx.Commit()
+
+    // logs out every session, tokens issued with the old password stop working
+    err = s.users.SetPassword(ctx, stored.UserID, hashedPassword, time.Now().UTC())
     if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to commit transaction: %v", err))
-        return nil, fmt.Errorf("failed to commit transaction: %v", err)
+        logger.LogThis(fmt.Sprintf("[ERROR] failed to change password: %v", err))
+        return nil, fmt.Errorf("failed to change password: %v", err)
     }
 
-    return &proto.StringResponse{ResponseStr: "Password changed successfully"}, nil
+    return &proto.StringResponse{ResponseStr: "Password changed successfully, please log in again"}, nil
 }
 
 func (s *server) DeleteUser(ctx context.Context, req *proto.UserIDPassword) (*proto.StringResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
+    caller := callerFromContext(ctx)
 
     user := models.UserIDPassword{
     	UserID:   -1,
@@ -312,24 +364,21 @@ func (s *server) DeleteUser(ctx context.Context, req *proto.UserIDPassword) (*pr
         return nil, fmt.Errorf("user id and password are required [Insufficient Input]")
     }
 
-    row := database.UserDB.QueryRow("SELECT password_hash FROM users WHERE user_id = $1", user.UserID)
+    // only the account owner or an admin can delete an account
+    if err := requireSelfOrRole(caller, user.UserID, adminRoles); err != nil {
+        return nil, err
+    }
+
     // check if user exists
-    var storedPassword string
-    err := row.Scan(&storedPassword)
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] wrong username or password, error: %v", err))
-        return nil, fmt.Errorf("wrong username or password, error: %v", err)
-        // return logger.LogThis("[ERROR] wrong username or password")
-        // return nil, fmt.Errorf("wrong username or password")
+    stored, err := s.users.GetCredentialsByID(ctx, user.UserID)
+    if err != nil && err != errNotFound {
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
-    // compare password
-    err = bcrypt.CompareHashAndPassword([]byte(storedPassword), []byte(user.Password))
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] wrong username or password, error: %v", err))
-        return nil, fmt.Errorf("wrong username or password, error: %v", err)
-        // return logger.LogThis("[ERROR] wrong username or password")
-        // return nil, fmt.Errorf("wrong username or password")
+    // compare password, with lockout
+    if err := s.checkCredentials(ctx, stored.Username, stored.PasswordHash, err == nil, user.Password); err != nil {
+        return nil, err
     }
 
     // check if user still borrows a book, inter-service call to bookservice
@@ -338,7 +387,7 @@ func (s *server) DeleteUser(ctx context.Context, req *proto.UserIDPassword) (*pr
         logger.LogThis("[ERROR] failed to get metadata")
         return nil, fmt.Errorf("failed to get metadata")
     }
-    outCtx := metadata.NewOutgoingContext(ctx, md)
+    outCtx := metadata.NewOutgoingContext(ctx, internalCallMetadata(md))
     bookServiceClient := proto.NewBookAndBorrowServiceClient(interServiceConn)
     doesStillBorrow, err := bookServiceClient.DoesUserStillBorrow(outCtx, &proto.IntRequest{RequestInt: int32(user.UserID)})
     if err != nil {
@@ -352,37 +401,21 @@ func (s *server) DeleteUser(ctx context.Context, req *proto.UserIDPassword) (*pr
     }
 
     // OK Deletes
-    tx, err := database.UserDB.Begin()
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to start transaction: %v [UserDB]", err))
-        return nil, fmt.Errorf("failed to start transaction: %v [UserDB]", err)
-    }
-
-    _, err = tx.Exec("DELETE FROM users WHERE user_id = $1", user.UserID)
-    if err != nil {
-        tx.Rollback()
+    if err := s.users.Delete(ctx, user.UserID); err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to delete user: %v", err))
         return nil, fmt.Errorf("failed to delete user: %v", err)
     }
 
-    err = tx.Commit()
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to commit transaction: %v", err))
-        return nil, fmt.Errorf("failed to commit transaction: %v", err)
-    }
-
     return &proto.StringResponse{ResponseStr: "User deleted successfully"}, nil
 }
 
 func (s *server) GetUser(ctx context.Context, req *proto.IntRequest) (*proto.User, error) {
-    if _, err := validateJWT(ctx); err != nil {
+    caller := callerFromContext(ctx)
+    if err := requireSelfOrRole(caller, int(req.RequestInt), staffRoles); err != nil {
         return nil, err
     }
 
-    var user models.User
-    row := database.UserDB.QueryRow("SELECT user_id, username, first_name, last_name, email, role FROM users WHERE user_id = $1", req.RequestInt,)
-    err := row.Scan(&user.UserID, &user.Username, &user.FirstName, &user.LastName, &user.Email, &user.Role)
+    user, err := s.users.Get(ctx, int(req.RequestInt))
     if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to get user: %v", err))
         return nil, fmt.Errorf("failed to get user: %v", err)
@@ -399,27 +432,16 @@ func (s *server) GetUser(ctx context.Context, req *proto.IntRequest) (*proto.Use
 }
 
 func (s *server) DoesUserExist(ctx context.Context, req *proto.IntRequest) (*proto.BoolResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var scan int
-    err := database.UserDB.QueryRow("SELECT 1 FROM users WHERE user_id = $1 LIMIT 1", req.RequestInt).Scan(&scan)
-    if err == sql.ErrNoRows {
-        return &proto.BoolResponse{ResponseBool: false}, nil
-    } else if err != nil {
+    exists, err := s.users.Exists(ctx, int(req.RequestInt))
+    if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to check user existence: %v", err))
         return nil, fmt.Errorf("failed to check user existence: %v", err)
     }
 
-    return &proto.BoolResponse{ResponseBool: true}, nil
+    return &proto.BoolResponse{ResponseBool: exists}, nil
 }
 
 func (s *server) CreateAuthor(ctx context.Context, req *proto.Author) (*proto.StringResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
     parsedBirthDate, err := time.Parse("2006-01-02", req.Birthdate)
     if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to parse birthdate: %v", err))
@@ -440,98 +462,37 @@ func (s *server) CreateAuthor(ctx context.Context, req *proto.Author) (*proto.St
         return nil, fmt.Errorf("failed to validate author: %v", err)
     }
 
-    // check if author is already exists [Duplicate Entry]
-    var scan int
-    err = database.AuthorDB.QueryRow("SELECT 1 AS exists FROM authors WHERE name = $1 LIMIT 1", author.Name).Scan(&scan)
-    if err != sql.ErrNoRows && err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] author already exists [Duplicate Entry], error: %v", err))
-        return nil, fmt.Errorf("author already exists [Duplicate Entry], error: %v", err)
-    }
-
-    tx, err := database.AuthorDB.Begin()
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to start transaction: %v [AuthorDB]", err))
-        return nil, fmt.Errorf("failed to start transaction: %v [AuthorDB]", err)
-    }
-
-    _, err = tx.Exec("INSERT INTO authors (name, birthdate, nationality, biography) VALUES ($1, $2, $3, $4)", author.Name, author.Birthdate, author.Nationality, author.Biography)
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to insert author: %v", err))
-        return nil, fmt.Errorf("failed to insert author: %v", err)
-    }
-
-    err = tx.Commit()
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to commit transaction: %v", err))
-        return nil, fmt.Errorf("failed to commit transaction: %v", err)
+    if err := s.authors.Create(ctx, author); err != nil {
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.StringResponse{ResponseStr: "author created successfully"}, nil
 }
 
 func (s *server) GetAuthors(ctx context.Context, req *proto.IDLimits) (*proto.AuthorMins, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var authorMins []*proto.AuthorMin
-
-    rows, err := database.AuthorDB.Query("SELECT author_id, name FROM authors WHERE author_id BETWEEN $1 AND $2", req.Min, req.Max)
+    authorMins, err := s.authors.List(ctx, int(req.Min), int(req.Max))
     if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to get authors: %v", err))
-        return nil, fmt.Errorf("failed to get authors: %v", err)
-    }
-
-    for rows.Next() {
-        var authorMin proto.AuthorMin
-        err := rows.Scan(&authorMin.AuthorId, &authorMin.Name)
-        if err != nil {
-            logger.LogThis(fmt.Sprintf("[ERROR] failed to scan author: %v", err))
-            return nil, fmt.Errorf("failed to scan author: %v", err)
-        }
-        authorMins = append(authorMins, &authorMin)
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.AuthorMins{Authors: authorMins}, nil
 }
 
 func (s *server) GetAuthorsByName(ctx context.Context, req *proto.StringRequest) (*proto.AuthorMins, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var authorMins []*proto.AuthorMin
-
     // ilike %str%
-    rows, err := database.AuthorDB.Query("SELECT author_id, name FROM authors WHERE name ILIKE $1", "%"+req.RequestStr+"%")
+    authorMins, err := s.authors.Search(ctx, req.RequestStr)
     if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to get authors: %v", err))
-        return nil, fmt.Errorf("failed to get authors: %v", err)
-    }
-
-    for rows.Next() {
-        var authorMin proto.AuthorMin
-        err := rows.Scan(&authorMin.AuthorId, &authorMin.Name)
-        if err != nil {
-            logger.LogThis(fmt.Sprintf("[ERROR] failed to scan author: %v", err))
-            return nil, fmt.Errorf("failed to scan author: %v", err)
-        }
-        authorMins = append(authorMins, &authorMin)
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.AuthorMins{Authors: authorMins}, nil
 }
 
 func (s *server) GetAuthorByID(ctx context.Context, req *proto.IntRequest) (*proto.Author, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var author models.Author
-    row := database.AuthorDB.QueryRow("SELECT name, birthdate, nationality, biography, created_at, updated_at FROM authors WHERE author_id = $1", req.RequestInt)
-    err := row.Scan(&author.Name, &author.Birthdate, &author.Nationality, &author.Biography, &author.CreatedAt, &author.UpdatedAt)
+    author, err := s.authors.Get(ctx, int(req.RequestInt))
     if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to get author: %v", err))
         return nil, fmt.Errorf("failed to get author: %v", err)
@@ -548,10 +509,6 @@ func (s *server) GetAuthorByID(ctx context.Context, req *proto.IntRequest) (*pro
 }
 
 func (s *server) EditAuthor(ctx context.Context, req *proto.UpdateAuthor) (*proto.StringResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
     _, err := time.Parse("2006-01-02", req.NewBirthdate)
     if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to parse birthdate: %v", err))
@@ -572,55 +529,30 @@ func (s *server) EditAuthor(ctx context.Context, req *proto.UpdateAuthor) (*prot
         return nil, fmt.Errorf("failed to validate author: %v", err)
     }
 
-    // check if id exists, and fetch created_at
-    row := database.AuthorDB.QueryRow("SELECT created_at FROM authors WHERE author_id = $1", author.AuthorID)
-    var storedCreatedAt string
-    err = row.Scan(&storedCreatedAt)
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] author id is invalid: %v", err))
-        return nil, fmt.Errorf("author id is invalid: %v", err)
-    }
-
-    // update author
-    tx, err := database.AuthorDB.Begin()
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to begin transaction: %v [AuthorDB]", err))
-        return nil, fmt.Errorf("failed to begin transaction: %v [AuthorDB]", err)
-    }
-
-    _, err = tx.Exec("UPDATE authors SET name = $1, birthdate = $2, nationality = $3, biography = $4, updated_at = $5 WHERE author_id = $6",
-        author.NewName, author.NewBirthdate, author.NewNationality, author.NewBiography, author.UpdatedAt, author.AuthorID)
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to update author: %v", err))
-        return nil, fmt.Errorf("failed to update author: %v", err)
-    }
-
-    err = tx.Commit()
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to commit transaction: %v", err))
-        return nil, fmt.Errorf("failed to commit transaction: %v", err)
+    // update author, the id has to exist
+    err = s.authors.Update(ctx, author)
+    if err == errNotFound {
+        logger.LogThis("[ERROR] author id is invalid")
+        return nil, fmt.Errorf("author id is invalid")
+    } else if err != nil {
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.StringResponse{ResponseStr: fmt.Sprintf("author %s updated at %s", author.NewName, author.UpdatedAt.Format("2006-01-02 15:04:05"))}, nil
 }
 
 func (s *server) DeleteAuthor(ctx context.Context, req *proto.IntRequest) (*proto.StringResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
     // check if id exists
-    var scan int
-    err := database.AuthorDB.QueryRow("SELECT 1 AS exists FROM authors WHERE author_id = $1 LIMIT 1", req.RequestInt).Scan(&scan)
-    if err == sql.ErrNoRows {
-        logger.LogThis("[ERROR] author id is invalid")
-        return nil, fmt.Errorf("author id is invalid")
-    } else if err != nil {
+    exists, err := s.authors.Exists(ctx, int(req.RequestInt))
+    if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to check author existence: %v", err))
         return nil, fmt.Errorf("failed to check author existence: %v", err)
     }
+    if !exists {
+        logger.LogThis("[ERROR] author id is invalid")
+        return nil, fmt.Errorf("author id is invalid")
+    }
 
     // check if author is in use by books, inter-service call to bookservice
     md, ok := metadata.FromIncomingContext(ctx)
@@ -628,7 +560,7 @@ func (s *server) DeleteAuthor(ctx context.Context, req *proto.IntRequest) (*prot
         logger.LogThis("[ERROR] failed to get metadata")
         return nil, fmt.Errorf("failed to get metadata")
     }
-    outCtx := metadata.NewOutgoingContext(ctx, md)
+    outCtx := metadata.NewOutgoingContext(ctx, internalCallMetadata(md))
     bookServiceClient := proto.NewBookAndBorrowServiceClient(interServiceConn)
     isAuthorInUse, err := bookServiceClient.IsAuthorInUseByBook(outCtx, &proto.IntRequest{RequestInt: req.RequestInt})
     if err != nil {
@@ -641,8 +573,7 @@ func (s *server) DeleteAuthor(ctx context.Context, req *proto.IntRequest) (*prot
     }
 
     // OK delete
-    _, err = database.AuthorDB.Exec("DELETE FROM authors WHERE author_id = $1", req.RequestInt)
-    if err != nil {
+    if err := s.authors.Delete(ctx, int(req.RequestInt)); err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to delete author: %v", err))
         return nil, fmt.Errorf("failed to delete author: %v", err)
     }
@@ -651,28 +582,16 @@ func (s *server) DeleteAuthor(ctx context.Context, req *proto.IntRequest) (*prot
 }
 
 func (s *server) DoesAuthorExist(ctx context.Context, req *proto.IntRequest) (*proto.BoolResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var scan int
-    err := database.AuthorDB.QueryRow("SELECT 1 AS exists FROM authors WHERE author_id = $1 LIMIT 1", req.RequestInt).Scan(&scan)
-    if err == sql.ErrNoRows {
-        // debug: check 'quick patch' on REST interface
-        return &proto.BoolResponse{ResponseBool: false}, nil
-    } else if err != nil {
+    exists, err := s.authors.Exists(ctx, int(req.RequestInt))
+    if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to check author existence: %v", err))
         return nil, fmt.Errorf("failed to check author existence: %v", err)
     }
 
-    return &proto.BoolResponse{ResponseBool: true}, nil
+    return &proto.BoolResponse{ResponseBool: exists}, nil
 }
 
 func (s *server) CreateCategory(ctx context.Context, req *proto.Category) (*proto.StringResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
     category := models.Category{
     	Name:        "",
     	Description: new(string),
@@ -692,78 +611,31 @@ func (s *server) CreateCategory(ctx context.Context, req *proto.Category) (*prot
         return nil, fmt.Errorf("name is required")
     }
 
-    // check if category already exists [Duplicate Entry]
-    row := database.CategoryDB.QueryRow("SELECT 1 AS exists FROM categories WHERE name = $1", category.Name)
-    var exists bool
-    err := row.Scan(&exists)
-    if err == nil && exists {
+    // category names are unique [Duplicate Entry]
+    err := s.categories.Create(ctx, category)
+    if err == errDuplicate {
         logger.LogThis("[ERROR] category already exists [Duplicate Entry]")
         return nil, fmt.Errorf("category already exists [Duplicate Entry]")
-    } else if err == nil && !exists {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to check if category already exists [Duplicate Entry]: %v", err))
-        return nil, fmt.Errorf("failed to check if category already exists [Duplicate Entry]: %v", err)
-    }
-
-    tx, err := database.CategoryDB.Begin()
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to start transaction: %v [CategoryDB]", err))
-        return nil, fmt.Errorf("failed to start transaction: %v [CategoryDB]", err)
-    }
-
-    _, err = tx.Exec("INSERT INTO categories (name, description, created_at, updated_at) VALUES ($1, $2, $3, $4)", category.Name, category.Description, category.CreatedAt, category.UpdatedAt)
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to create category: %v", err))
-        return nil, fmt.Errorf("failed to create category: %v", err)
-    }
-    
-    err = tx.Commit()
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to commit transaction: %v", err))
-        return nil, fmt.Errorf("failed to commit transaction: %v", err)
+    } else if err != nil {
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.StringResponse{ResponseStr: "Category created successfully"}, nil
 }
 
 func (s *server) GetCategories(ctx context.Context, req *proto.IDLimits) (*proto.CategoryMins, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var categorymins []*proto.CategoryMin
-    rows, err := database.CategoryDB.Query("SELECT category_id, name FROM categories WHERE category_id BETWEEN $1 AND $2", req.Min, req.Max)
+    categoryMins, err := s.categories.List(ctx, int(req.Min), int(req.Max))
     if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to get categories: %v", err))
-        return nil, fmt.Errorf("failed to get categories: %v", err)
-    }
-    defer rows.Close()
-
-    for rows.Next() {
-        var categoryID int32
-        var name string
-        if err := rows.Scan(&categoryID, &name); err != nil {
-            logger.LogThis(fmt.Sprintf("[ERROR] failed to scan category: %v", err))
-            return nil, fmt.Errorf("failed to scan category: %v", err)
-        }
-        categorymins = append(categorymins, &proto.CategoryMin{
-            CategoryId: categoryID,
-            Name:       name,
-        })
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
-    return &proto.CategoryMins{Categories: categorymins}, nil
+    return &proto.CategoryMins{Categories: categoryMins}, nil
 }
 
 func (s *server) GetCategoryByID(ctx context.Context, req *proto.IntRequest) (*proto.Category, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var category models.Category
-    row := database.CategoryDB.QueryRow("SELECT name, description, created_at, updated_at FROM categories WHERE category_id = $1", req.RequestInt)
-    err := row.Scan(&category.Name, &category.Description, &category.CreatedAt, &category.UpdatedAt)
+    category, err := s.categories.Get(ctx, int(req.RequestInt))
     if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to get category: %v", err))
         return nil, fmt.Errorf("failed to get category: %v", err)
@@ -778,37 +650,16 @@ func (s *server) GetCategoryByID(ctx context.Context, req *proto.IntRequest) (*p
 }
 
 func (s *server) GetCategoriesByName(ctx context.Context, req *proto.StringRequest) (*proto.CategoryMins, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var categoryMins []*proto.CategoryMin
-
-    rows, err := database.CategoryDB.Query("SELECT category_id, name FROM categories WHERE name ILIKE $1", "%"+req.RequestStr+"%")
+    categoryMins, err := s.categories.Search(ctx, req.RequestStr)
     if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to get categories: %v", err))
-        return nil, fmt.Errorf("failed to get categories: %v", err)
-    }
-    defer rows.Close()
-
-    for rows.Next() {
-        var categoryMin proto.CategoryMin
-        err := rows.Scan(&categoryMin.CategoryId, &categoryMin.Name)
-        if err != nil {
-            logger.LogThis(fmt.Sprintf("[ERROR] failed to scan category: %v", err))
-            return nil, fmt.Errorf("failed to scan category: %v", err)
-        }
-        categoryMins = append(categoryMins, &categoryMin)
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.CategoryMins{Categories: categoryMins}, nil
 }
 
 func (s *server) EditCategory(ctx context.Context, req *proto.UpdateCategory) (*proto.StringResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
     category := models.UpdateCategory{
     	CategoryID:     int(req.CategoryId),
     	NewName:        req.NewName,
@@ -822,33 +673,17 @@ func (s *server) EditCategory(ctx context.Context, req *proto.UpdateCategory) (*
         return nil, fmt.Errorf("failed to validate category: %v", err)
     }
 
-    // check if id exists, and fetch created_at
-    row := database.CategoryDB.QueryRow("SELECT created_at FROM categories WHERE category_id = $1", category.CategoryID)
-    var storedCreatedAt string
-    err := row.Scan(&storedCreatedAt)
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] category id is invalid: %v", err))
-        return nil, fmt.Errorf("category id is invalid: %v", err)
-    }
-
-    tx, err := database.CategoryDB.Begin()
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to start transaction: %v [CategoryDB]", err))
-        return nil, fmt.Errorf("failed to start transaction: %v [CategoryDB]", err)
-    }
-
-    _, err = tx.Exec("UPDATE categories SET name = $1, description = $2, updated_at = $3 WHERE category_id = $4", category.NewName, category.NewDescription, category.UpdatedAt, category.CategoryID)
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to update category: %v", err))
-        return nil, fmt.Errorf("failed to update category: %v", err)
-    }
-    
-    err = tx.Commit()
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to commit transaction: %v", err))
-        return nil, fmt.Errorf("failed to commit transaction: %v", err)
+    // update category, the id has to exist and the new name cannot be taken
+    err := s.categories.Update(ctx, category)
+    if err == errNotFound {
+        logger.LogThis("[ERROR] category id is invalid")
+        return nil, fmt.Errorf("category id is invalid")
+    } else if err == errDuplicate {
+        logger.LogThis("[ERROR] category already exists [Duplicate Entry]")
+        return nil, fmt.Errorf("category already exists [Duplicate Entry]")
+    } else if err != nil {
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.StringResponse{
@@ -857,21 +692,17 @@ func (s *server) EditCategory(ctx context.Context, req *proto.UpdateCategory) (*
 }
 
 func (s *server) DeleteCategory(ctx context.Context, req *proto.IntRequest) (*proto.StringResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
     
     // check if category id exists
-    var scan int
-    err := database.CategoryDB.QueryRow("SELECT 1 FROM categories WHERE category_id = $1 LIMIT 1", req.RequestInt).Scan(&scan)
-    if err == sql.ErrNoRows {
-        logger.LogThis("[ERROR] category id is unavailable")
-        return nil, fmt.Errorf("category id is unavailable")
-    } else if err != nil {
+    exists, err := s.categories.Exists(ctx, int(req.RequestInt))
+    if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to check category existence: %v", err))
         return nil, fmt.Errorf("failed to check category existence: %v", err)
     }
+    if !exists {
+        logger.LogThis("[ERROR] category id is unavailable")
+        return nil, fmt.Errorf("category id is unavailable")
+    }
 
     // check if category is in use, inter-service call to bookservice
     md, ok := metadata.FromIncomingContext(ctx)
@@ -879,7 +710,7 @@ func (s *server) DeleteCategory(ctx context.Context, req *proto.IntRequest) (*pr
         logger.LogThis("[ERROR] failed to get metadata")
         return nil, fmt.Errorf("failed to get metadata")
     }
-    outCtx := metadata.NewOutgoingContext(ctx, md)
+    outCtx := metadata.NewOutgoingContext(ctx, internalCallMetadata(md))
     var isCategoryInUse *proto.BoolResponse
     bookServiceClient := proto.NewBookAndBorrowServiceClient(interServiceConn)
     isCategoryInUse, err = bookServiceClient.IsCategoryInUseByBook(outCtx, &proto.IntRequest{RequestInt: req.RequestInt})
@@ -893,8 +724,7 @@ func (s *server) DeleteCategory(ctx context.Context, req *proto.IntRequest) (*pr
     }
 
     // OK delete
-    _, err = database.CategoryDB.Exec("DELETE FROM categories WHERE category_id = $1", req.RequestInt)
-    if err != nil {
+    if err := s.categories.Delete(ctx, int(req.RequestInt)); err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to delete category: %v", err))
         return nil, fmt.Errorf("failed to delete category: %v", err)
     }
@@ -903,61 +733,36 @@ func (s *server) DeleteCategory(ctx context.Context, req *proto.IntRequest) (*pr
 }
 
 func (s *server) DoesCategoryExist(ctx context.Context, req *proto.IntRequest) (*proto.BoolResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var exists bool
-    err := database.CategoryDB.QueryRow("SELECT 1 AS exists FROM categories WHERE category_id = $1 LIMIT 1", req.RequestInt).Scan(&exists)
-    if err == sql.ErrNoRows {
-        return &proto.BoolResponse{ResponseBool: false}, nil
-    } else if err != nil {
+    exists, err := s.categories.Exists(ctx, int(req.RequestInt))
+    if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to check category existence: %v", err))
         return nil, fmt.Errorf("failed to check category existence: %v", err)
     }
 
-    return &proto.BoolResponse{ResponseBool: true}, nil
+    return &proto.BoolResponse{ResponseBool: exists}, nil
 }
 
 func (s *server) IsAuthorInUseByBook(ctx context.Context, req *proto.IntRequest) (*proto.BoolResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var scan int
-    err := database.BookDB.QueryRow("SELECT 1 AS exists FROM books WHERE author_id = $1 LIMIT 1", req.RequestInt).Scan(&scan)
-    if err == sql.ErrNoRows {
-        return &proto.BoolResponse{ResponseBool: false}, nil
-    } else if err != nil {
+    inUse, err := s.books.IsAuthorInUse(ctx, int(req.RequestInt))
+    if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to check author in use: %v", err))
         return nil, fmt.Errorf("failed to check author in use: %v", err)
     }
 
-    return &proto.BoolResponse{ResponseBool: true}, nil
+    return &proto.BoolResponse{ResponseBool: inUse}, nil
 }
 
 func (s *server) IsCategoryInUseByBook(ctx context.Context, req *proto.IntRequest) (*proto.BoolResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var scan int
-    err := database.BookDB.QueryRow("SELECT 1 AS exists FROM books WHERE category_id = $1 LIMIT 1", req.RequestInt).Scan(&scan)
-    if err == sql.ErrNoRows {
-        return &proto.BoolResponse{ResponseBool: false}, nil
-    } else if err != nil {
+    inUse, err := s.books.IsCategoryInUse(ctx, int(req.RequestInt))
+    if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to check category in use: %v", err))
         return nil, fmt.Errorf("failed to check category in use: %v", err)
     }
 
-    return &proto.BoolResponse{ResponseBool: true}, nil
+    return &proto.BoolResponse{ResponseBool: inUse}, nil
 }
 
 func (s *server) CreateBook(ctx context.Context, req *proto.Book) (*proto.StringResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
     parsedPublishedDate, err := time.Parse("2006-01-02", req.PublishedDate)
     if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to parse published date: %v", err))
@@ -969,16 +774,14 @@ func (s *server) CreateBook(ctx context.Context, req *proto.Book) (*proto.String
     	AuthorID:       int(req.AuthorId),
     	PublishedDate:  &parsedPublishedDate,
     	ISBN:           &req.Isbn,
-    	TotalStock:     int(req.TotalStock),
-    	AvailableStock: int(req.AvailableStock),
     	CreatedAt:      time.Now(),
     	UpdatedAt:      time.Now(),
     }
 
     // validate
     if err := ModelValidator(book); err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] title, category_id, author_id, published_date, isbn, total_stock, available_stock are required [Insufficient Input]: %v", err))
-        return nil, fmt.Errorf("title, category_id, author_id, published_date, isbn, total_stock, available_stock are required [Insufficient Input]: %v", err)
+        logger.LogThis(fmt.Sprintf("[ERROR] title, category_id, author_id, published_date, isbn are required [Insufficient Input]: %v", err))
+        return nil, fmt.Errorf("title, category_id, author_id, published_date, isbn are required [Insufficient Input]: %v", err)
     }
 
     // check if category id exists, inter-service call to categoryservice
@@ -987,7 +790,7 @@ func (s *server) CreateBook(ctx context.Context, req *proto.Book) (*proto.String
         logger.LogThis("[ERROR] failed to get metadata")
         return nil, fmt.Errorf("failed to get metadata")
     }
-    outCtx := metadata.NewOutgoingContext(ctx, md)
+    outCtx := metadata.NewOutgoingContext(ctx, internalCallMetadata(md))
     categoryServiceClient := proto.NewCategoryServiceClient(interServiceConn)
     doesCategoryExist, err := categoryServiceClient.DoesCategoryExist(outCtx, &proto.IntRequest{RequestInt: int32(book.CategoryID)})
     if err != nil {
@@ -1013,63 +816,30 @@ func (s *server) CreateBook(ctx context.Context, req *proto.Book) (*proto.String
         return nil, fmt.Errorf("author id is unavailable")
     }
 
-    tx, err := database.BookDB.Begin()
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to start transaction: %v [BookDB]", err))
-        return nil, fmt.Errorf("failed to start transaction: %v [BookDB]", err)
-    }
-
-    _, err = tx.Exec("INSERT INTO books (title, category_id, author_id, published_date, isbn, total_stock, available_stock, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
-        book.Title, book.CategoryID, book.AuthorID, book.PublishedDate, book.ISBN, book.TotalStock, book.AvailableStock, book.CreatedAt, book.UpdatedAt)
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to insert book: %v", err))
-        return nil, fmt.Errorf("failed to insert book: %v", err)
-    }
-
-    err = tx.Commit()
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to commit transaction: %v", err))
-        return nil, fmt.Errorf("failed to commit transaction: %v", err)
+    // stock starts at zero and follows the items added with CreateItem, ISBNs are unique [Duplicate Entry]
+    err = s.books.Create(ctx, book)
+    if err == errDuplicate {
+        logger.LogThis("[ERROR] isbn already exists [Duplicate Entry]")
+        return nil, fmt.Errorf("isbn already exists [Duplicate Entry]")
+    } else if err != nil {
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.StringResponse{ResponseStr: fmt.Sprintf("Book %s created", book.Title)}, nil
 }
 
 func (s *server) GetBooks(ctx context.Context, req *proto.IDLimits) (*proto.BookMins, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var bookMins []*proto.BookMin
-
-    rows, err := database.BookDB.Query("SELECT book_id, title, category_id, author_id, published_date, available_stock FROM books WHERE book_id BETWEEN $1 AND $2", req.Min, req.Max)
+    bookMins, err := s.books.List(ctx, int(req.Min), int(req.Max))
     if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to get books: %v", err))
-        return nil, fmt.Errorf("failed to get books: %v", err)
-    }
-
-    for rows.Next() {
-        var bookMin proto.BookMin
-        err := rows.Scan(&bookMin.BookId, &bookMin.Title, &bookMin.CategoryId, &bookMin.AuthorId, &bookMin.PublishedDate, &bookMin.AvailableStock)
-        if err != nil {
-            logger.LogThis(fmt.Sprintf("[ERROR] failed to scan book: %v", err))
-            return nil, fmt.Errorf("failed to scan book: %v", err)
-        }
-        bookMins = append(bookMins, &bookMin)
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.BookMins{Books: bookMins}, nil
 }
 
 func (s *server) GetBooksByDate(ctx context.Context, req *proto.DateLimits) (*proto.BookMins, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var bookMins []*proto.BookMin
-
     // validate input date format
     _, err := time.Parse("2006-01-02", req.StartDate)
     if err != nil {
@@ -1082,61 +852,28 @@ func (s *server) GetBooksByDate(ctx context.Context, req *proto.DateLimits) (*pr
         return nil, fmt.Errorf("failed to parse max date: %v", err)
     }
 
-    rows, err := database.BookDB.Query("SELECT book_id, title, category_id, author_id, published_date, available_stock FROM books WHERE published_date BETWEEN $1 AND $2", req.StartDate, req.EndDate)
+    bookMins, err := s.books.ListByPublishedDate(ctx, req.StartDate, req.EndDate)
     if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to get books: %v", err))
-        return nil, fmt.Errorf("failed to get books: %v", err)
-    }
-
-    for rows.Next() {
-        var bookMin proto.BookMin
-        err := rows.Scan(&bookMin.BookId, &bookMin.Title, &bookMin.CategoryId, &bookMin.AuthorId, &bookMin.PublishedDate, &bookMin.AvailableStock)
-        if err != nil {
-            logger.LogThis(fmt.Sprintf("[ERROR] failed to scan book: %v", err))
-            return nil, fmt.Errorf("failed to scan book: %v", err)
-        }
-        bookMins = append(bookMins, &bookMin)
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.BookMins{Books: bookMins}, nil
 }
 
 func (s *server) GetBooksByName(ctx context.Context, req *proto.StringRequest) (*proto.BookMins, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var bookMins []*proto.BookMin
-
     // ilike %str%
-    rows, err := database.BookDB.Query("SELECT book_id, title, category_id, author_id, published_date, available_stock FROM books WHERE title ILIKE $1", "%"+req.RequestStr+"%")
+    bookMins, err := s.books.Search(ctx, req.RequestStr)
     if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to get books: %v", err))
-        return nil, fmt.Errorf("failed to get books: %v", err)
-    }
-
-    for rows.Next() {
-        var bookMin proto.BookMin
-        err := rows.Scan(&bookMin.BookId, &bookMin.Title, &bookMin.CategoryId, &bookMin.AuthorId, &bookMin.PublishedDate, &bookMin.AvailableStock)
-        if err != nil {
-            logger.LogThis(fmt.Sprintf("[ERROR] failed to scan book: %v", err))
-            return nil, fmt.Errorf("failed to scan book: %v", err)
-        }
-        bookMins = append(bookMins, &bookMin)
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.BookMins{Books: bookMins}, nil
 }
 
 func (s *server) GetBookByID(ctx context.Context, req *proto.IntRequest) (*proto.Book, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var book models.Book
-    row := database.BookDB.QueryRow("SELECT title, category_id, author_id, published_date, isbn, total_stock, available_stock, created_at, updated_at FROM books WHERE book_id = $1", req.RequestInt)
-
-    err := row.Scan(&book.Title, &book.CategoryID, &book.AuthorID, &book.PublishedDate, &book.ISBN, &book.TotalStock, &book.AvailableStock, &book.CreatedAt, &book.UpdatedAt)
+    book, err := s.books.Get(ctx, int(req.RequestInt))
     if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to get book: %v", err))
         return nil, fmt.Errorf("failed to get book: %v", err)
@@ -1156,10 +893,6 @@ func (s *server) GetBookByID(ctx context.Context, req *proto.IntRequest) (*proto
 }
 
 func (s *server) EditBook(ctx context.Context, req *proto.UpdateBook) (*proto.StringResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
     _, err := time.Parse("2006-01-02", req.NewPublishedDate)
     if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to parse published date: %v", err))
@@ -1173,15 +906,13 @@ func (s *server) EditBook(ctx context.Context, req *proto.UpdateBook) (*proto.St
     	NewAuthorID:       int(req.NewAuthorId),
     	NewPublishedDate:  req.NewPublishedDate,
     	NewISBN:           req.NewIsbn,
-    	NewTotalStock:     int(req.NewTotalStock),
-    	NewAvailableStock: int(req.NewAvailableStock),
     	UpdatedAt:         time.Now(),
     }
 
     // validate model
     if err := ModelValidator(book); err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] title, category_id, author_id, published_date, isbn, total_stock, available_stock are required [Insufficient Input]: %v", err))
-        return nil, fmt.Errorf("title, category_id, author_id, published_date, isbn, total_stock, available_stock are required [Insufficient Input]: %v", err)
+        logger.LogThis(fmt.Sprintf("[ERROR] title, category_id, author_id, published_date, isbn are required [Insufficient Input]: %v", err))
+        return nil, fmt.Errorf("title, category_id, author_id, published_date, isbn are required [Insufficient Input]: %v", err)
     }
 
     // check if category id exists, inter-service call to categoryservice
@@ -1190,7 +921,7 @@ func (s *server) EditBook(ctx context.Context, req *proto.UpdateBook) (*proto.St
         logger.LogThis("[ERROR] failed to get metadata")
         return nil, fmt.Errorf("failed to get metadata")
     }
-    outCtx := metadata.NewOutgoingContext(ctx, md)
+    outCtx := metadata.NewOutgoingContext(ctx, internalCallMetadata(md))
     categoryServiceClient := proto.NewCategoryServiceClient(interServiceConn)
     doesCategoryExist, err := categoryServiceClient.DoesCategoryExist(outCtx, &proto.IntRequest{RequestInt: int32(book.NewCategoryID)})
     if err != nil {
@@ -1214,286 +945,172 @@ func (s *server) EditBook(ctx context.Context, req *proto.UpdateBook) (*proto.St
         return nil, fmt.Errorf("author does not exist")
     }
 
-    // check if book id exists, inter-service call to bookservice
-    var scan int
-    err = database.BookDB.QueryRow("SELECT 1 FROM books WHERE book_id = $1", book.BookID).Scan(&scan)
-    if err != nil && err == sql.ErrNoRows {
-        logger.LogThis(fmt.Sprintf("[ERROR] book does not exist: %v", err))
-        return nil, fmt.Errorf("book does not exist: %v", err)
+    // update book, the id has to exist and the new ISBN cannot be taken
+    err = s.books.Update(ctx, book)
+    if err == errNotFound {
+        logger.LogThis("[ERROR] book does not exist")
+        return nil, fmt.Errorf("book does not exist")
+    } else if err == errDuplicate {
+        logger.LogThis("[ERROR] isbn already exists [Duplicate Entry]")
+        return nil, fmt.Errorf("isbn already exists [Duplicate Entry]")
     } else if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to check book: %v", err))
-        return nil, fmt.Errorf("failed to check book: %v", err)
-    }
-
-    // update book
-    tx, err := database.BookDB.Begin()
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to begin transaction: %v [BookDB]", err))
-        return nil, fmt.Errorf("failed to begin transaction: %v [BookDB]", err)
-    }
-
-    _, err = tx.Exec("UPDATE books SET title = $1, category_id = $2, author_id = $3, published_date = $4, isbn = $5, total_stock = $6, available_stock = $7, updated_at = $8 WHERE book_id = $9",
-        book.NewTitle, book.NewCategoryID, book.NewAuthorID, book.NewPublishedDate, book.NewISBN, book.NewTotalStock, book.NewAvailableStock, book.UpdatedAt, book.BookID)
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to update book: %v", err))
-        return nil, fmt.Errorf("failed to update book: %v", err)
-    }
-
-    err = tx.Commit()
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to commit transaction: %v", err))
-        return nil, fmt.Errorf("failed to commit transaction: %v", err)
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.StringResponse{ResponseStr: "successfully updated"}, nil
 }
 
 func (s *server) DeleteBook(ctx context.Context, req *proto.IntRequest) (*proto.StringResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    // check if book exists
-    var scan int
-    err := database.BookDB.QueryRow("SELECT 1 AS exists FROM books WHERE book_id = $1 LIMIT 1", req.RequestInt).Scan(&scan)
-    if err == sql.ErrNoRows {
+    // delete the book with its copies
+    err := s.books.Delete(ctx, int(req.RequestInt))
+    if err == errNotFound {
         logger.LogThis("[ERROR] book does not exist")
         return nil, fmt.Errorf("book does not exist")
     } else if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to check book existence: %v", err))
-        return nil, fmt.Errorf("failed to check book existence: %v", err)
-    }
-
-    // delete book
-    tx, err := database.BookDB.Begin()
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to begin transaction: %v [BookDB]", err))
-        return nil, fmt.Errorf("failed to begin transaction: %v [BookDB]", err)
-    }
-
-    _, err = tx.Exec("DELETE FROM books WHERE book_id = $1", req.RequestInt)
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to delete book: %v", err))
-        return nil, fmt.Errorf("failed to delete book: %v", err)
-    }
-    
-    err = tx.Commit()
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to commit transaction: %v", err))
-        return nil, fmt.Errorf("failed to commit transaction: %v", err)
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.StringResponse{ResponseStr: "successfully deleted"}, nil
 }
 
 func (s *server) DoesUserStillBorrow(ctx context.Context, req *proto.IntRequest) (*proto.BoolResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var scan int
-    err := database.BookDB.QueryRow("SELECT 1 AS exists FROM borrowing WHERE user_id = $1 AND returned = 'f' LIMIT 1", req.RequestInt).Scan(&scan)
-    if err == nil { // NGs
-        return &proto.BoolResponse{ResponseBool: true}, nil
-    } else if err != sql.ErrNoRows {
+    stillBorrows, err := s.borrows.StillBorrows(ctx, int(req.RequestInt))
+    if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to check borrow: %v", err))
         return nil, fmt.Errorf("failed to check borrow: %v", err)
     }
 
-    return &proto.BoolResponse{ResponseBool: false}, nil
+    return &proto.BoolResponse{ResponseBool: stillBorrows}, nil
 }
 
 func (s *server) CreateBorrow(ctx context.Context, req *proto.Borrow) (*proto.StringResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    parsedReturnDate, err := time.Parse("2006-01-02", req.ReturnDate)
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to parse return date: %v", err))
-        return nil, fmt.Errorf("failed to parse return date: %v", err)
-    }
+    caller := callerFromContext(ctx)
 
+    // return_date comes from the circulation policy and book_id from the item, the ones in the request are ignored
     borrow := models.Borrow{
-    	BookID:       int(req.BookId),
     	UserID:       int(req.UserId),
     	BorrowedDate: time.Now(),
-    	ReturnDate:   parsedReturnDate,
         ReturnedDate: nil,
     	Returned:     false,
     }
 
     // validate model
-    if err := ModelValidator(borrow); err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] book_id, user_id, borrowed_date, return_date are required [Insufficient Input]: %v", err))
-        return nil, fmt.Errorf("book_id, user_id, borrowed_date, return_date are required [Insufficient Input]: %v", err)
+    if err := ModelValidator(borrow); err != nil || req.Barcode == "" {
+        logger.LogThis(fmt.Sprintf("[ERROR] barcode, user_id are required [Insufficient Input]: %v", err))
+        return nil, fmt.Errorf("barcode, user_id are required [Insufficient Input]: %v", err)
     }
-    
-    // check if user exists, inter-service call to userservice
-    md, ok := metadata.FromIncomingContext(ctx)
-    if !ok {
-        logger.LogThis("[ERROR] failed to get metadata")
-        return nil, fmt.Errorf("failed to get metadata")
+
+    // members can only borrow for themselves
+    if err := requireSelfOrRole(caller, borrow.UserID, staffRoles); err != nil {
+        return nil, err
     }
-    outCtx := metadata.NewOutgoingContext(ctx, md)
-    userServiceClient := proto.NewUserServiceClient(interServiceConn)
-    doesUserExist, err := userServiceClient.DoesUserExist(outCtx, &proto.IntRequest{RequestInt: int32(borrow.UserID)})
+    
+    // check if user exists and get the role its circulation policy is keyed by
+    role, err := borrowerRole(ctx, borrow.UserID)
     if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to check user: %v", err))
-        return nil, fmt.Errorf("failed to check user: %v", err)
-    }
-    if !doesUserExist.ResponseBool {
-        logger.LogThis("[ERROR] user does not exist")
-        return nil, fmt.Errorf("user does not exist")
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
-    // check if book available
-    var scan int
-    err = database.BookDB.QueryRow("SELECT 1 AS exists FROM books WHERE book_id = $1 AND available_stock > 0 LIMIT 1", borrow.BookID).Scan(&scan)
-    if err == sql.ErrNoRows {
-        logger.LogThis("[ERROR] book is not available")
-        return nil, fmt.Errorf("book is not available")
-    } else if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to check book existence: %v", err))
-        return nil, fmt.Errorf("failed to check book existence: %v", err)
+    // check if the borrower verified their email, inter-service call to userservice
+    if unverifiedEmailPolicy != UnverifiedAllow {
+        md, ok := metadata.FromIncomingContext(ctx)
+        if !ok {
+            logger.LogThis("[ERROR] failed to get metadata")
+            return nil, fmt.Errorf("failed to get metadata")
+        }
+        outCtx := metadata.NewOutgoingContext(ctx, internalCallMetadata(md))
+        userServiceClient := proto.NewUserServiceClient(interServiceConn)
+        isEmailVerified, err := userServiceClient.IsEmailVerified(outCtx, &proto.IntRequest{RequestInt: int32(borrow.UserID)})
+        if err != nil {
+            logger.LogThis(fmt.Sprintf("[ERROR] failed to check email verification: %v", err))
+            return nil, fmt.Errorf("failed to check email verification: %v", err)
+        }
+        if !isEmailVerified.ResponseBool {
+            logger.LogThis(fmt.Sprintf("[ERROR] email of user_id %d is not verified", borrow.UserID))
+            return nil, status.Error(codes.FailedPrecondition, "email address is not verified")
+        }
     }
 
-    // OK create borrow
-    tx, err := database.BookDB.Begin()
+    // users with too many unpaid fines cannot borrow
+    balance, err := s.borrows.OutstandingFines(ctx, borrow.UserID)
     if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to begin transaction: %v [BookDB]", err))
-        return nil, fmt.Errorf("failed to begin transaction: %v [BookDB]", err)
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
+    }
+    if balance > fineBlockThreshold {
+        logger.LogThis(fmt.Sprintf("[ERROR] user_id %d owes %d cents in fines", borrow.UserID, balance))
+        return nil, status.Errorf(codes.FailedPrecondition, "outstanding fines of %d cents exceed the limit of %d cents", balance, fineBlockThreshold)
     }
 
-    _, err = tx.Exec("INSERT INTO borrowing (book_id, user_id, borrowed_date, return_date, returned_date, returned) VALUES ($1, $2, $3, $4, $5, $6)", borrow.BookID, borrow.UserID, borrow.BorrowedDate, borrow.ReturnDate, borrow.ReturnedDate, borrow.Returned)
-    if err != nil {
-        tx.Rollback()
+    // OK create borrow at the branch, takes the copy in the same transaction
+    _, borrow.ReturnDate, err = s.borrows.Create(ctx, req.Barcode, borrow.UserID, int(req.BranchId), role, borrow.BorrowedDate)
+    if err == errBranchNotFound || err == errItemNotFound || err == errItemUnavailable || err == errItemReserved || err == errItemElsewhere || err == errLoanLimit {
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
+    } else if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to create borrow: %v", err))
         return nil, fmt.Errorf("failed to create borrow: %v", err)
     }
-    
-    err = tx.Commit()
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to commit transaction: %v", err))
-        return nil, fmt.Errorf("failed to commit transaction: %v", err)
-    }
 
-    return &proto.StringResponse{ResponseStr: "successfully borrowed"}, nil
+    return &proto.StringResponse{ResponseStr: fmt.Sprintf("successfully borrowed, due %s", borrow.ReturnDate.Format("2006-01-02"))}, nil
 }
 
-func (s *server) CreateReturn(ctx context.Context, req *proto.IntRequest) (*proto.StringResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
+func (s *server) CreateReturn(ctx context.Context, req *proto.Return) (*proto.StringResponse, error) {
+    // mark returned at the branch, charge the fine and put the copy back in one transaction
+    fine, err := s.borrows.Return(ctx, int(req.BorrowingId), int(req.BranchId), time.Now())
+    if err == errBranchNotFound {
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
         return nil, err
-    }
-
-    // check if borrow exists
-    var scan int
-    err := database.BookDB.QueryRow("SELECT 1 AS exists FROM borrowing WHERE borrowing_id = $1 AND returned = 'f' LIMIT 1", req.RequestInt).Scan(&scan)
-    if err == sql.ErrNoRows {
+    } else if err == errBorrowNotFound {
         logger.LogThis("[ERROR] borrow does not exist")
-        return nil, fmt.Errorf("borrow does not exist")
+        return nil, err
     } else if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to check borrow existence: %v", err))
-        return nil, fmt.Errorf("failed to check borrow existence: %v", err)
-    }
-
-    // OK create return
-    tx, err := database.BookDB.Begin()
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to begin transaction: %v [BookDB]", err))
-        return nil, fmt.Errorf("failed to begin transaction: %v [BookDB]", err)
-    }
-
-    _, err = tx.Exec("UPDATE borrowing SET returned = 't', returned_date = $1 WHERE borrowing_id = $2 AND returned = 'f'", time.Now(), req.RequestInt)
-    if err != nil {
-        tx.Rollback()
         logger.LogThis(fmt.Sprintf("[ERROR] failed to create return: %v", err))
         return nil, fmt.Errorf("failed to create return: %v", err)
     }
-    
-    err = tx.Commit()
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to commit transaction: %v", err))
-        return nil, fmt.Errorf("failed to commit transaction: %v", err)
-    }
 
+    if fine > 0 {
+        return &proto.StringResponse{ResponseStr: fmt.Sprintf("successfully returned, overdue fine: %d cents", fine)}, nil
+    }
     return &proto.StringResponse{ResponseStr: "successfully returned"}, nil
 }
 
 func (s *server) GetBorrowings(ctx context.Context, req *proto.IDLimits) (*proto.BorrowOrReturnMins, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var borrowOrReturnMins []*proto.BorrowOrReturnMin
-
-    rows, err := database.BookDB.Query("SELECT borrowing_id, book_id, user_id, borrowed_date FROM borrowing WHERE returned = 'f' AND borrowing_id BETWEEN $1 AND $2", req.Min, req.Max)
+    borrowOrReturnMins, err := s.borrows.List(ctx, borrowFilter{Returned: false, MinID: int(req.Min), MaxID: int(req.Max)})
     if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to get borrowings: %v", err))
-        return nil, fmt.Errorf("failed to get borrowings: %v", err)
-    }
-
-    for rows.Next() {
-        var borrowOrReturnMin proto.BorrowOrReturnMin
-        err := rows.Scan(&borrowOrReturnMin.BorrowingId, &borrowOrReturnMin.BookId, &borrowOrReturnMin.UserId, &borrowOrReturnMin.BorrowedDate)
-        if err != nil {
-            logger.LogThis(fmt.Sprintf("[ERROR] failed to scan borrowings: %v", err))
-            return nil, fmt.Errorf("failed to scan borrowings: %v", err)
-        }
-
-        borrowOrReturnMins = append(borrowOrReturnMins, &borrowOrReturnMin)
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.BorrowOrReturnMins{Message: "success", Borrowings: borrowOrReturnMins}, nil
 }
 
 func (s *server) GetBorrowingsByDate(ctx context.Context, req *proto.DateLimits) (*proto.BorrowOrReturnMins, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var borrowOrReturnMins []*proto.BorrowOrReturnMin
-
-    rows, err := database.BookDB.Query("SELECT borrowing_id, book_id, user_id, borrowed_date FROM borrowing WHERE returned = 'f' AND borrowed_date BETWEEN $1 AND $2", req.StartDate, req.EndDate)
+    borrowOrReturnMins, err := s.borrows.List(ctx, borrowFilter{Returned: false, StartDate: req.StartDate, EndDate: req.EndDate})
     if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to get borrowings: %v", err))
-        return nil, fmt.Errorf("failed to get borrowings: %v", err)
-    }
-
-    for rows.Next() {
-        var borrowOrReturnMin proto.BorrowOrReturnMin
-        err := rows.Scan(&borrowOrReturnMin.BorrowingId, &borrowOrReturnMin.BookId, &borrowOrReturnMin.UserId, &borrowOrReturnMin.BorrowedDate)
-        if err != nil {
-            logger.LogThis(fmt.Sprintf("[ERROR] failed to scan borrowings: %v", err))
-            return nil, fmt.Errorf("failed to scan borrowings: %v", err)
-        }
-
-        borrowOrReturnMins = append(borrowOrReturnMins, &borrowOrReturnMin)
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.BorrowOrReturnMins{Message: "success", Borrowings: borrowOrReturnMins}, nil
 }
 
 func (s *server) GetBorrowingsByUserID(ctx context.Context, req *proto.IntRequest) (*proto.BorrowOrReturnMins, error) {
-    if _, err := validateJWT(ctx); err != nil {
+    caller := callerFromContext(ctx)
+    if err := requireSelfOrRole(caller, int(req.RequestInt), staffRoles); err != nil {
         return nil, err
     }
 
-    var borrowOrReturnMins []*proto.BorrowOrReturnMin
-
     // check if user exist, inter-service call to userservice
     md, ok := metadata.FromIncomingContext(ctx)
     if !ok {
         logger.LogThis("[ERROR] failed to get metadata")
         return nil, fmt.Errorf("failed to get metadata")
     }
-    outCtx := metadata.NewOutgoingContext(ctx, md)
+    outCtx := metadata.NewOutgoingContext(ctx, internalCallMetadata(md))
     userServiceClient := proto.NewUserServiceClient(interServiceConn)
     doesUserExist, err := userServiceClient.DoesUserExist(outCtx, &proto.IntRequest{RequestInt: req.RequestInt})
     if err != nil {
@@ -1505,94 +1122,48 @@ func (s *server) GetBorrowingsByUserID(ctx context.Context, req *proto.IntReques
         return nil, fmt.Errorf("user does not exist")
     }
 
-    rows, err := database.BookDB.Query("SELECT borrowing_id, book_id, user_id, borrowed_date FROM borrowing WHERE returned = 'f' AND user_id = $1", req.RequestInt)
+    borrowOrReturnMins, err := s.borrows.List(ctx, borrowFilter{Returned: false, UserID: int(req.RequestInt)})
     if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to get borrowings: %v", err))
-        return nil, fmt.Errorf("failed to get borrowings: %v", err)
-    }
-
-    for rows.Next() {
-        var borrowOrReturnMin proto.BorrowOrReturnMin
-        err := rows.Scan(&borrowOrReturnMin.BorrowingId, &borrowOrReturnMin.BookId, &borrowOrReturnMin.UserId, &borrowOrReturnMin.BorrowedDate)
-        if err != nil {
-            logger.LogThis(fmt.Sprintf("[ERROR] failed to scan borrowings: %v", err))
-            return nil, fmt.Errorf("failed to scan borrowings: %v", err)
-        }
-
-        borrowOrReturnMins = append(borrowOrReturnMins, &borrowOrReturnMin)
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.BorrowOrReturnMins{Message: "success", Borrowings: borrowOrReturnMins}, nil
 }
 
 func (s *server) GetReturns(ctx context.Context, req *proto.IDLimits) (*proto.BorrowOrReturnMins, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var borrowOrReturnMins []*proto.BorrowOrReturnMin
-
-    rows, err := database.BookDB.Query("SELECT borrowing_id, book_id, user_id, borrowed_date FROM borrowing WHERE returned = 't' AND borrowing_id BETWEEN $1 AND $2", req.Min, req.Max)
+    borrowOrReturnMins, err := s.borrows.List(ctx, borrowFilter{Returned: true, MinID: int(req.Min), MaxID: int(req.Max)})
     if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to get borrowings: %v", err))
-        return nil, fmt.Errorf("failed to get borrowings: %v", err)
-    }
-
-    for rows.Next() {
-        var borrowOrReturnMin proto.BorrowOrReturnMin
-        err := rows.Scan(&borrowOrReturnMin.BorrowingId, &borrowOrReturnMin.BookId, &borrowOrReturnMin.UserId, &borrowOrReturnMin.BorrowedDate)
-        if err != nil {
-            logger.LogThis(fmt.Sprintf("[ERROR] failed to scan borrowings: %v", err))
-            return nil, fmt.Errorf("failed to scan borrowings: %v", err)
-        }
-
-        borrowOrReturnMins = append(borrowOrReturnMins, &borrowOrReturnMin)
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.BorrowOrReturnMins{Message: "success", Borrowings: borrowOrReturnMins}, nil
 }
 
 func (s *server) GetReturnsByDate(ctx context.Context, req *proto.DateLimits) (*proto.BorrowOrReturnMins, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var borrowOrReturnMins []*proto.BorrowOrReturnMin
-
-    rows, err := database.BookDB.Query("SELECT borrowing_id, book_id, user_id, borrowed_date FROM borrowing WHERE returned = 't' AND borrowed_date BETWEEN $1 AND $2", req.StartDate, req.EndDate)
+    borrowOrReturnMins, err := s.borrows.List(ctx, borrowFilter{Returned: true, StartDate: req.StartDate, EndDate: req.EndDate})
     if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to get borrowings: %v", err))
-        return nil, fmt.Errorf("failed to get borrowings: %v", err)
-    }
-
-    for rows.Next() {
-        var borrowOrReturnMin proto.BorrowOrReturnMin
-        err := rows.Scan(&borrowOrReturnMin.BorrowingId, &borrowOrReturnMin.BookId, &borrowOrReturnMin.UserId, &borrowOrReturnMin.BorrowedDate)
-        if err != nil {
-            logger.LogThis(fmt.Sprintf("[ERROR] failed to scan borrowings: %v", err))
-            return nil, fmt.Errorf("failed to scan borrowings: %v", err)
-        }
-
-        borrowOrReturnMins = append(borrowOrReturnMins, &borrowOrReturnMin)
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.BorrowOrReturnMins{Message: "success", Borrowings: borrowOrReturnMins}, nil
 }
 
 func (s *server) GetReturnsByUserID(ctx context.Context, req *proto.IntRequest) (*proto.BorrowOrReturnMins, error) {
-    if _, err := validateJWT(ctx); err != nil {
+    caller := callerFromContext(ctx)
+    if err := requireSelfOrRole(caller, int(req.RequestInt), staffRoles); err != nil {
         return nil, err
     }
 
-    var borrowOrReturnMins []*proto.BorrowOrReturnMin
-
     // check if user exist, inter-service call to userservice
     md, ok := metadata.FromIncomingContext(ctx)
     if !ok {
         logger.LogThis("[ERROR] failed to get metadata")
         return nil, fmt.Errorf("failed to get metadata")
     }
-    outCtx := metadata.NewOutgoingContext(ctx, md)
+    outCtx := metadata.NewOutgoingContext(ctx, internalCallMetadata(md))
     userServiceClient := proto.NewUserServiceClient(interServiceConn)
     doesUserExist, err := userServiceClient.DoesUserExist(outCtx, &proto.IntRequest{RequestInt: req.RequestInt})
     if err != nil {
@@ -1604,58 +1175,71 @@ func (s *server) GetReturnsByUserID(ctx context.Context, req *proto.IntRequest)
         return nil, fmt.Errorf("user does not exist")
     }
 
-    rows, err := database.BookDB.Query("SELECT borrowing_id, book_id, user_id, borrowed_date FROM borrowing WHERE returned = 't' AND user_id = $1", req.RequestInt)
+    borrowOrReturnMins, err := s.borrows.List(ctx, borrowFilter{Returned: true, UserID: int(req.RequestInt)})
     if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to get borrowings: %v", err))
-        return nil, fmt.Errorf("failed to get borrowings: %v", err)
-    }
-
-    for rows.Next() {
-        var borrowOrReturnMin proto.BorrowOrReturnMin
-        err := rows.Scan(&borrowOrReturnMin.BorrowingId, &borrowOrReturnMin.BookId, &borrowOrReturnMin.UserId, &borrowOrReturnMin.BorrowedDate)
-        if err != nil {
-            logger.LogThis(fmt.Sprintf("[ERROR] failed to scan borrowings: %v", err))
-            return nil, fmt.Errorf("failed to scan borrowings: %v", err)
-        }
-
-        borrowOrReturnMins = append(borrowOrReturnMins, &borrowOrReturnMin)
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
     return &proto.BorrowOrReturnMins{Message: "success", Borrowings: borrowOrReturnMins}, nil
 }
 
-func (s *server) GetOverdues(ctx context.Context, req *proto.DateLimits) (*proto.BorrowOrReturnMins, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
+func (s *server) GetOverdues(ctx context.Context, req *proto.OverdueLimits) (*proto.BorrowOrReturnMins, error) {
     var borrowOrReturnMins []*proto.BorrowOrReturnMin
 
-    rows, err := database.BookDB.Query("SELECT borrowing_id, book_id, user_id, borrowed_date FROM borrowing WHERE returned = 'f' AND return_date < borrowed_date")
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to get borrowings: %v", err))
-        return nil, fmt.Errorf("failed to get borrowings: %v", err)
+    // overdue as of now, or as of the start of the requested day, in UTC like the parsed dates
+    asOf := time.Now().UTC()
+    if req.AsOf != "" {
+        parsedAsOf, err := time.Parse("2006-01-02", req.AsOf)
+        if err != nil {
+            logger.LogThis(fmt.Sprintf("[ERROR] failed to parse as_of: %v", err))
+            return nil, fmt.Errorf("failed to parse as_of: %v", err)
+        }
+        asOf = parsedAsOf
     }
 
-    for rows.Next() {
-        var borrowOrReturnMin proto.BorrowOrReturnMin
-        err := rows.Scan(&borrowOrReturnMin.BorrowingId, &borrowOrReturnMin.BookId, &borrowOrReturnMin.UserId, &borrowOrReturnMin.BorrowedDate)
+    // optional due date window, both ends inclusive
+    var start, end *time.Time
+    if req.StartDate != "" {
+        startDate, err := time.Parse("2006-01-02", req.StartDate)
         if err != nil {
-            logger.LogThis(fmt.Sprintf("[ERROR] failed to scan borrowings: %v", err))
-            return nil, fmt.Errorf("failed to scan borrowings: %v", err)
+            logger.LogThis(fmt.Sprintf("[ERROR] failed to parse start_date: %v", err))
+            return nil, fmt.Errorf("failed to parse start_date: %v", err)
         }
+        start = &startDate
+    }
+    if req.EndDate != "" {
+        endDate, err := time.Parse("2006-01-02", req.EndDate)
+        if err != nil {
+            logger.LogThis(fmt.Sprintf("[ERROR] failed to parse end_date: %v", err))
+            return nil, fmt.Errorf("failed to parse end_date: %v", err)
+        }
+        endDate = endDate.AddDate(0, 0, 1)
+        end = &endDate
+    }
 
-        borrowOrReturnMins = append(borrowOrReturnMins, &borrowOrReturnMin)
+    // most days overdue first, days late count the days the branch of the loan was open
+    overdues, err := s.borrows.Overdue(ctx, asOf, start, end)
+    if err != nil {
+        logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+        return nil, err
     }
 
-    return &proto.BorrowOrReturnMins{Message: "success", Borrowings: borrowOrReturnMins}, nil
+    for _, overdue := range overdues {
+        borrowOrReturnMins = append(borrowOrReturnMins, &proto.BorrowOrReturnMin{
+            BorrowingId:  int32(overdue.BorrowingID),
+            BookId:       int32(overdue.BookID),
+            UserId:       int32(overdue.UserID),
+            BorrowedDate: overdue.BorrowedDate.Format("2006-01-02 15:04:05"),
+            ReturnDate:   overdue.ReturnDate.Format("2006-01-02 15:04:05"),
+            DaysLate:     int32(overdue.DaysLate),
+        })
+    }
+
+    return &proto.BorrowOrReturnMins{Message: "overdue", Borrowings: borrowOrReturnMins}, nil
 }
 
 func (s *server) EditBorrow(ctx context.Context, req *proto.UpdateBorrow) (*proto.StringResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
 
     // validate dates
     _, err := time.Parse("2006-01-02", req.NewBorrowedDate)
@@ -1690,23 +1274,15 @@ func (s *server) EditBorrow(ctx context.Context, req *proto.UpdateBorrow) (*prot
         return nil, fmt.Errorf("borrowing_id, new_book_id, new_user_id, new_borrowed_date, new_return_date, new_returned_date, new_returned are required [Insufficient Input]: %v", err)
     }
 
-    // check if borrow_id exists
-    var scan int
-    err = database.BookDB.QueryRow("SELECT 1 FROM borrowing WHERE borrowing_id = $1 LIMIT 1", req.BorrowingId).Scan(&scan)
-    if err != nil && err == sql.ErrNoRows {
-        logger.LogThis(fmt.Sprintf("[ERROR] borrow_id does not exist: %v", err))
-        return nil, fmt.Errorf("failed to check if borrow_id exists: %v", err)
-    } else if err != sql.ErrNoRows {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to check if borrow_id exists: %v", err))
-    }
-
-    // check if book exists
-    err = database.BookDB.QueryRow("SELECT 1 FROM books WHERE book_id = $1 LIMIT 1", req.NewBookId).Scan(&scan)
-    if err != nil && err == sql.ErrNoRows {
-        logger.LogThis(fmt.Sprintf("[ERROR] book does not exist: %v", err))
-        return nil, fmt.Errorf("failed to check if book exists: %v", err)
-    } else if err != sql.ErrNoRows {
+    // check if book exists, the borrow is checked when it is updated
+    bookExists, err := s.books.Exists(ctx, updateBorrow.NewBookID)
+    if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to check if book exists: %v", err))
+        return nil, fmt.Errorf("failed to check if book exists: %v", err)
+    }
+    if !bookExists {
+        logger.LogThis("[ERROR] book does not exist")
+        return nil, errBookNotFound
     }
 
     // check if user exists, inter-service call to userservice
@@ -1715,7 +1291,7 @@ func (s *server) EditBorrow(ctx context.Context, req *proto.UpdateBorrow) (*prot
         logger.LogThis("[ERROR] failed to get metadata")
         return nil, fmt.Errorf("failed to get metadata")
     }
-    outCtx := metadata.NewOutgoingContext(ctx, md)
+    outCtx := metadata.NewOutgoingContext(ctx, internalCallMetadata(md))
     userServiceClient := proto.NewUserServiceClient(interServiceConn)
     doesUserExist, err := userServiceClient.DoesUserExist(outCtx, &proto.IntRequest{RequestInt: int32(updateBorrow.NewUserID)})
     if err != nil {
@@ -1727,92 +1303,47 @@ func (s *server) EditBorrow(ctx context.Context, req *proto.UpdateBorrow) (*prot
         return nil, fmt.Errorf("user does not exist")
     }
 
-    // update borrow
-    tx, err := database.BookDB.Begin()
+    newReturned, err := strconv.ParseBool(req.NewReturned)
     if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to begin transaction: %v [BookDB]", err))
-        return nil, fmt.Errorf("failed to begin transaction: %v [BookDB]", err)
+        logger.LogThis(fmt.Sprintf("[ERROR] failed to parse new_returned: %v", err))
+        return nil, fmt.Errorf("failed to parse new_returned: %v", err)
     }
 
-    _, err = tx.Exec("UPDATE borrowing SET book_id = $1, user_id = $2, borrowed_date = $3, return_date = $4, returned = $5 WHERE borrowing_id = $6", req.NewBookId, req.NewUserId, req.NewBorrowedDate, req.NewReturnDate, req.NewReturned, req.BorrowingId)
-    if err != nil {
-        tx.Rollback()
+    // update borrow, moving the copy between books when book_id or returned changes
+    err = s.borrows.Update(ctx, updateBorrow, newReturned)
+    if err == errBorrowNotFound || err == errBookUnavailable || err == errBookNotFound {
+        logger.LogThis(fmt.Sprintf("[ERROR] failed to update borrow: %v", err))
+        return nil, err
+    } else if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to update borrow: %v", err))
         return nil, fmt.Errorf("failed to update borrow: %v", err)
     }
 
-    err = tx.Commit()
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to commit transaction: %v", err))
-        return nil, fmt.Errorf("failed to commit transaction: %v", err)
-    }
-
     return &proto.StringResponse{ResponseStr: "successfully updated borrow"}, nil
 }
 
 func (s *server) DeleteBorrow(ctx context.Context, req *proto.IntRequest) (*proto.StringResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    // check if borrowing exists, and get book_id
-    var bookId int
-    err := database.BookDB.QueryRow("SELECT book_id FROM borrowing WHERE borrowing_id = $1 LIMIT 1", req.RequestInt).Scan(&bookId)
-    if err == sql.ErrNoRows {
+    // delete the borrowing, and return the book if it was still out
+    err := s.borrows.Delete(ctx, int(req.RequestInt))
+    if err == errBorrowNotFound {
         logger.LogThis("[ERROR] borrowing not found")
-        return nil, fmt.Errorf("borrowing not found")
-    }
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to fetch borrowing details: %v", err))
-        return nil, fmt.Errorf("failed to fetch borrowing details: %v", err)
-    }
-
-    // OK
-    tx, err := database.BookDB.Begin()
-    if err != nil {
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to begin transaction: %v [BookDB]", err))
-        return nil, fmt.Errorf("failed to begin transaction: %v [BookDB]", err)
-    }
-
-    _, err = tx.Exec("DELETE FROM borrowing WHERE borrowing_id = $1", req.RequestInt)
-    if err != nil {
-        tx.Rollback()
+        return nil, err
+    } else if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to delete borrow: %v", err))
         return nil, fmt.Errorf("failed to delete borrow: %v", err)
     }
 
-    // extra: return the book
-    _, err = tx.Exec("UPDATE books SET available_stock = available_stock + 1 WHERE book_id = $1", bookId)
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to return book: %v", err))
-        return nil, fmt.Errorf("failed to return book: %v", err)
-    }
-
-    err = tx.Commit()
-    if err != nil {
-        tx.Rollback()
-        logger.LogThis(fmt.Sprintf("[ERROR] failed to commit transaction: %v", err))
-        return nil, fmt.Errorf("failed to commit transaction: %v", err)
-    }
-
     return &proto.StringResponse{ResponseStr: "successfully deleted borrow"}, nil
 }
 
 func (s *server) GetBookRecommendations(ctx context.Context, req *proto.GetRecommendation) (*proto.BookMins, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    var bookMins []*proto.BookMin
-
     // check if category_id exists, inter-service call to categoryservice
     md, ok := metadata.FromIncomingContext(ctx)
     if !ok {
         logger.LogThis("[ERROR] failed to get metadata")
         return nil, fmt.Errorf("failed to get metadata")
     }
-    outCtx := metadata.NewOutgoingContext(ctx, md)
+    outCtx := metadata.NewOutgoingContext(ctx, internalCallMetadata(md))
     categoryServiceClient := proto.NewCategoryServiceClient(interServiceConn)
     doesCategoryExist, err := categoryServiceClient.DoesCategoryExist(outCtx, &proto.IntRequest{RequestInt: int32(req.CategoryId)})
     if err != nil {
@@ -1827,83 +1358,220 @@ func (s *server) GetBookRecommendations(ctx context.Context, req *proto.GetRecom
     // debug limit
     // fmt.Println(req.Limit)
 
-    rows, err := database.BookDB.Query("SELECT book_id, title FROM books WHERE category_id = $1 ORDER BY RANDOM() LIMIT $2", req.CategoryId, req.Limit)
+    bookMins, err := s.books.Recommend(ctx, int(req.CategoryId), int(req.Limit))
     if err != nil {
         logger.LogThis(fmt.Sprintf("[ERROR] failed to fetch books: %v", err))
         return nil, fmt.Errorf("failed to fetch books: %v", err)
     }
 
-    for rows.Next() {
-        var bookMin proto.BookMin
-        err := rows.Scan(&bookMin.BookId, &bookMin.Title)
-        if err != nil {
-            logger.LogThis(fmt.Sprintf("[ERROR] failed to scan book: %v", err))
-            return nil, fmt.Errorf("failed to scan book: %v", err)
-        }
-        bookMins = append(bookMins, &bookMin)
-    }
-
     return &proto.BookMins{Books: bookMins}, nil
 }
 
 func (s *server) HelloWorld(ctx context.Context, req *proto.StringRequest) (*proto.StringResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
-    username, err := validateJWT(ctx)
-    if err != nil {
-        return nil, err
-    }
+    caller := callerFromContext(ctx)
     
-    message := req.RequestStr + ", " + username
+    message := req.RequestStr + ", " + caller.Username
     return &proto.StringResponse{ResponseStr: message}, nil
 }
 
 func (s *server) Ping(ctx context.Context, req *emptypb.Empty) (*proto.StringResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
-    }
-
     message := "Pong"
     return &proto.StringResponse{ResponseStr: message}, nil
 }
 
+// admin-only impersonation, disabled unless IMPERSONATION_ENABLED=true
 func (s *server) AuthWithoutCredentials(ctx context.Context, req *proto.StringRequest) (*proto.StringResponse, error) {
-    if _, err := validateJWT(ctx); err != nil {
-        return nil, err
+    caller := callerFromContext(ctx)
+
+    if os.Getenv("IMPERSONATION_ENABLED") != "true" {
+        logger.LogThis(fmt.Sprintf("[ERROR] impersonation is disabled, requested by %s", caller.Username))
+        return nil, status.Error(codes.Unimplemented, "impersonation is disabled")
+    }
+
+    // no chained impersonation
+    if caller.Impersonator != "" {
+        logger.LogThis(fmt.Sprintf("[ERROR] %s tried to impersonate from an impersonation token", caller.Impersonator))
+        return nil, status.Error(codes.PermissionDenied, "cannot impersonate from an impersonation token")
     }
 
-    token, err := jwtgenerator.GenerateJWT(req.RequestStr)
+    // look up the target user, the minted token carries their id and role
+    target, err := s.users.GetCredentials(ctx, req.RequestStr)
     if err != nil {
-        return nil, err
+        logger.LogThis(fmt.Sprintf("[ERROR] failed to get user: %v", err))
+        return nil, fmt.Errorf("failed to get user: %v", err)
     }
+    if target.Role == RoleAdmin {
+        logger.LogThis(fmt.Sprintf("[ERROR] %s tried to impersonate admin %s", caller.Username, req.RequestStr))
+        return nil, status.Error(codes.PermissionDenied, "cannot impersonate an admin")
+    }
+
+    token, expiresAt, err := jwtgenerator.GenerateImpersonationJWT(target.UserID, req.RequestStr, target.Role, caller.UserID, caller.Username, caller.SessionID)
+    if err != nil {
+        logger.LogThis(fmt.Sprintf("[ERROR] failed to generate impersonation token: %v", err))
+        return nil, fmt.Errorf("failed to generate impersonation token: %v", err)
+    }
+
+    // audit, no token is handed out without a record
+    clientAddr := ""
+    if p, ok := peer.FromContext(ctx); ok {
+        clientAddr = p.Addr.String()
+    }
+    err = s.users.RecordImpersonation(ctx, impersonationRecord{
+        ImpersonatorID:       caller.UserID,
+        ImpersonatorUsername: caller.Username,
+        TargetUserID:         target.UserID,
+        TargetUsername:       req.RequestStr,
+        SessionID:            caller.SessionID,
+        ClientAddr:           clientAddr,
+        ExpiresAt:            expiresAt.UTC(),
+        CreatedAt:            time.Now().UTC(),
+    })
+    if err != nil {
+        logger.LogThis(fmt.Sprintf("[ERROR] failed to write impersonation audit record: %v", err))
+        return nil, fmt.Errorf("failed to write impersonation audit record: %v", err)
+    }
+
+    logger.LogThis(fmt.Sprintf("[INFO] %s is impersonating %s until %s", caller.Username, req.RequestStr, expiresAt.Format("2006-01-02 15:04:05")))
     return &proto.StringResponse{ResponseStr: token}, nil
 }
 
+func (s *server) GetJWKS(ctx context.Context, req *emptypb.Empty) (*proto.JWKS, error) {
+    var keys []*proto.JWK
+    for _, jwk := range jwtgenerator.PublicJWKS() {
+        keys = append(keys, &proto.JWK{
+            Kty: jwk.Kty,
+            Kid: jwk.Kid,
+            Alg: jwk.Alg,
+            Use: jwk.Use,
+            N:   jwk.N,
+            E:   jwk.E,
+            Crv: jwk.Crv,
+            X:   jwk.X,
+        })
+    }
+    return &proto.JWKS{Keys: keys}, nil
+}
+
 func main() {
     // err := godotenv.Load()
     // if err != nil {
     //     log.Fatal("Error loading .env file, trying to get from os")
     // }
 
-    database.ConnectBookDB()
-    database.ConnectAuthorDB()
-    database.ConnectUserDB()
-    database.ConnectCategoryDB()
+    // schema migrations, `server migrate up|down|status` runs them and exits
+    if len(os.Args) > 1 && os.Args[1] == "migrate" {
+        if err := runMigrate(os.Args[2:]); err != nil {
+            fmt.Fprintln(os.Stderr, err)
+            os.Exit(1)
+        }
+        return
+    }
+
+    // JWT signing keys
+    if err := jwtgenerator.InitKeyring(); err != nil {
+        logger.LogThis(fmt.Sprintf("[FATAL] failed to load JWT keyring: %v", err))
+        os.Exit(1)
+    }
+
+    // password hashing and policy
+    if err := initPasswords(); err != nil {
+        logger.LogThis(fmt.Sprintf("[FATAL] failed to set up password hashing: %v", err))
+        os.Exit(1)
+    }
+
+    // mail delivery (password resets, due date reminders)
+    var err error
+    notifier, err = notify.FromEnv()
+    if err != nil {
+        logger.LogThis(fmt.Sprintf("[FATAL] failed to set up notifier: %v", err))
+        os.Exit(1)
+    }
+
+    // storage, STORE=memory starts without any database and STORE=sqlite with a single file
+    var srv *server
+    switch storeBackend {
+    case StoreMemory:
+        logger.LogThis("[INFO] using the in-memory store, data is lost on exit")
+        m := newMemoryDB()
+        users := &memoryUserStore{m: m}
+        if err := seedMemoryAdmin(context.Background(), users); err != nil {
+            logger.LogThis(fmt.Sprintf("[FATAL] failed to create the admin: %v", err))
+            os.Exit(1)
+        }
+        srv = &server{
+            users:         users,
+            authors:       &memoryAuthorStore{m: m},
+            categories:    &memoryCategoryStore{m: m},
+            books:         &memoryBookStore{m: m},
+            borrows:       &memoryBorrowStore{m: m},
+            holds:         &memoryHoldStore{m: m},
+            branches:      &memoryBranchStore{m: m},
+            calendars:     &memoryCalendarStore{m: m},
+            policies:      &memoryPolicyStore{m: m},
+            notifications: &memoryNotificationStore{m: m},
+            resets:        &memoryPasswordResetStore{m: m},
+            sessions:      &memorySessionStore{m: m},
+            loginAttempts: &memoryLoginAttemptStore{m: m},
+            apiKeys:       &memoryAPIKeyStore{m: m},
+        }
+    case StoreSQLite:
+        database.ConnectSQLite()
+        users := &sqliteUserStore{db: database.SQLiteDB}
+        if err := seedAdmin(context.Background(), users, os.Getenv("SQLITE_ADMIN_PASSWORD")); err != nil {
+            logger.LogThis(fmt.Sprintf("[FATAL] failed to create the admin: %v", err))
+            os.Exit(1)
+        }
+        srv = &server{
+            users:         users,
+            authors:       &sqliteAuthorStore{db: database.SQLiteDB},
+            categories:    &sqliteCategoryStore{db: database.SQLiteDB},
+            books:         &sqliteBookStore{db: database.SQLiteDB},
+            borrows:       &sqliteBorrowStore{db: database.SQLiteDB},
+            sessions:      &sqliteSessionStore{db: database.SQLiteDB},
+            loginAttempts: &sqliteLoginAttemptStore{db: database.SQLiteDB},
+        }
+    default:
+        if database.MigrateOnStart {
+            database.CreatePostgresDatabases()
+        }
+        database.ConnectBookDB()
+        database.ConnectAuthorDB()
+        database.ConnectUserDB()
+        database.ConnectCategoryDB()
+        if database.MigrateOnStart {
+            database.MigratePostgres()
+        }
+
+        srv = &server{
+            users:         &pgUserStore{db: database.UserDB},
+            authors:       &pgAuthorStore{db: database.AuthorDB},
+            categories:    &pgCategoryStore{db: database.CategoryDB},
+            books:         &pgBookStore{db: database.BookDB},
+            borrows:       &pgBorrowStore{db: database.BookDB},
+            holds:         &pgHoldStore{db: database.BookDB},
+            branches:      &pgBranchStore{db: database.BookDB},
+            calendars:     &pgCalendarStore{db: database.BookDB},
+            policies:      &pgPolicyStore{db: database.BookDB},
+            notifications: &pgNotificationStore{db: database.BookDB},
+            resets:        &pgPasswordResetStore{db: database.UserDB},
+            sessions:      &pgSessionStore{db: database.UserDB},
+            loginAttempts: &pgLoginAttemptStore{db: database.UserDB},
+            apiKeys:       &pgAPIKeyStore{db: database.UserDB},
+        }
+    }
 
     // gRPC setup
     lis, err := net.Listen("tcp", "0.0.0.0:50051")
     if err != nil {
         logger.LogThis(fmt.Sprintf("[FATAL] failed to listen: %v", err))
     }
-    s := grpc.NewServer()
+    s := grpc.NewServer(grpc.UnaryInterceptor(srv.authInterceptor))
 
-    proto.RegisterUtilServiceServer(s, &server{})
-    proto.RegisterUserServiceServer(s, &server{})
-    proto.RegisterAuthorServiceServer(s, &server{})
-    proto.RegisterCategoryServiceServer(s, &server{})
-    proto.RegisterBookAndBorrowServiceServer(s, &server{})
+    proto.RegisterUtilServiceServer(s, srv)
+    proto.RegisterUserServiceServer(s, srv)
+    proto.RegisterAuthorServiceServer(s, srv)
+    proto.RegisterCategoryServiceServer(s, srv)
+    proto.RegisterBookAndBorrowServiceServer(s, srv)
 
     reflection.Register(s)
 
@@ -1919,6 +1587,11 @@ func main() {
     InitGRPCConnection()
     defer CloseGRPCConnection()
 
+    // due date reminders and overdue notices, on the stores that keep them
+    if srv.notifications != nil {
+        startReminderScheduler(context.Background(), srv.notifications, srv.users)
+    }
+
     // stopper
     select {}
 }
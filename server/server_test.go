@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	proto "gogrpc-rpc-boiler/proto"
+	"gogrpc-rpc-boiler/server/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestImpersonationIsGatedAndAudited(t *testing.T) {
+	useTestKeyring(t)
+	s, m, memberID := newTestServer(t)
+	ctx := context.Background()
+
+	var adminID int
+	for _, username := range []string{"boss", "root"} {
+		userID, err := s.users.Create(ctx, models.UserSensitive{Username: username, Email: username + "@example.com", Role: RoleAdmin})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if adminID == 0 {
+			adminID = userID
+		}
+	}
+	pair, err := s.issueTokenPair(ctx, adminID, "boss", RoleAdmin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	admin, err := validateBearer(s, pair.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	adminCtx := peer.NewContext(context.WithValue(ctx, principalKey{}, admin), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("198.51.100.7"), Port: 40000}})
+
+	t.Setenv("IMPERSONATION_ENABLED", "")
+	if _, err := s.AuthWithoutCredentials(adminCtx, &proto.StringRequest{RequestStr: "reader"}); status.Code(err) != codes.Unimplemented {
+		t.Fatalf("impersonation while disabled = %v, want Unimplemented", err)
+	}
+
+	t.Setenv("IMPERSONATION_ENABLED", "true")
+	if _, err := s.AuthWithoutCredentials(adminCtx, &proto.StringRequest{RequestStr: "root"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("impersonation of an admin = %v, want PermissionDenied", err)
+	}
+	minted, err := s.AuthWithoutCredentials(adminCtx, &proto.StringRequest{RequestStr: "reader"})
+	if err != nil {
+		t.Fatalf("impersonation = %v", err)
+	}
+	impersonated, err := validateBearer(s, minted.ResponseStr)
+	if err != nil {
+		t.Fatalf("impersonation token = %v", err)
+	}
+	if impersonated.UserID != memberID || impersonated.Role != RoleMember || impersonated.Impersonator != "boss" || impersonated.SessionID != admin.SessionID {
+		t.Fatalf("impersonation token for %+v, want reader acted by boss on the admin's session", impersonated)
+	}
+
+	// an impersonation token never mints another one, whatever role it carries
+	chained := &principal{UserID: adminID, Username: "boss", Role: RoleAdmin, SessionID: admin.SessionID, ImpersonatorID: adminID, Impersonator: "boss"}
+	if _, err := s.AuthWithoutCredentials(context.WithValue(ctx, principalKey{}, chained), &proto.StringRequest{RequestStr: "reader"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("chained impersonation = %v, want PermissionDenied", err)
+	}
+
+	m.mu.Lock()
+	audit := append([]impersonationRecord(nil), m.impersonations...)
+	m.mu.Unlock()
+	if len(audit) != 1 {
+		t.Fatalf("%d audit records, want one for the token handed out", len(audit))
+	}
+	record := audit[0]
+	if record.ImpersonatorID != adminID || record.TargetUserID != memberID || record.SessionID != admin.SessionID || record.ClientAddr != "198.51.100.7:40000" || record.ExpiresAt.IsZero() {
+		t.Fatalf("audit record %+v, want boss impersonating reader from 198.51.100.7:40000", record)
+	}
+
+	// logging the admin out ends the impersonation
+	if _, err := s.Logout(adminCtx, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := validateBearer(s, minted.ResponseStr); err == nil {
+		t.Fatal("impersonation token outlived the admin's session")
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	jwtgenerator "gogrpc-rpc-boiler/server/jwt"
+	logger "gogrpc-rpc-boiler/server/log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// creates a session for the user, returns its id and the refresh token for it
+func (s *server) createSession(ctx context.Context, userID int) (int, string, error) {
+	secret, hash, err := jwtgenerator.NewRefreshSecret()
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to generate refresh token: %v", err)
+	}
+
+	now := time.Now().UTC()
+	sessionID, err := s.sessions.Create(ctx, userID, hash, now.Add(jwtgenerator.RefreshTokenTTL), now)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return sessionID, jwtgenerator.FormatRefreshToken(sessionID, secret), nil
+}
+
+// starts a new session and issues its first access/refresh token pair
+func (s *server) issueTokenPair(ctx context.Context, userID int, username string, role string) (*proto.TokenPair, error) {
+	sessionID, refreshToken, err := s.createSession(ctx, userID)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+		return nil, err
+	}
+
+	accessToken, err := jwtgenerator.GenerateJWT(userID, username, role, sessionID)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to generate access token: %v", err))
+		return nil, fmt.Errorf("failed to generate access token: %v", err)
+	}
+
+	return &proto.TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(jwtgenerator.AccessTokenTTL.Seconds()),
+	}, nil
+}
+
+// checks that the session behind an access token is still live,
+// and that the user's password was not changed after the token was issued
+func (s *server) checkSession(ctx context.Context, sessionID int, userID int, issuedAt time.Time) error {
+	session, err := s.sessions.Get(ctx, sessionID)
+	if err == errNotFound || (err == nil && session.UserID != userID) {
+		return fmt.Errorf("session not found")
+	} else if err != nil {
+		return fmt.Errorf("failed to check session: %v", err)
+	}
+
+	if session.Revoked {
+		return fmt.Errorf("session has been revoked")
+	}
+	if time.Now().UTC().After(session.ExpiresAt) {
+		return fmt.Errorf("session has expired")
+	}
+	// iat has second precision
+	if session.PasswordChangedAt.Truncate(time.Second).After(issuedAt) {
+		return fmt.Errorf("password changed after token was issued")
+	}
+
+	return nil
+}
+
+// revokes every live session of a user, run inside the caller's transaction
+func revokeUserSessions(ctx context.Context, tx *sql.Tx, userID int) error {
+	_, err := tx.ExecContext(ctx, "UPDATE sessions SET revoked_at = $1 WHERE user_id = $2 AND revoked_at IS NULL", time.Now().UTC(), userID)
+	return err
+}
+
+func (s *server) RefreshToken(ctx context.Context, req *proto.StringRequest) (*proto.TokenPair, error) {
+	sessionID, secret, err := jwtgenerator.ParseRefreshToken(req.RequestStr)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] invalid refresh token: %v", err))
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+
+	session, err := s.sessions.Get(ctx, sessionID)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] refresh token session not found: %v", err))
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+
+	now := time.Now().UTC()
+	if session.Revoked || now.After(session.ExpiresAt) {
+		logger.LogThis(fmt.Sprintf("[ERROR] refresh token used on revoked or expired session %d", sessionID))
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+
+	// rotate, only while the session still has the presented token, so concurrent refreshes
+	// of the same token cannot both rotate it
+	newSecret, newHash, err := jwtgenerator.NewRefreshSecret()
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to generate refresh token: %v", err))
+		return nil, fmt.Errorf("failed to generate refresh token: %v", err)
+	}
+	hash := jwtgenerator.HashRefreshSecret(secret)
+	err = errNotFound
+	if hash == session.RefreshTokenHash {
+		err = s.sessions.Rotate(ctx, sessionID, hash, newHash, now)
+	}
+
+	// an old (already rotated) refresh token was replayed, kill the whole session
+	if err == errNotFound {
+		if err := s.sessions.Revoke(ctx, sessionID, now); err != nil {
+			logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+			return nil, err
+		}
+		logger.LogThis(fmt.Sprintf("[ERROR] refresh token reuse detected, session %d revoked", sessionID))
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	} else if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+		return nil, err
+	}
+
+	accessToken, err := jwtgenerator.GenerateJWT(session.UserID, session.Username, session.Role, sessionID)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to generate access token: %v", err))
+		return nil, fmt.Errorf("failed to generate access token: %v", err)
+	}
+
+	return &proto.TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: jwtgenerator.FormatRefreshToken(sessionID, newSecret),
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(jwtgenerator.AccessTokenTTL.Seconds()),
+	}, nil
+}
+
+func (s *server) Logout(ctx context.Context, req *emptypb.Empty) (*proto.StringResponse, error) {
+	caller := callerFromContext(ctx)
+
+	if err := s.sessions.Revoke(ctx, caller.SessionID, time.Now().UTC()); err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+		return nil, err
+	}
+
+	return &proto.StringResponse{ResponseStr: "Logged out successfully"}, nil
+}
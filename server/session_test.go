@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRefreshRotatesAndReplayRevokesSession(t *testing.T) {
+	useTestKeyring(t)
+	s, _, userID := newTestServer(t)
+	ctx := context.Background()
+
+	first, err := s.issueTokenPair(ctx, userID, "reader", RoleMember)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := s.RefreshToken(ctx, &proto.StringRequest{RequestStr: first.RefreshToken})
+	if err != nil {
+		t.Fatalf("refresh = %v", err)
+	}
+	if second.RefreshToken == first.RefreshToken {
+		t.Fatal("refresh token was not rotated")
+	}
+	if _, err := validateBearer(s, second.AccessToken); err != nil {
+		t.Fatalf("refreshed access token = %v", err)
+	}
+
+	// the rotated token is replayed, e.g. by whoever stole it: the whole session ends
+	if _, err := s.RefreshToken(ctx, &proto.StringRequest{RequestStr: first.RefreshToken}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("replay of a rotated refresh token = %v, want Unauthenticated", err)
+	}
+	if _, err := s.RefreshToken(ctx, &proto.StringRequest{RequestStr: second.RefreshToken}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("refresh after the replay = %v, want Unauthenticated", err)
+	}
+	if _, err := validateBearer(s, second.AccessToken); err == nil {
+		t.Fatal("access token of the revoked session was accepted")
+	}
+}
+
+func TestTokensIssuedBeforePasswordChangeAreRejected(t *testing.T) {
+	useTestKeyring(t)
+	s, m, userID := newTestServer(t)
+	ctx := context.Background()
+
+	pair, err := s.issueTokenPair(ctx, userID, "reader", RoleMember)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caller, err := validateBearer(s, pair.AccessToken)
+	if err != nil {
+		t.Fatalf("access token = %v", err)
+	}
+
+	changedAt := time.Now().UTC().Add(time.Second)
+	m.mu.Lock()
+	m.users[userID].PasswordChangedAt = changedAt
+	m.mu.Unlock()
+
+	if _, err := validateBearer(s, pair.AccessToken); err == nil {
+		t.Fatal("access token issued before the password change was accepted")
+	}
+	if err := s.checkSession(ctx, caller.SessionID, userID, changedAt.Add(-time.Second)); err == nil {
+		t.Fatal("session accepted a token issued a second before the password change")
+	}
+	// iat has second precision, a token issued in the same second is still valid
+	if err := s.checkSession(ctx, caller.SessionID, userID, changedAt.Truncate(time.Second)); err != nil {
+		t.Fatalf("token issued after the password change = %v", err)
+	}
+}
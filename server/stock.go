@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	logger "gogrpc-rpc-boiler/server/log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errBookUnavailable = status.Error(codes.FailedPrecondition, "book is not available")
+	errBorrowNotFound  = status.Error(codes.NotFound, "borrow does not exist")
+)
+
+// recomputes the counters of a book from the status of its items,
+// total_stock counts the copies in circulation and available_stock the ones on the shelf
+func syncStock(ctx context.Context, tx *sql.Tx, bookID int) error {
+	_, err := tx.ExecContext(ctx, `UPDATE books SET
+        total_stock = (SELECT COUNT(*) FROM items WHERE book_id = $1 AND status NOT IN ($2, $3)),
+        available_stock = (SELECT COUNT(*) FROM items WHERE book_id = $1 AND status = $4)
+        WHERE book_id = $1`, bookID, ItemLost, ItemDamaged, ItemAvailable)
+	if err != nil {
+		return fmt.Errorf("failed to update stock: %v", err)
+	}
+	return nil
+}
+
+// takes any copy of the book off the shelf, returns its item_id
+func takeCopy(ctx context.Context, tx *sql.Tx, bookID int) (int, error) {
+	if err := lockBook(ctx, tx, bookID); err != nil {
+		return 0, err
+	}
+	var itemID int
+	err := tx.QueryRowContext(ctx, "SELECT item_id FROM items WHERE book_id = $1 AND status = $2 ORDER BY item_id LIMIT 1 FOR UPDATE", bookID, ItemAvailable).Scan(&itemID)
+	if err == sql.ErrNoRows {
+		return 0, errBookUnavailable
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to get item: %v", err)
+	}
+	if err := setItemStatus(ctx, tx, itemID, ItemOnLoan); err != nil {
+		return 0, err
+	}
+	return itemID, syncStock(ctx, tx, bookID)
+}
+
+// puts a copy back: reserved for the next hold in the queue if there is one (sent to its pickup branch
+// when that is another branch), on the shelf otherwise. a copy marked damaged leaves circulation instead
+func putBackCopy(ctx context.Context, tx *sql.Tx, bookID int, itemID int) error {
+	// the book before its items, the order every borrow and item change locks in
+	if err := lockBook(ctx, tx, bookID); err != nil {
+		return err
+	}
+	var condition string
+	if err := tx.QueryRowContext(ctx, "SELECT condition FROM items WHERE item_id = $1 FOR UPDATE", itemID).Scan(&condition); err != nil {
+		return fmt.Errorf("failed to get item: %v", err)
+	}
+
+	next := ItemAvailable
+	if condition == ConditionDamaged {
+		next = ItemDamaged
+	} else {
+		reserved, err := reserveForNextHold(ctx, tx, bookID, itemID)
+		if err != nil {
+			return err
+		}
+		if reserved != "" {
+			next = reserved
+		}
+	}
+	if err := setItemStatus(ctx, tx, itemID, next); err != nil {
+		return err
+	}
+	return syncStock(ctx, tx, bookID)
+}
+
+// runs fn in a transaction on db, rolled back when fn fails or ctx is cancelled
+func inTx(ctx context.Context, db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to commit transaction: %v", err)
+	}
+	return nil
+}
+
+// records a borrow of the copy with the barcode at the branch in one transaction under the borrower's circulation policy,
+// returns the borrowing_id and the due date. branchID 0 borrows at the copy's branch.
+// a copy on the hold shelf can only be borrowed by the patron it is reserved for
+func borrowItem(ctx context.Context, db *sql.DB, barcode string, userID int, branchID int, role string, borrowedDate time.Time) (int, time.Time, error) {
+	var borrowingID int
+	var returnDate time.Time
+	err := inTx(ctx, db, func(tx *sql.Tx) error {
+		var bookID int
+		err := tx.QueryRowContext(ctx, "SELECT book_id FROM items WHERE barcode = $1", barcode).Scan(&bookID)
+		if err == sql.ErrNoRows {
+			return errItemNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to get item: %v", err)
+		}
+
+		if err := lockPatron(ctx, tx, userID); err != nil {
+			return err
+		}
+		if err := lockBook(ctx, tx, bookID); err != nil {
+			return err
+		}
+		policy, err := policyForBook(ctx, tx, role, bookID)
+		if err != nil {
+			return err
+		}
+		if err := checkLoanLimit(ctx, tx, userID, policy); err != nil {
+			return err
+		}
+		if err := expireHolds(ctx, tx, bookID); err != nil {
+			return err
+		}
+
+		var itemID int
+		var itemStatus string
+		var itemBranchID sql.NullInt64
+		if err := tx.QueryRowContext(ctx, "SELECT item_id, status, branch_id FROM items WHERE barcode = $1 FOR UPDATE", barcode).Scan(&itemID, &itemStatus, &itemBranchID); err != nil {
+			return fmt.Errorf("failed to get item: %v", err)
+		}
+		// the copy has to be at the desk it is checked out from
+		if branchID == 0 {
+			branchID = int(itemBranchID.Int64)
+		} else if itemBranchID.Valid && int(itemBranchID.Int64) != branchID {
+			return errItemElsewhere
+		}
+		reservedItemID, err := fulfillHold(ctx, tx, bookID, userID)
+		if err != nil {
+			return err
+		}
+		switch itemStatus {
+		case ItemOnHold:
+			if itemID != reservedItemID {
+				return errItemReserved
+			}
+		case ItemAvailable:
+			// the patron took another copy off the shelf, the one reserved for them goes to the next hold
+			if reservedItemID != 0 {
+				if err := putBackCopy(ctx, tx, bookID, reservedItemID); err != nil {
+					return err
+				}
+			}
+		default:
+			return errItemUnavailable
+		}
+		if err := setItemStatus(ctx, tx, itemID, ItemOnLoan); err != nil {
+			return err
+		}
+		if err := syncStock(ctx, tx, bookID); err != nil {
+			return err
+		}
+
+		// the loan keeps the renewal rules it was made under, it is due on a day the branch is open
+		calendar, err := loadCalendar(ctx, tx, branchID)
+		if err != nil {
+			return err
+		}
+		returnDate = calendar.nextOpenDay(borrowedDate.AddDate(0, 0, policy.LoanDays))
+		err = tx.QueryRowContext(ctx, "INSERT INTO borrowing (book_id, item_id, user_id, borrowed_date, return_date, returned_date, returned, loan_days, renewals_allowed, branch_id) VALUES ($1, $2, $3, $4, $5, NULL, FALSE, $6, $7, NULLIF($8, 0)) RETURNING borrowing_id",
+			bookID, itemID, userID, borrowedDate, returnDate, policy.LoanDays, policy.Renewals, branchID).Scan(&borrowingID)
+		if err != nil {
+			return fmt.Errorf("failed to create borrow: %v", err)
+		}
+		return nil
+	})
+	return borrowingID, returnDate, err
+}
+
+// marks a borrow returned at the branch, charges any overdue fine (by the calendar of the branch it was borrowed at)
+// and puts the copy back in one transaction,
+// returns the book_id and the fine in cents. the copy stays at the branch it is returned to, branchID 0 leaves it where it was.
+// only the caller that flips returned from false to true gets a row back, so a copy is never returned twice
+func returnBook(ctx context.Context, db *sql.DB, borrowingID int, branchID int, returnedDate time.Time) (int, int, error) {
+	var bookID, fine int
+	err := inTx(ctx, db, func(tx *sql.Tx) error {
+		var userID, borrowBranchID int
+		var itemID sql.NullInt64
+		var returnDate time.Time
+		err := tx.QueryRowContext(ctx, "UPDATE borrowing SET returned = TRUE, returned_date = $1, return_branch_id = NULLIF($3, 0) WHERE borrowing_id = $2 AND returned = FALSE RETURNING book_id, item_id, user_id, return_date, COALESCE(branch_id, 0)",
+			returnedDate, borrowingID, branchID).Scan(&bookID, &itemID, &userID, &returnDate, &borrowBranchID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errBorrowNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to create return: %v", err)
+		}
+		if fine, err = chargeOverdueFine(ctx, tx, borrowingID, bookID, userID, borrowBranchID, returnDate, returnedDate); err != nil {
+			return err
+		}
+		if itemID.Valid && branchID != 0 {
+			if err := lockBook(ctx, tx, bookID); err != nil {
+				return err
+			}
+			if err := moveItem(ctx, tx, int(itemID.Int64), branchID); err != nil {
+				return err
+			}
+		}
+		return putBackBorrowedCopy(ctx, tx, bookID, itemID)
+	})
+	return bookID, fine, err
+}
+
+// puts back the copy of a borrow, borrows from before items existed have none
+func putBackBorrowedCopy(ctx context.Context, tx *sql.Tx, bookID int, itemID sql.NullInt64) error {
+	if !itemID.Valid {
+		logger.LogThis(fmt.Sprintf("[INFO] borrow of book_id %d has no item, stock unchanged", bookID))
+		return nil
+	}
+	return putBackCopy(ctx, tx, bookID, int(itemID.Int64))
+}
@@ -0,0 +1,309 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	"gogrpc-rpc-boiler/server/models"
+)
+
+// storage behind every handler, the Postgres implementation is in store_postgres.go,
+// the in-memory one in store_memory.go and the SQLite one in store_sqlite.go
+
+var (
+	errNotFound  = errors.New("not found")
+	errDuplicate = errors.New("already exists")
+)
+
+// storage backend, STORE=memory runs without any database (tests, local demos),
+// STORE=sqlite keeps everything in the single file SQLITE_PATH (one-machine branches)
+const (
+	StorePostgres = "postgres"
+	StoreMemory   = "memory"
+	StoreSQLite   = "sqlite"
+)
+
+var storeBackend = storeFromEnv()
+
+func storeFromEnv() string {
+	switch backend := os.Getenv("STORE"); backend {
+	case StoreMemory, StoreSQLite:
+		return backend
+	default:
+		return StorePostgres
+	}
+}
+
+// a user with what login and password checks need
+type userCredentials struct {
+	UserID        int
+	Username      string
+	PasswordHash  string
+	Email         string
+	Role          string
+	EmailVerified bool
+}
+
+type impersonationRecord struct {
+	ImpersonatorID       int
+	ImpersonatorUsername string
+	TargetUserID         int
+	TargetUsername       string
+	SessionID            int
+	ClientAddr           string
+	ExpiresAt            time.Time
+	CreatedAt            time.Time
+}
+
+type UserStore interface {
+	// returns the user_id, errDuplicate when the username or email is taken
+	Create(ctx context.Context, user models.UserSensitive) (int, error)
+	// errNotFound when there is no such user
+	GetCredentials(ctx context.Context, username string) (userCredentials, error)
+	GetCredentialsByID(ctx context.Context, userID int) (userCredentials, error)
+	GetCredentialsByEmail(ctx context.Context, email string) (userCredentials, error)
+	Get(ctx context.Context, userID int) (models.User, error)
+	Exists(ctx context.Context, userID int) (bool, error)
+	// replaces the password hash and logs out every session of the user
+	SetPassword(ctx context.Context, userID int, passwordHash string, changedAt time.Time) error
+	Delete(ctx context.Context, userID int) error
+	RecordImpersonation(ctx context.Context, record impersonationRecord) error
+	// marks the address verified when it is still the user's, returns false when it already was,
+	// errNotFound when the user has another address
+	VerifyEmail(ctx context.Context, userID int, email string, verifiedAt time.Time) (bool, error)
+	// replaces the hash only while it is still oldHash, sessions are left alone
+	Rehash(ctx context.Context, userID int, oldHash string, newHash string) error
+}
+
+// a login session with what the token checks need of its user
+type sessionRecord struct {
+	SessionID         int
+	UserID            int
+	Username          string
+	Role              string
+	RefreshTokenHash  string
+	ExpiresAt         time.Time
+	Revoked           bool
+	PasswordChangedAt time.Time // zero when never set
+}
+
+type SessionStore interface {
+	// returns the session_id
+	Create(ctx context.Context, userID int, refreshTokenHash string, expiresAt time.Time, createdAt time.Time) (int, error)
+	// errNotFound when there is no such session
+	Get(ctx context.Context, sessionID int) (sessionRecord, error)
+	// swaps the refresh token hash, errNotFound unless the session is live and still has oldHash
+	Rotate(ctx context.Context, sessionID int, oldHash string, newHash string, at time.Time) error
+	Revoke(ctx context.Context, sessionID int, at time.Time) error
+}
+
+// failed login counters by key (user:<username>, ip:<addr>)
+type LoginAttemptStore interface {
+	// zero when the key is not locked
+	LockedUntil(ctx context.Context, key string) (time.Time, error)
+	// counts a failure and returns the count, which starts over when the last failure is older than window
+	RecordFailure(ctx context.Context, key string, at time.Time, window time.Duration) (int, error)
+	Lock(ctx context.Context, key string, until time.Time) error
+	Clear(ctx context.Context, key string) error
+}
+
+type AuthorStore interface {
+	Create(ctx context.Context, author models.Author) error
+	// authors with an author_id between min and max
+	List(ctx context.Context, min int, max int) ([]*proto.AuthorMin, error)
+	// authors whose name contains name, case insensitive
+	Search(ctx context.Context, name string) ([]*proto.AuthorMin, error)
+	// errNotFound when there is no such author
+	Get(ctx context.Context, authorID int) (models.Author, error)
+	Update(ctx context.Context, author models.UpdateAuthor) error
+	Delete(ctx context.Context, authorID int) error
+	Exists(ctx context.Context, authorID int) (bool, error)
+}
+
+type CategoryStore interface {
+	// errDuplicate when the name is taken
+	Create(ctx context.Context, category models.Category) error
+	List(ctx context.Context, min int, max int) ([]*proto.CategoryMin, error)
+	Search(ctx context.Context, name string) ([]*proto.CategoryMin, error)
+	// errNotFound when there is no such category
+	Get(ctx context.Context, categoryID int) (models.Category, error)
+	Update(ctx context.Context, category models.UpdateCategory) error
+	Delete(ctx context.Context, categoryID int) error
+	Exists(ctx context.Context, categoryID int) (bool, error)
+}
+
+type BookStore interface {
+	// stock starts at zero and follows the items, errDuplicate when the ISBN is taken
+	Create(ctx context.Context, book models.Book) error
+	List(ctx context.Context, min int, max int) ([]*proto.BookMin, error)
+	// books published between start and end (YYYY-MM-DD), both inclusive
+	ListByPublishedDate(ctx context.Context, start string, end string) ([]*proto.BookMin, error)
+	Search(ctx context.Context, title string) ([]*proto.BookMin, error)
+	// errNotFound when there is no such book
+	Get(ctx context.Context, bookID int) (models.Book, error)
+	// stock is derived from items and never edited here
+	Update(ctx context.Context, book models.UpdateBook) error
+	// deletes the book with its items
+	Delete(ctx context.Context, bookID int) error
+	Exists(ctx context.Context, bookID int) (bool, error)
+	IsAuthorInUse(ctx context.Context, authorID int) (bool, error)
+	IsCategoryInUse(ctx context.Context, categoryID int) (bool, error)
+	// up to limit random books of the category
+	Recommend(ctx context.Context, categoryID int, limit int) ([]*proto.BookMin, error)
+	// adds a copy at a branch, errBookNotFound, errBranchNotFound or AlreadyExists for a taken barcode
+	AddItem(ctx context.Context, item *proto.Item) (*proto.Item, error)
+	// copies of a book, with a status and at a branch, zero values match any
+	ListItems(ctx context.Context, bookID int, itemStatus string, branchID int) ([]*proto.Item, error)
+	// takes the copy out of circulation, its hold goes back to the queue and its transfer is cancelled.
+	// errItemNotFound, FailedPrecondition when it is already lost
+	MarkItemLost(ctx context.Context, barcode string) (*proto.Item, error)
+	// a copy on a shelf leaves circulation now, one on loan or in transit once it is back
+	MarkItemDamaged(ctx context.Context, barcode string) (*proto.Item, error)
+}
+
+// which borrows List returns, returned or not and one of: a borrowing_id range,
+// a borrowed_date range (YYYY-MM-DD) or a user
+type borrowFilter struct {
+	Returned  bool
+	MinID     int
+	MaxID     int
+	StartDate string
+	EndDate   string
+	UserID    int
+}
+
+// a borrow that was overdue at some point in time
+type overdueBorrow struct {
+	BorrowingID  int
+	BookID       int
+	UserID       int
+	BranchID     int
+	BorrowedDate time.Time
+	ReturnDate   time.Time
+	DaysLate     int // open days of the branch between the due date and asOf
+}
+
+type BorrowStore interface {
+	// borrows the copy with the barcode under the circulation policy of role, returns the borrowing_id and the due date.
+	// errBranchNotFound for an unknown branch, branchID 0 borrows at the copy's branch
+	Create(ctx context.Context, barcode string, userID int, branchID int, role string, borrowedDate time.Time) (int, time.Time, error)
+	// returns the borrow and puts the copy back, returns the overdue fine in cents
+	Return(ctx context.Context, borrowingID int, branchID int, returnedDate time.Time) (int, error)
+	// unpaid fines of the user in cents
+	OutstandingFines(ctx context.Context, userID int) (int, error)
+	List(ctx context.Context, filter borrowFilter) ([]*proto.BorrowOrReturnMin, error)
+	// borrows overdue at asOf, optionally due from start and before end, longest overdue first
+	Overdue(ctx context.Context, asOf time.Time, start *time.Time, end *time.Time) ([]overdueBorrow, error)
+	StillBorrows(ctx context.Context, userID int) (bool, error)
+	// moves the copy between books when book_id or returned changes, errBorrowNotFound when there is no such borrow
+	Update(ctx context.Context, borrow models.UpdateBorrow, returned bool) error
+	// puts the copy back when it was still out
+	Delete(ctx context.Context, borrowingID int) error
+	// extends the borrow by its loan period, checkAccess sees the borrower before anything changes.
+	// errBorrowNotFound, errRenewalLimit, errRenewalOverdue or errRenewalHolds
+	Renew(ctx context.Context, borrowingID int, checkAccess func(userID int) error) (*proto.RenewedBorrow, error)
+	// every fine of the user, oldest first
+	ListFines(ctx context.Context, userID int) ([]*proto.Fine, error)
+	// records a payment, errFineNotFound, FailedPrecondition for a closed fine, InvalidArgument above what is owed
+	PayFine(ctx context.Context, fineID int, amountCents int) (*proto.Fine, error)
+	// forgives what is left of an outstanding fine, errFineNotFound or FailedPrecondition for a closed fine
+	WaiveFine(ctx context.Context, fineID int, note string) (*proto.Fine, error)
+}
+
+type HoldStore interface {
+	// queues the user for the book under the circulation policy of role, a copy on the shelf of another branch
+	// is sent to the pickup branch right away. errBookNotFound, errBranchNotFound, errHoldLimit, FailedPrecondition
+	// when a copy can be borrowed or the user borrows the book, AlreadyExists when the user already holds it
+	Place(ctx context.Context, bookID int, userID int, pickupBranchID int, role string) (*proto.Hold, error)
+	// cancels an active hold, checkAccess sees the holder before anything changes. errHoldNotFound
+	Cancel(ctx context.Context, holdID int, checkAccess func(userID int) error) error
+	// active holds of a book and a user, zero values match any
+	List(ctx context.Context, bookID int, userID int) ([]*proto.Hold, error)
+}
+
+type BranchStore interface {
+	// errDuplicate when the name is taken
+	Create(ctx context.Context, name string, address string) (*proto.Branch, error)
+	List(ctx context.Context) ([]*proto.Branch, error)
+	Exists(ctx context.Context, branchID int) (bool, error)
+	// sets an available copy aside to be shipped to the branch. errItemNotFound, errBranchNotFound or errItemUnavailable
+	RequestTransfer(ctx context.Context, barcode string, toBranchID int) (*proto.Transfer, error)
+	// errTransferNotFound, FailedPrecondition unless the transfer is requested
+	ShipTransfer(ctx context.Context, transferID int) (*proto.Transfer, error)
+	// checks the copy in at the receiving branch, errTransferNotFound, FailedPrecondition unless it is in transit
+	ReceiveTransfer(ctx context.Context, transferID int) (*proto.Transfer, error)
+	// transfers from or to a branch with a status, zero values match any
+	ListTransfers(ctx context.Context, branchID int, transferStatus string) ([]*proto.Transfer, error)
+}
+
+type CalendarStore interface {
+	// the hours in effect per weekday and the holidays of the branch, branch 0 has the defaults only
+	Get(ctx context.Context, branchID int) (*proto.Calendar, error)
+	// creates or replaces the hours of a weekday at a branch
+	SetHours(ctx context.Context, hours *proto.OpeningHours) error
+	// creates or renames the holiday of a branch on a date (YYYY-MM-DD), returns its holiday_id
+	AddHoliday(ctx context.Context, holiday *proto.Holiday) (int, error)
+	// errNotFound when there is no such holiday
+	DeleteHoliday(ctx context.Context, holidayID int) error
+}
+
+type PolicyStore interface {
+	List(ctx context.Context) ([]circulationPolicy, error)
+	// creates or replaces the policy of its role and category, returns its policy_id
+	Set(ctx context.Context, policy circulationPolicy) (int, error)
+	// errNotFound when there is no such policy
+	Delete(ctx context.Context, policyID int) error
+}
+
+type NotificationStore interface {
+	// the notices owed at now that were not sent yet: overdue for loans due before now,
+	// due soon for the others due before dueSoonBefore
+	Due(ctx context.Context, now time.Time, dueSoonBefore time.Time) ([]dueNotice, error)
+	// records a notice before it is sent, returns its notification_id, false when it already was
+	Claim(ctx context.Context, notice dueNotice, recipient string, subject string, sentAt time.Time) (int, bool, error)
+	// forgets a claimed notice that could not be sent
+	Release(ctx context.Context, notificationID int) error
+	// notices sent to the user, newest first
+	List(ctx context.Context, userID int) ([]*proto.Notification, error)
+}
+
+// an API key with the username and role of its owner, zero times are unset
+type apiKeyRecord struct {
+	KeyID      int
+	UserID     int
+	Username   string
+	OwnerRole  string
+	Name       string
+	Prefix     string
+	Role       string
+	Scopes     []string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+	RevokedAt  time.Time
+}
+
+type APIKeyStore interface {
+	// stores the key under the hash of its secret, returns the key_id
+	Create(ctx context.Context, key apiKeyRecord, keyHash string) (int, error)
+	// errNotFound when there is no such key
+	Get(ctx context.Context, keyID int) (apiKeyRecord, error)
+	GetByHash(ctx context.Context, keyHash string) (apiKeyRecord, error)
+	// the keys of the user, revoked ones included
+	List(ctx context.Context, userID int) ([]apiKeyRecord, error)
+	Revoke(ctx context.Context, keyID int, at time.Time) error
+	// sets last_used_at, at most once a minute per key
+	Touch(ctx context.Context, keyID int, at time.Time) error
+}
+
+type PasswordResetStore interface {
+	// stores a new reset token of the user, the older ones stop working
+	Create(ctx context.Context, userID int, tokenHash string, expiresAt time.Time, createdAt time.Time) error
+	// uses up a token at, newHash gets its user and returns the new password hash, which replaces the old one
+	// and logs out every session. errNotFound for an unknown, used or expired token, when newHash fails
+	// the token stays unused
+	Use(ctx context.Context, tokenHash string, at time.Time, newHash func(user userCredentials) (string, error)) (userCredentials, error)
+}
@@ -0,0 +1,2267 @@
+package main
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	"gogrpc-rpc-boiler/server/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stores kept in process memory for STORE=memory, with the constraints of the Postgres schema
+// (unique username, email, category name, ISBN, barcode and branch name, available stock never below zero)
+// and the same circulation: holds, transfers, calendars, policies and the fine ledger behave like on Postgres.
+// nothing can roll back, so every step checks what can fail before it changes anything
+
+// with MEMORY_ADMIN_PASSWORD set, starts with a verified admin "admin", there is no other way to get one
+func seedMemoryAdmin(ctx context.Context, users *memoryUserStore) error {
+	return seedAdmin(ctx, users, os.Getenv("MEMORY_ADMIN_PASSWORD"))
+}
+
+// creates the verified admin "admin" with the password plain unless plain is empty or the admin exists
+func seedAdmin(ctx context.Context, users UserStore, plain string) error {
+	const username, email = "admin", "admin@localhost"
+	if plain == "" {
+		return nil
+	}
+	if _, err := users.GetCredentials(ctx, username); err != errNotFound {
+		return err
+	}
+	hash, err := hashNewPassword(plain, username, email)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	userID, err := users.Create(ctx, models.UserSensitive{
+		Username:  username,
+		Password:  hash,
+		FirstName: optionalString(""),
+		LastName:  optionalString(""),
+		Email:     email,
+		Role:      RoleAdmin,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = users.VerifyEmail(ctx, userID, email, now)
+	return err
+}
+
+type memoryUser struct {
+	models.UserSensitive
+	UserID            int
+	EmailVerified     bool
+	PasswordChangedAt time.Time
+}
+
+type memoryLoginAttempt struct {
+	Failures      int
+	LockedUntil   time.Time
+	LastFailureAt time.Time
+}
+
+type memoryItem struct {
+	ItemID        int
+	BookID        int
+	Barcode       string
+	Condition     string
+	Status        string
+	ShelfLocation string
+	BranchID      int
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type memoryBorrow struct {
+	BorrowingID     int
+	BookID          int
+	ItemID          int // 0 when the borrow has no copy
+	UserID          int
+	BranchID        int
+	ReturnBranchID  int
+	BorrowedDate    time.Time
+	ReturnDate      time.Time
+	Returned        bool
+	ReturnedDate    time.Time
+	LoanDays        int // the rules of the policy the loan was made under
+	RenewalsAllowed int
+	RenewalCount    int
+	LastRenewedAt   time.Time
+}
+
+type memoryFine struct {
+	FineID      int
+	BorrowingID int
+	BookID      int
+	UserID      int
+	DaysLate    int
+	AmountCents int
+	PaidCents   int
+	Status      string
+	Note        string
+	CreatedAt   time.Time
+	ClosedAt    time.Time
+}
+
+type memoryHold struct {
+	HoldID          int
+	BookID          int
+	UserID          int
+	Status          string
+	ItemID          int // the copy reserved or in transit for the hold, 0 when none
+	PickupBranchID  int
+	PlacedAt        time.Time
+	ReadyAt         time.Time
+	PickupExpiresAt time.Time
+	ClosedAt        time.Time
+}
+
+type memoryBranch struct {
+	BranchID  int
+	Name      string
+	Address   string
+	CreatedAt time.Time
+}
+
+type memoryTransfer struct {
+	TransferID   int
+	ItemID       int
+	FromBranchID int
+	ToBranchID   int
+	HoldID       int // 0 for a plain transfer
+	Status       string
+	RequestedAt  time.Time
+	ShippedAt    time.Time
+	ReceivedAt   time.Time
+}
+
+// opening hours are unique per branch and weekday
+type memoryWeekday struct {
+	BranchID int
+	Weekday  int
+}
+
+type memoryHours struct {
+	OpensAt  string
+	ClosesAt string
+	Closed   bool
+}
+
+type memoryHoliday struct {
+	HolidayID int
+	BranchID  int
+	Date      string // YYYY-MM-DD
+	Name      string
+}
+
+type memoryNotification struct {
+	NotificationID int
+	UserID         int
+	BorrowingID    int
+	Kind           string
+	DueDate        time.Time
+	Recipient      string
+	Subject        string
+	SentAt         time.Time
+}
+
+// the owner's username and role are joined in when the key is read
+type memoryAPIKey struct {
+	apiKeyRecord
+	KeyHash string
+}
+
+type memoryPasswordReset struct {
+	ResetID   int
+	UserID    int
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    time.Time
+	CreatedAt time.Time
+}
+
+// every table behind one lock, so a borrow and the stock it changes are one step like a transaction
+type memoryDB struct {
+	mu sync.Mutex
+
+	lastID         map[string]int
+	users          map[int]*memoryUser
+	impersonations []impersonationRecord
+	sessions       map[int]*sessionRecord
+	loginAttempts  map[string]*memoryLoginAttempt
+	authors        map[int]*models.Author
+	categories     map[int]*models.Category
+	books          map[int]*models.Book
+	items          map[int]*memoryItem
+	borrows        map[int]*memoryBorrow
+	fines          map[int]*memoryFine
+	holds          map[int]*memoryHold
+	branches       map[int]*memoryBranch
+	transfers      map[int]*memoryTransfer
+	openingHours   map[memoryWeekday]*memoryHours
+	holidays       map[int]*memoryHoliday
+	policies       map[int]*circulationPolicy
+	notifications  map[int]*memoryNotification
+	apiKeys        map[int]*memoryAPIKey
+	passwordResets map[int]*memoryPasswordReset
+}
+
+func newMemoryDB() *memoryDB {
+	return &memoryDB{
+		lastID:         map[string]int{},
+		users:          map[int]*memoryUser{},
+		sessions:       map[int]*sessionRecord{},
+		loginAttempts:  map[string]*memoryLoginAttempt{},
+		authors:        map[int]*models.Author{},
+		categories:     map[int]*models.Category{},
+		books:          map[int]*models.Book{},
+		items:          map[int]*memoryItem{},
+		borrows:        map[int]*memoryBorrow{},
+		fines:          map[int]*memoryFine{},
+		holds:          map[int]*memoryHold{},
+		branches:       map[int]*memoryBranch{},
+		transfers:      map[int]*memoryTransfer{},
+		openingHours:   map[memoryWeekday]*memoryHours{},
+		holidays:       map[int]*memoryHoliday{},
+		policies:       map[int]*circulationPolicy{},
+		notifications:  map[int]*memoryNotification{},
+		apiKeys:        map[int]*memoryAPIKey{},
+		passwordResets: map[int]*memoryPasswordReset{},
+	}
+}
+
+// the next id of a table, like a SERIAL column ids are never reused
+func (m *memoryDB) nextID(table string) int {
+	m.lastID[table]++
+	return m.lastID[table]
+}
+
+// ids of a map in ascending order
+func sortedIDs[T any](rows map[int]T) []int {
+	ids := make([]int, 0, len(rows))
+	for id := range rows {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// case-insensitive substring match, like ILIKE '%pattern%'
+func containsFold(s string, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+func optionalString(s string) *string {
+	return &s
+}
+
+type memoryUserStore struct {
+	m *memoryDB
+}
+
+func (s *memoryUserStore) Create(ctx context.Context, user models.UserSensitive) (int, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	for _, existing := range s.m.users {
+		if existing.Username == user.Username || existing.Email == user.Email {
+			return 0, errDuplicate
+		}
+	}
+	userID := s.m.nextID("users")
+	s.m.users[userID] = &memoryUser{UserSensitive: user, UserID: userID, PasswordChangedAt: time.Now().UTC()}
+	return userID, nil
+}
+
+func memoryCredentials(user *memoryUser) userCredentials {
+	return userCredentials{
+		UserID:        user.UserID,
+		Username:      user.Username,
+		PasswordHash:  user.Password,
+		Email:         user.Email,
+		Role:          user.Role,
+		EmailVerified: user.EmailVerified,
+	}
+}
+
+func (s *memoryUserStore) GetCredentials(ctx context.Context, username string) (userCredentials, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	for _, user := range s.m.users {
+		if user.Username == username {
+			return memoryCredentials(user), nil
+		}
+	}
+	return userCredentials{}, errNotFound
+}
+
+func (s *memoryUserStore) GetCredentialsByID(ctx context.Context, userID int) (userCredentials, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	user, ok := s.m.users[userID]
+	if !ok {
+		return userCredentials{}, errNotFound
+	}
+	return memoryCredentials(user), nil
+}
+
+func (s *memoryUserStore) GetCredentialsByEmail(ctx context.Context, email string) (userCredentials, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	for _, user := range s.m.users {
+		if user.Email == email {
+			return memoryCredentials(user), nil
+		}
+	}
+	return userCredentials{}, errNotFound
+}
+
+func (s *memoryUserStore) Get(ctx context.Context, userID int) (models.User, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	user, ok := s.m.users[userID]
+	if !ok {
+		return models.User{}, errNotFound
+	}
+	return models.User{
+		UserID:    user.UserID,
+		Username:  user.Username,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Email:     user.Email,
+		Role:      user.Role,
+	}, nil
+}
+
+func (s *memoryUserStore) Exists(ctx context.Context, userID int) (bool, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	_, ok := s.m.users[userID]
+	return ok, nil
+}
+
+func (s *memoryUserStore) SetPassword(ctx context.Context, userID int, passwordHash string, changedAt time.Time) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	user, ok := s.m.users[userID]
+	if !ok {
+		return errNotFound
+	}
+	user.Password = passwordHash
+	user.PasswordChangedAt = changedAt
+	user.UpdatedAt = changedAt
+	// tokens issued with the old password stop working
+	for _, session := range s.m.sessions {
+		if session.UserID == userID {
+			session.Revoked = true
+		}
+	}
+	return nil
+}
+
+func (s *memoryUserStore) Delete(ctx context.Context, userID int) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if _, ok := s.m.users[userID]; !ok {
+		return errNotFound
+	}
+	delete(s.m.users, userID)
+	for sessionID, session := range s.m.sessions {
+		if session.UserID == userID {
+			delete(s.m.sessions, sessionID)
+		}
+	}
+	return nil
+}
+
+func (s *memoryUserStore) RecordImpersonation(ctx context.Context, record impersonationRecord) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	s.m.impersonations = append(s.m.impersonations, record)
+	return nil
+}
+
+func (s *memoryUserStore) VerifyEmail(ctx context.Context, userID int, email string, verifiedAt time.Time) (bool, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	user, ok := s.m.users[userID]
+	if !ok || user.Email != email {
+		return false, errNotFound
+	}
+	if user.EmailVerified {
+		return false, nil
+	}
+	user.EmailVerified = true
+	return true, nil
+}
+
+func (s *memoryUserStore) Rehash(ctx context.Context, userID int, oldHash string, newHash string) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if user, ok := s.m.users[userID]; ok && user.Password == oldHash {
+		user.Password = newHash
+		user.UpdatedAt = time.Now().UTC()
+	}
+	return nil
+}
+
+type memorySessionStore struct {
+	m *memoryDB
+}
+
+func (s *memorySessionStore) Create(ctx context.Context, userID int, refreshTokenHash string, expiresAt time.Time, createdAt time.Time) (int, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if _, ok := s.m.users[userID]; !ok {
+		return 0, errNotFound
+	}
+	sessionID := s.m.nextID("sessions")
+	s.m.sessions[sessionID] = &sessionRecord{
+		SessionID:        sessionID,
+		UserID:           userID,
+		RefreshTokenHash: refreshTokenHash,
+		ExpiresAt:        expiresAt,
+	}
+	return sessionID, nil
+}
+
+func (s *memorySessionStore) Get(ctx context.Context, sessionID int) (sessionRecord, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	session, ok := s.m.sessions[sessionID]
+	if !ok {
+		return sessionRecord{}, errNotFound
+	}
+	user := s.m.users[session.UserID]
+	record := *session
+	record.Username = user.Username
+	record.Role = user.Role
+	record.PasswordChangedAt = user.PasswordChangedAt
+	return record, nil
+}
+
+func (s *memorySessionStore) Rotate(ctx context.Context, sessionID int, oldHash string, newHash string, at time.Time) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	session, ok := s.m.sessions[sessionID]
+	if !ok || session.Revoked || !at.Before(session.ExpiresAt) || session.RefreshTokenHash != oldHash {
+		return errNotFound
+	}
+	session.RefreshTokenHash = newHash
+	return nil
+}
+
+func (s *memorySessionStore) Revoke(ctx context.Context, sessionID int, at time.Time) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if session, ok := s.m.sessions[sessionID]; ok {
+		session.Revoked = true
+	}
+	return nil
+}
+
+type memoryLoginAttemptStore struct {
+	m *memoryDB
+}
+
+func (s *memoryLoginAttemptStore) LockedUntil(ctx context.Context, key string) (time.Time, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if attempt, ok := s.m.loginAttempts[key]; ok {
+		return attempt.LockedUntil, nil
+	}
+	return time.Time{}, nil
+}
+
+func (s *memoryLoginAttemptStore) RecordFailure(ctx context.Context, key string, at time.Time, window time.Duration) (int, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	attempt, ok := s.m.loginAttempts[key]
+	if !ok {
+		attempt = &memoryLoginAttempt{}
+		s.m.loginAttempts[key] = attempt
+	}
+	if attempt.LastFailureAt.Before(at.Add(-window)) {
+		attempt.Failures = 0
+	}
+	attempt.Failures++
+	attempt.LastFailureAt = at
+	return attempt.Failures, nil
+}
+
+func (s *memoryLoginAttemptStore) Lock(ctx context.Context, key string, until time.Time) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if attempt, ok := s.m.loginAttempts[key]; ok {
+		attempt.LockedUntil = until
+	}
+	return nil
+}
+
+func (s *memoryLoginAttemptStore) Clear(ctx context.Context, key string) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	delete(s.m.loginAttempts, key)
+	return nil
+}
+
+type memoryAuthorStore struct {
+	m *memoryDB
+}
+
+func (s *memoryAuthorStore) Create(ctx context.Context, author models.Author) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	s.m.authors[s.m.nextID("authors")] = &author
+	return nil
+}
+
+func (s *memoryAuthorStore) list(match func(authorID int, author *models.Author) bool) []*proto.AuthorMin {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	var authorMins []*proto.AuthorMin
+	for _, authorID := range sortedIDs(s.m.authors) {
+		if author := s.m.authors[authorID]; match(authorID, author) {
+			authorMins = append(authorMins, &proto.AuthorMin{AuthorId: int32(authorID), Name: author.Name})
+		}
+	}
+	return authorMins
+}
+
+func (s *memoryAuthorStore) List(ctx context.Context, min int, max int) ([]*proto.AuthorMin, error) {
+	return s.list(func(authorID int, author *models.Author) bool {
+		return authorID >= min && authorID <= max
+	}), nil
+}
+
+func (s *memoryAuthorStore) Search(ctx context.Context, name string) ([]*proto.AuthorMin, error) {
+	return s.list(func(authorID int, author *models.Author) bool {
+		return containsFold(author.Name, name)
+	}), nil
+}
+
+func (s *memoryAuthorStore) Get(ctx context.Context, authorID int) (models.Author, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	author, ok := s.m.authors[authorID]
+	if !ok {
+		return models.Author{}, errNotFound
+	}
+	return *author, nil
+}
+
+func (s *memoryAuthorStore) Update(ctx context.Context, update models.UpdateAuthor) error {
+	birthdate, err := time.Parse("2006-01-02", update.NewBirthdate)
+	if err != nil {
+		return err
+	}
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	author, ok := s.m.authors[update.AuthorID]
+	if !ok {
+		return errNotFound
+	}
+	author.Name = update.NewName
+	author.Birthdate = &birthdate
+	author.Nationality = optionalString(update.NewNationality)
+	author.Biography = optionalString(update.NewBiography)
+	author.UpdatedAt = update.UpdatedAt
+	return nil
+}
+
+func (s *memoryAuthorStore) Delete(ctx context.Context, authorID int) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if _, ok := s.m.authors[authorID]; !ok {
+		return errNotFound
+	}
+	delete(s.m.authors, authorID)
+	return nil
+}
+
+func (s *memoryAuthorStore) Exists(ctx context.Context, authorID int) (bool, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	_, ok := s.m.authors[authorID]
+	return ok, nil
+}
+
+type memoryCategoryStore struct {
+	m *memoryDB
+}
+
+// errDuplicate when another category than categoryID has the name
+func (s *memoryCategoryStore) checkName(categoryID int, name string) error {
+	for id, category := range s.m.categories {
+		if id != categoryID && category.Name == name {
+			return errDuplicate
+		}
+	}
+	return nil
+}
+
+func (s *memoryCategoryStore) Create(ctx context.Context, category models.Category) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if err := s.checkName(0, category.Name); err != nil {
+		return err
+	}
+	s.m.categories[s.m.nextID("categories")] = &category
+	return nil
+}
+
+func (s *memoryCategoryStore) list(match func(categoryID int, category *models.Category) bool) []*proto.CategoryMin {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	var categoryMins []*proto.CategoryMin
+	for _, categoryID := range sortedIDs(s.m.categories) {
+		if category := s.m.categories[categoryID]; match(categoryID, category) {
+			categoryMins = append(categoryMins, &proto.CategoryMin{CategoryId: int32(categoryID), Name: category.Name})
+		}
+	}
+	return categoryMins
+}
+
+func (s *memoryCategoryStore) List(ctx context.Context, min int, max int) ([]*proto.CategoryMin, error) {
+	return s.list(func(categoryID int, category *models.Category) bool {
+		return categoryID >= min && categoryID <= max
+	}), nil
+}
+
+func (s *memoryCategoryStore) Search(ctx context.Context, name string) ([]*proto.CategoryMin, error) {
+	return s.list(func(categoryID int, category *models.Category) bool {
+		return containsFold(category.Name, name)
+	}), nil
+}
+
+func (s *memoryCategoryStore) Get(ctx context.Context, categoryID int) (models.Category, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	category, ok := s.m.categories[categoryID]
+	if !ok {
+		return models.Category{}, errNotFound
+	}
+	return *category, nil
+}
+
+func (s *memoryCategoryStore) Update(ctx context.Context, update models.UpdateCategory) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if err := s.checkName(update.CategoryID, update.NewName); err != nil {
+		return err
+	}
+	category, ok := s.m.categories[update.CategoryID]
+	if !ok {
+		return errNotFound
+	}
+	category.Name = update.NewName
+	category.Description = optionalString(update.NewDescription)
+	category.UpdatedAt = update.UpdatedAt
+	return nil
+}
+
+func (s *memoryCategoryStore) Delete(ctx context.Context, categoryID int) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if _, ok := s.m.categories[categoryID]; !ok {
+		return errNotFound
+	}
+	delete(s.m.categories, categoryID)
+	return nil
+}
+
+func (s *memoryCategoryStore) Exists(ctx context.Context, categoryID int) (bool, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	_, ok := s.m.categories[categoryID]
+	return ok, nil
+}
+
+type memoryBookStore struct {
+	m *memoryDB
+}
+
+// errDuplicate when another book than bookID has the ISBN, books without one never clash
+func (s *memoryBookStore) checkISBN(bookID int, isbn *string) error {
+	if isbn == nil {
+		return nil
+	}
+	for id, book := range s.m.books {
+		if id != bookID && book.ISBN != nil && *book.ISBN == *isbn {
+			return errDuplicate
+		}
+	}
+	return nil
+}
+
+// the book with its stock counted from the items, like syncStock
+func (m *memoryDB) bookWithStock(bookID int) models.Book {
+	book := *m.books[bookID]
+	book.TotalStock, book.AvailableStock = 0, 0
+	for _, item := range m.items {
+		if item.BookID != bookID {
+			continue
+		}
+		if item.Status != ItemLost && item.Status != ItemDamaged {
+			book.TotalStock++
+		}
+		if item.Status == ItemAvailable {
+			book.AvailableStock++
+		}
+	}
+	return book
+}
+
+func (s *memoryBookStore) Create(ctx context.Context, book models.Book) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if err := s.checkISBN(0, book.ISBN); err != nil {
+		return err
+	}
+	book.TotalStock, book.AvailableStock = 0, 0
+	s.m.books[s.m.nextID("books")] = &book
+	return nil
+}
+
+func (s *memoryBookStore) list(match func(bookID int, book *models.Book) bool) []*proto.BookMin {
+	var bookMins []*proto.BookMin
+	for _, bookID := range sortedIDs(s.m.books) {
+		if !match(bookID, s.m.books[bookID]) {
+			continue
+		}
+		book := s.m.bookWithStock(bookID)
+		bookMin := &proto.BookMin{
+			BookId:         int32(bookID),
+			Title:          book.Title,
+			CategoryId:     int32(book.CategoryID),
+			AuthorId:       strconv.Itoa(book.AuthorID),
+			AvailableStock: int32(book.AvailableStock),
+		}
+		if book.PublishedDate != nil {
+			bookMin.PublishedDate = book.PublishedDate.Format(time.RFC3339)
+		}
+		bookMins = append(bookMins, bookMin)
+	}
+	return bookMins
+}
+
+func (s *memoryBookStore) List(ctx context.Context, min int, max int) ([]*proto.BookMin, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	return s.list(func(bookID int, book *models.Book) bool {
+		return bookID >= min && bookID <= max
+	}), nil
+}
+
+func (s *memoryBookStore) ListByPublishedDate(ctx context.Context, start string, end string) ([]*proto.BookMin, error) {
+	startDate, err := time.Parse("2006-01-02", start)
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := time.Parse("2006-01-02", end)
+	if err != nil {
+		return nil, err
+	}
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	bookMins := s.list(func(bookID int, book *models.Book) bool {
+		return book.PublishedDate != nil && !book.PublishedDate.Before(startDate) && !book.PublishedDate.After(endDate)
+	})
+	sort.SliceStable(bookMins, func(i, j int) bool {
+		return bookMins[i].PublishedDate < bookMins[j].PublishedDate
+	})
+	return bookMins, nil
+}
+
+func (s *memoryBookStore) Search(ctx context.Context, title string) ([]*proto.BookMin, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	return s.list(func(bookID int, book *models.Book) bool {
+		return containsFold(book.Title, title)
+	}), nil
+}
+
+func (s *memoryBookStore) Get(ctx context.Context, bookID int) (models.Book, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if _, ok := s.m.books[bookID]; !ok {
+		return models.Book{}, errNotFound
+	}
+	return s.m.bookWithStock(bookID), nil
+}
+
+func (s *memoryBookStore) Update(ctx context.Context, update models.UpdateBook) error {
+	publishedDate, err := time.Parse("2006-01-02", update.NewPublishedDate)
+	if err != nil {
+		return err
+	}
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	isbn := optionalString(update.NewISBN)
+	if err := s.checkISBN(update.BookID, isbn); err != nil {
+		return err
+	}
+	book, ok := s.m.books[update.BookID]
+	if !ok {
+		return errNotFound
+	}
+	book.Title = update.NewTitle
+	book.CategoryID = update.NewCategoryID
+	book.AuthorID = update.NewAuthorID
+	book.PublishedDate = &publishedDate
+	book.ISBN = isbn
+	book.UpdatedAt = update.UpdatedAt
+	return nil
+}
+
+func (s *memoryBookStore) Delete(ctx context.Context, bookID int) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if _, ok := s.m.books[bookID]; !ok {
+		return errNotFound
+	}
+	for itemID, item := range s.m.items {
+		if item.BookID == bookID {
+			delete(s.m.items, itemID)
+		}
+	}
+	delete(s.m.books, bookID)
+	return nil
+}
+
+func (s *memoryBookStore) Exists(ctx context.Context, bookID int) (bool, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	_, ok := s.m.books[bookID]
+	return ok, nil
+}
+
+func (s *memoryBookStore) IsAuthorInUse(ctx context.Context, authorID int) (bool, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	for _, book := range s.m.books {
+		if book.AuthorID == authorID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *memoryBookStore) IsCategoryInUse(ctx context.Context, categoryID int) (bool, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	for _, book := range s.m.books {
+		if book.CategoryID == categoryID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *memoryBookStore) Recommend(ctx context.Context, categoryID int, limit int) ([]*proto.BookMin, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	bookMins := s.list(func(bookID int, book *models.Book) bool {
+		return book.CategoryID == categoryID
+	})
+	rand.Shuffle(len(bookMins), func(i, j int) {
+		bookMins[i], bookMins[j] = bookMins[j], bookMins[i]
+	})
+	if len(bookMins) > limit {
+		bookMins = bookMins[:limit]
+	}
+	return bookMins, nil
+}
+
+func memoryItemToProto(item *memoryItem) *proto.Item {
+	return &proto.Item{
+		ItemId:        int32(item.ItemID),
+		BookId:        int32(item.BookID),
+		Barcode:       item.Barcode,
+		Condition:     item.Condition,
+		Status:        item.Status,
+		ShelfLocation: item.ShelfLocation,
+		CreatedAt:     item.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:     item.UpdatedAt.Format("2006-01-02 15:04:05"),
+		BranchId:      int32(item.BranchID),
+	}
+}
+
+func (s *memoryBookStore) AddItem(ctx context.Context, req *proto.Item) (*proto.Item, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if _, ok := s.m.branches[int(req.BranchId)]; !ok {
+		return nil, errBranchNotFound
+	}
+	if _, ok := s.m.books[int(req.BookId)]; !ok {
+		return nil, errBookNotFound
+	}
+	if s.m.itemByBarcode(req.Barcode) != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "barcode %s is already in use", req.Barcode)
+	}
+
+	now := time.Now().UTC()
+	item := &memoryItem{
+		ItemID:        s.m.nextID("items"),
+		BookID:        int(req.BookId),
+		Barcode:       req.Barcode,
+		Condition:     req.Condition,
+		ShelfLocation: req.ShelfLocation,
+		BranchID:      int(req.BranchId),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	s.m.items[item.ItemID] = item
+	s.m.putBackCopy(item)
+	return memoryItemToProto(item), nil
+}
+
+func (s *memoryBookStore) ListItems(ctx context.Context, bookID int, itemStatus string, branchID int) ([]*proto.Item, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	var items []*proto.Item
+	for _, itemID := range sortedIDs(s.m.items) {
+		item := s.m.items[itemID]
+		if (bookID == 0 || item.BookID == bookID) && (itemStatus == "" || item.Status == itemStatus) && (branchID == 0 || item.BranchID == branchID) {
+			items = append(items, memoryItemToProto(item))
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].BookId < items[j].BookId
+	})
+	return items, nil
+}
+
+// a lost copy on loan stays on the borrow, returning it later puts it back in circulation
+func (s *memoryBookStore) MarkItemLost(ctx context.Context, barcode string) (*proto.Item, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	item := s.m.itemByBarcode(barcode)
+	if item == nil {
+		return nil, errItemNotFound
+	}
+	if item.Status == ItemLost {
+		return nil, status.Error(codes.FailedPrecondition, "item is already lost")
+	}
+	// a copy lost on the hold shelf or on its way to another branch sends its hold back to the queue
+	if item.Status == ItemOnHold || item.Status == ItemInTransit {
+		s.m.unreserveItem(item.ItemID)
+	}
+	if item.Status == ItemInTransit {
+		s.m.cancelTransfers(item.ItemID)
+	}
+	s.m.setItemStatus(item, ItemLost)
+	return memoryItemToProto(item), nil
+}
+
+// a damaged copy on loan stays on the borrow and leaves circulation when it is returned,
+// one in transit when it is received
+func (s *memoryBookStore) MarkItemDamaged(ctx context.Context, barcode string) (*proto.Item, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	item := s.m.itemByBarcode(barcode)
+	if item == nil {
+		return nil, errItemNotFound
+	}
+	if item.Status == ItemDamaged {
+		return nil, status.Error(codes.FailedPrecondition, "item is already damaged")
+	}
+	item.Condition = ConditionDamaged
+	item.UpdatedAt = time.Now().UTC()
+	switch item.Status {
+	case ItemOnHold:
+		s.m.unreserveItem(item.ItemID)
+		s.m.setItemStatus(item, ItemDamaged)
+	case ItemAvailable:
+		s.m.setItemStatus(item, ItemDamaged)
+	}
+	return memoryItemToProto(item), nil
+}
+
+// the copy with the barcode, nil when there is none
+func (m *memoryDB) itemByBarcode(barcode string) *memoryItem {
+	for _, item := range m.items {
+		if item.Barcode == barcode {
+			return item
+		}
+	}
+	return nil
+}
+
+func (m *memoryDB) setItemStatus(item *memoryItem, itemStatus string) {
+	item.Status = itemStatus
+	item.UpdatedAt = time.Now().UTC()
+}
+
+// the policy of a role for a book in categoryID, the most specific one wins like in policyFor
+func (m *memoryDB) policyFor(role string, categoryID int) circulationPolicy {
+	policy, rank := defaultCirculationPolicy, 4
+	for _, candidate := range m.policies {
+		if (candidate.Role != role && candidate.Role != anyPolicyRole) || (candidate.CategoryID != categoryID && candidate.CategoryID != anyPolicyCategory) {
+			continue
+		}
+		candidateRank := 0
+		if candidate.CategoryID == anyPolicyCategory {
+			candidateRank += 2
+		}
+		if candidate.Role == anyPolicyRole {
+			candidateRank++
+		}
+		if candidateRank < rank {
+			policy, rank = *candidate, candidateRank
+		}
+	}
+	return policy
+}
+
+// the policy of a role for a book, the book must exist
+func (m *memoryDB) policyForBook(role string, bookID int) (circulationPolicy, error) {
+	book, ok := m.books[bookID]
+	if !ok {
+		return circulationPolicy{}, errBookNotFound
+	}
+	return m.policyFor(role, book.CategoryID), nil
+}
+
+// true when a policy of categoryID counts a loan or hold of the book
+func (m *memoryDB) countsFor(bookID int, categoryID int) bool {
+	if categoryID == anyPolicyCategory {
+		return true
+	}
+	book, ok := m.books[bookID]
+	return ok && book.CategoryID == categoryID
+}
+
+// refuses a new borrow when the user already has the policy's maximum of loans out
+func (m *memoryDB) checkLoanLimit(userID int, policy circulationPolicy) error {
+	loans := 0
+	for _, borrow := range m.borrows {
+		if borrow.UserID == userID && !borrow.Returned && m.countsFor(borrow.BookID, policy.CategoryID) {
+			loans++
+		}
+	}
+	if loans >= policy.MaxLoans {
+		return errLoanLimit
+	}
+	return nil
+}
+
+// refuses a new hold when the user already has the policy's maximum of active holds
+func (m *memoryDB) checkHoldLimit(userID int, policy circulationPolicy) error {
+	holds := 0
+	for _, hold := range m.holds {
+		if hold.UserID == userID && isActiveHold(hold) && m.countsFor(hold.BookID, policy.CategoryID) {
+			holds++
+		}
+	}
+	if holds >= policy.MaxHolds {
+		return errHoldLimit
+	}
+	return nil
+}
+
+// the calendar of a branch like loadCalendar: its own hours override the defaults of branch 0 per weekday,
+// and both its holidays and the ones of every branch apply
+func (m *memoryDB) calendar(branchID int) *libraryCalendar {
+	calendar := &libraryCalendar{closedWeekdays: map[time.Weekday]bool{}, holidays: map[string]bool{}}
+	for weekday := 0; weekday < 7; weekday++ {
+		if hours, _, ok := m.hoursInEffect(branchID, weekday); ok {
+			calendar.closedWeekdays[time.Weekday(weekday)] = hours.Closed
+		}
+	}
+	for _, holiday := range m.holidays {
+		if holiday.BranchID == 0 || holiday.BranchID == branchID {
+			calendar.holidays[holiday.Date] = true
+		}
+	}
+	return calendar
+}
+
+// the hours of a weekday at the branch and the branch they are set for, its own or the defaults
+func (m *memoryDB) hoursInEffect(branchID int, weekday int) (*memoryHours, int, bool) {
+	if hours, ok := m.openingHours[memoryWeekday{BranchID: branchID, Weekday: weekday}]; ok {
+		return hours, branchID, true
+	}
+	hours, ok := m.openingHours[memoryWeekday{BranchID: 0, Weekday: weekday}]
+	return hours, 0, ok
+}
+
+func isActiveHold(hold *memoryHold) bool {
+	return hold.Status == HoldWaiting || hold.Status == HoldInTransit || hold.Status == HoldReady
+}
+
+// the active hold of the user on the book, nil when there is none
+func (m *memoryDB) activeHold(bookID int, userID int) *memoryHold {
+	for _, hold := range m.holds {
+		if hold.BookID == bookID && hold.UserID == userID && isActiveHold(hold) {
+			return hold
+		}
+	}
+	return nil
+}
+
+// puts the copy on the hold shelf for an active hold, false when the hold is no longer active
+func (m *memoryDB) readyHold(hold *memoryHold, itemID int) bool {
+	if hold.Status != HoldWaiting && hold.Status != HoldInTransit {
+		return false
+	}
+	now := time.Now().UTC()
+	hold.Status = HoldReady
+	hold.ReadyAt = now
+	hold.PickupExpiresAt = now.Add(holdPickupWindow)
+	hold.ItemID = itemID
+	return true
+}
+
+// reserves the copy for the oldest waiting hold of its book and returns the copy's next status
+// like reserveForNextHold, empty when nobody is waiting
+func (m *memoryDB) reserveForNextHold(item *memoryItem) string {
+	var next *memoryHold
+	for _, holdID := range sortedIDs(m.holds) {
+		hold := m.holds[holdID]
+		if hold.BookID == item.BookID && hold.Status == HoldWaiting && (next == nil || hold.PlacedAt.Before(next.PlacedAt)) {
+			next = hold
+		}
+	}
+	if next == nil {
+		return ""
+	}
+	if next.PickupBranchID != 0 && item.BranchID != 0 && next.PickupBranchID != item.BranchID {
+		m.routeToHold(next, item.ItemID, item.BranchID, next.PickupBranchID)
+		return ItemInTransit
+	}
+	m.readyHold(next, item.ItemID)
+	return ItemOnHold
+}
+
+// sends the copy to the pickup branch of the hold, the hold waits in transit until the copy is received
+func (m *memoryDB) routeToHold(hold *memoryHold, itemID int, fromBranchID int, toBranchID int) {
+	hold.Status = HoldInTransit
+	hold.ItemID = itemID
+	m.createTransfer(itemID, fromBranchID, toBranchID, hold.HoldID)
+}
+
+// opens a transfer of the copy, the caller sets the copy in_transit
+func (m *memoryDB) createTransfer(itemID int, fromBranchID int, toBranchID int, holdID int) *memoryTransfer {
+	transfer := &memoryTransfer{
+		TransferID:   m.nextID("transfers"),
+		ItemID:       itemID,
+		FromBranchID: fromBranchID,
+		ToBranchID:   toBranchID,
+		HoldID:       holdID,
+		Status:       TransferRequested,
+		RequestedAt:  time.Now().UTC(),
+	}
+	m.transfers[transfer.TransferID] = transfer
+	return transfer
+}
+
+// cancels the open transfers of a copy that left circulation
+func (m *memoryDB) cancelTransfers(itemID int) {
+	for _, transfer := range m.transfers {
+		if transfer.ItemID == itemID && (transfer.Status == TransferRequested || transfer.Status == TransferInTransit) {
+			transfer.Status = TransferCancelled
+		}
+	}
+}
+
+// sends the hold a copy was reserved or in transit for back to the waiting queue
+func (m *memoryDB) unreserveItem(itemID int) {
+	for _, hold := range m.holds {
+		if hold.ItemID == itemID && (hold.Status == HoldReady || hold.Status == HoldInTransit) {
+			hold.Status = HoldWaiting
+			hold.ReadyAt, hold.PickupExpiresAt = time.Time{}, time.Time{}
+			hold.ItemID = 0
+		}
+	}
+}
+
+// expires ready holds of the book past their pickup time, their copies go to the next hold or back on the shelf
+func (m *memoryDB) expireHolds(bookID int) {
+	now := time.Now().UTC()
+	for _, holdID := range sortedIDs(m.holds) {
+		hold := m.holds[holdID]
+		if hold.BookID == bookID && hold.Status == HoldReady && hold.PickupExpiresAt.Before(now) {
+			hold.Status = HoldExpired
+			hold.ClosedAt = now
+			m.putBackItem(hold.ItemID)
+		}
+	}
+}
+
+// puts a copy back: reserved for the next hold in the queue if there is one (sent to its pickup branch
+// when that is another branch), on the shelf otherwise. a copy marked damaged leaves circulation instead
+func (m *memoryDB) putBackCopy(item *memoryItem) {
+	next := ItemAvailable
+	if item.Condition == ConditionDamaged {
+		next = ItemDamaged
+	} else if reserved := m.reserveForNextHold(item); reserved != "" {
+		next = reserved
+	}
+	m.setItemStatus(item, next)
+}
+
+// puts back the copy of a borrow or hold, if it has one and it still exists
+func (m *memoryDB) putBackItem(itemID int) {
+	if item, ok := m.items[itemID]; ok {
+		m.putBackCopy(item)
+	}
+}
+
+// takes any copy of the book off the shelf, errBookUnavailable when none is left
+func (m *memoryDB) takeCopy(bookID int) (int, error) {
+	for _, itemID := range sortedIDs(m.items) {
+		if item := m.items[itemID]; item.BookID == bookID && item.Status == ItemAvailable {
+			m.setItemStatus(item, ItemOnLoan)
+			return itemID, nil
+		}
+	}
+	return 0, errBookUnavailable
+}
+
+// writes the fine of a late return to the ledger by the calendar of the loan's branch, returns the amount charged
+func (m *memoryDB) chargeOverdueFine(borrow *memoryBorrow, returnedDate time.Time) int {
+	late := m.calendar(borrow.BranchID).openDaysLate(borrow.ReturnDate, returnedDate)
+	amount := fineAmount(late)
+	if amount == 0 {
+		return 0
+	}
+	fine := &memoryFine{
+		FineID:      m.nextID("fines"),
+		BorrowingID: borrow.BorrowingID,
+		BookID:      borrow.BookID,
+		UserID:      borrow.UserID,
+		DaysLate:    late,
+		AmountCents: amount,
+		Status:      FineOutstanding,
+		CreatedAt:   time.Now().UTC(),
+	}
+	m.fines[fine.FineID] = fine
+	return amount
+}
+
+type memoryBorrowStore struct {
+	m *memoryDB
+}
+
+// borrows the copy with the barcode like borrowItem: under the borrower's circulation policy,
+// a copy on the hold shelf only for the patron it is reserved for, due on a day the branch is open
+func (s *memoryBorrowStore) Create(ctx context.Context, barcode string, userID int, branchID int, role string, borrowedDate time.Time) (int, time.Time, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if _, ok := s.m.branches[branchID]; branchID != 0 && !ok {
+		return 0, time.Time{}, errBranchNotFound
+	}
+	item := s.m.itemByBarcode(barcode)
+	if item == nil {
+		return 0, time.Time{}, errItemNotFound
+	}
+	policy, err := s.m.policyForBook(role, item.BookID)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	if err := s.m.checkLoanLimit(userID, policy); err != nil {
+		return 0, time.Time{}, err
+	}
+	s.m.expireHolds(item.BookID)
+
+	// the copy has to be at the desk it is checked out from
+	if branchID == 0 {
+		branchID = item.BranchID
+	} else if item.BranchID != 0 && item.BranchID != branchID {
+		return 0, time.Time{}, errItemElsewhere
+	}
+	hold := s.m.activeHold(item.BookID, userID)
+	reservedItemID := 0
+	if hold != nil && hold.Status == HoldReady {
+		reservedItemID = hold.ItemID
+	}
+	switch item.Status {
+	case ItemOnHold:
+		if item.ItemID != reservedItemID {
+			return 0, time.Time{}, errItemReserved
+		}
+	case ItemAvailable:
+	default:
+		return 0, time.Time{}, errItemUnavailable
+	}
+
+	// a copy still in transit for the hold goes to the next hold once it is received
+	if hold != nil {
+		hold.Status = HoldFulfilled
+		hold.ClosedAt = time.Now().UTC()
+	}
+	s.m.setItemStatus(item, ItemOnLoan)
+	// the patron took another copy off the shelf, the one reserved for them goes to the next hold
+	if reservedItemID != 0 && reservedItemID != item.ItemID {
+		s.m.putBackItem(reservedItemID)
+	}
+
+	borrow := &memoryBorrow{
+		BorrowingID:     s.m.nextID("borrowing"),
+		BookID:          item.BookID,
+		ItemID:          item.ItemID,
+		UserID:          userID,
+		BranchID:        branchID,
+		BorrowedDate:    borrowedDate,
+		ReturnDate:      s.m.calendar(branchID).nextOpenDay(borrowedDate.AddDate(0, 0, policy.LoanDays)),
+		LoanDays:        policy.LoanDays,
+		RenewalsAllowed: policy.Renewals,
+	}
+	s.m.borrows[borrow.BorrowingID] = borrow
+	return borrow.BorrowingID, borrow.ReturnDate, nil
+}
+
+func (s *memoryBorrowStore) Return(ctx context.Context, borrowingID int, branchID int, returnedDate time.Time) (int, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if _, ok := s.m.branches[branchID]; branchID != 0 && !ok {
+		return 0, errBranchNotFound
+	}
+	borrow, ok := s.m.borrows[borrowingID]
+	if !ok || borrow.Returned {
+		return 0, errBorrowNotFound
+	}
+	borrow.Returned = true
+	borrow.ReturnedDate = returnedDate
+	borrow.ReturnBranchID = branchID
+	fine := s.m.chargeOverdueFine(borrow, returnedDate)
+
+	// the copy stays at the branch it is returned to
+	if item, ok := s.m.items[borrow.ItemID]; ok && branchID != 0 {
+		item.BranchID = branchID
+	}
+	s.m.putBackItem(borrow.ItemID)
+	return fine, nil
+}
+
+func (s *memoryBorrowStore) OutstandingFines(ctx context.Context, userID int) (int, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	balance := 0
+	for _, fine := range s.m.fines {
+		if fine.UserID == userID && fine.Status == FineOutstanding {
+			balance += fine.AmountCents - fine.PaidCents
+		}
+	}
+	return balance, nil
+}
+
+func (s *memoryBorrowStore) List(ctx context.Context, filter borrowFilter) ([]*proto.BorrowOrReturnMin, error) {
+	var start, end time.Time
+	if filter.UserID == 0 && (filter.StartDate != "" || filter.EndDate != "") {
+		var err error
+		if start, err = time.Parse("2006-01-02", filter.StartDate); err != nil {
+			return nil, err
+		}
+		if end, err = time.Parse("2006-01-02", filter.EndDate); err != nil {
+			return nil, err
+		}
+	}
+
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	var borrowOrReturnMins []*proto.BorrowOrReturnMin
+	for _, borrowingID := range sortedIDs(s.m.borrows) {
+		borrow := s.m.borrows[borrowingID]
+		if borrow.Returned != filter.Returned {
+			continue
+		}
+		switch {
+		case filter.UserID != 0:
+			if borrow.UserID != filter.UserID {
+				continue
+			}
+		case filter.StartDate != "" || filter.EndDate != "":
+			if borrow.BorrowedDate.Before(start) || borrow.BorrowedDate.After(end) {
+				continue
+			}
+		default:
+			if borrowingID < filter.MinID || borrowingID > filter.MaxID {
+				continue
+			}
+		}
+		borrowOrReturnMins = append(borrowOrReturnMins, &proto.BorrowOrReturnMin{
+			BorrowingId:  int32(borrowingID),
+			BookId:       int32(borrow.BookID),
+			UserId:       int32(borrow.UserID),
+			BorrowedDate: borrow.BorrowedDate.Format(time.RFC3339),
+		})
+	}
+	return borrowOrReturnMins, nil
+}
+
+// days the branch of the loan was closed do not count as late
+func (s *memoryBorrowStore) Overdue(ctx context.Context, asOf time.Time, start *time.Time, end *time.Time) ([]overdueBorrow, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	var overdues []overdueBorrow
+	calendars := map[int]*libraryCalendar{}
+	for _, borrow := range s.m.borrows {
+		if !borrow.ReturnDate.Before(asOf) || borrow.BorrowedDate.After(asOf) || (borrow.Returned && !borrow.ReturnedDate.After(asOf)) {
+			continue
+		}
+		if (start != nil && borrow.ReturnDate.Before(*start)) || (end != nil && !borrow.ReturnDate.Before(*end)) {
+			continue
+		}
+		if calendars[borrow.BranchID] == nil {
+			calendars[borrow.BranchID] = s.m.calendar(borrow.BranchID)
+		}
+		overdues = append(overdues, overdueBorrow{
+			BorrowingID:  borrow.BorrowingID,
+			BookID:       borrow.BookID,
+			UserID:       borrow.UserID,
+			BranchID:     borrow.BranchID,
+			BorrowedDate: borrow.BorrowedDate,
+			ReturnDate:   borrow.ReturnDate,
+			DaysLate:     calendars[borrow.BranchID].openDaysLate(borrow.ReturnDate, asOf),
+		})
+	}
+	sort.Slice(overdues, func(i, j int) bool {
+		if !overdues[i].ReturnDate.Equal(overdues[j].ReturnDate) {
+			return overdues[i].ReturnDate.Before(overdues[j].ReturnDate)
+		}
+		return overdues[i].BorrowingID < overdues[j].BorrowingID
+	})
+	return overdues, nil
+}
+
+func (s *memoryBorrowStore) StillBorrows(ctx context.Context, userID int) (bool, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	for _, borrow := range s.m.borrows {
+		if borrow.UserID == userID && !borrow.Returned {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *memoryBorrowStore) Update(ctx context.Context, update models.UpdateBorrow, returned bool) error {
+	borrowedDate, err := time.Parse("2006-01-02", update.NewBorrowedDate)
+	if err != nil {
+		return err
+	}
+	returnDate, err := time.Parse("2006-01-02", update.NewReturnDate)
+	if err != nil {
+		return err
+	}
+
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	borrow, ok := s.m.borrows[update.BorrowingID]
+	if !ok {
+		return errBorrowNotFound
+	}
+
+	// the same copy stays out when only dates or the borrower change
+	itemID := borrow.ItemID
+	keepItem := !borrow.Returned && !returned && borrow.BookID == update.NewBookID
+	if !returned && !keepItem {
+		if itemID, err = s.m.takeCopy(update.NewBookID); err != nil {
+			return err
+		}
+	}
+	if !borrow.Returned && !keepItem {
+		s.m.putBackItem(borrow.ItemID)
+	}
+
+	borrow.BookID = update.NewBookID
+	borrow.ItemID = itemID
+	borrow.UserID = update.NewUserID
+	borrow.BorrowedDate = borrowedDate
+	borrow.ReturnDate = returnDate
+	borrow.Returned = returned
+	return nil
+}
+
+func (s *memoryBorrowStore) Delete(ctx context.Context, borrowingID int) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	borrow, ok := s.m.borrows[borrowingID]
+	if !ok {
+		return errBorrowNotFound
+	}
+	delete(s.m.borrows, borrowingID)
+	if !borrow.Returned {
+		s.m.putBackItem(borrow.ItemID)
+	}
+	return nil
+}
+
+// extends a borrow by one loan period like renewBorrow, checkAccess sees the borrower before anything changes
+func (s *memoryBorrowStore) Renew(ctx context.Context, borrowingID int, checkAccess func(userID int) error) (*proto.RenewedBorrow, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	borrow, ok := s.m.borrows[borrowingID]
+	if !ok || borrow.Returned {
+		return nil, errBorrowNotFound
+	}
+	if err := checkAccess(borrow.UserID); err != nil {
+		return nil, err
+	}
+
+	if borrow.RenewalCount >= borrow.RenewalsAllowed {
+		return nil, errRenewalLimit
+	}
+	if time.Now().After(borrow.ReturnDate.Add(renewalOverdueGrace)) {
+		return nil, errRenewalOverdue
+	}
+	// patrons in the queue get the copy back on time, also while a copy is travelling to their branch
+	for _, hold := range s.m.holds {
+		if hold.BookID == borrow.BookID && (hold.Status == HoldWaiting || hold.Status == HoldInTransit) {
+			return nil, errRenewalHolds
+		}
+	}
+
+	borrow.ReturnDate = s.m.calendar(borrow.BranchID).nextOpenDay(borrow.ReturnDate.AddDate(0, 0, borrow.LoanDays))
+	borrow.RenewalCount++
+	borrow.LastRenewedAt = time.Now().UTC()
+	return &proto.RenewedBorrow{
+		BorrowingId:  int32(borrowingID),
+		ReturnDate:   borrow.ReturnDate.Format("2006-01-02 15:04:05"),
+		RenewalCount: int32(borrow.RenewalCount),
+		RenewalsLeft: int32(borrow.RenewalsAllowed - borrow.RenewalCount),
+	}, nil
+}
+
+func memoryFineToProto(fine *memoryFine) *proto.Fine {
+	return &proto.Fine{
+		FineId:      int32(fine.FineID),
+		BorrowingId: int32(fine.BorrowingID),
+		BookId:      int32(fine.BookID),
+		UserId:      int32(fine.UserID),
+		DaysLate:    int32(fine.DaysLate),
+		AmountCents: int32(fine.AmountCents),
+		PaidCents:   int32(fine.PaidCents),
+		Status:      fine.Status,
+		Note:        fine.Note,
+		CreatedAt:   fine.CreatedAt.Format("2006-01-02 15:04:05"),
+		ClosedAt:    formatOptionalTime(fine.ClosedAt),
+	}
+}
+
+func (s *memoryBorrowStore) ListFines(ctx context.Context, userID int) ([]*proto.Fine, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	var fines []*proto.Fine
+	for _, fineID := range sortedIDs(s.m.fines) {
+		if fine := s.m.fines[fineID]; fine.UserID == userID {
+			fines = append(fines, memoryFineToProto(fine))
+		}
+	}
+	return fines, nil
+}
+
+// records a payment on an outstanding fine, partial payments keep it outstanding
+func (s *memoryBorrowStore) PayFine(ctx context.Context, fineID int, amountCents int) (*proto.Fine, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	fine, ok := s.m.fines[fineID]
+	if !ok {
+		return nil, errFineNotFound
+	}
+	if fine.Status != FineOutstanding {
+		return nil, status.Errorf(codes.FailedPrecondition, "fine is already %s", fine.Status)
+	}
+	if owed := fine.AmountCents - fine.PaidCents; amountCents > owed {
+		return nil, status.Errorf(codes.InvalidArgument, "payment exceeds the %d cents still owed", owed)
+	}
+	fine.PaidCents += amountCents
+	if fine.PaidCents == fine.AmountCents {
+		fine.Status = FinePaid
+		fine.ClosedAt = time.Now().UTC()
+	}
+	return memoryFineToProto(fine), nil
+}
+
+func (s *memoryBorrowStore) WaiveFine(ctx context.Context, fineID int, note string) (*proto.Fine, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	fine, ok := s.m.fines[fineID]
+	if !ok {
+		return nil, errFineNotFound
+	}
+	if fine.Status != FineOutstanding {
+		return nil, status.Errorf(codes.FailedPrecondition, "fine is already %s", fine.Status)
+	}
+	fine.Status = FineWaived
+	fine.Note = note
+	fine.ClosedAt = time.Now().UTC()
+	return memoryFineToProto(fine), nil
+}
+
+type memoryHoldStore struct {
+	m *memoryDB
+}
+
+// queues the user for the book like placeHold, a copy on the shelf of another branch is sent to the pickup branch right away
+func (s *memoryHoldStore) Place(ctx context.Context, bookID int, userID int, pickupBranchID int, role string) (*proto.Hold, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if _, ok := s.m.branches[pickupBranchID]; pickupBranchID != 0 && !ok {
+		return nil, errBranchNotFound
+	}
+	policy, err := s.m.policyForBook(role, bookID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.m.checkHoldLimit(userID, policy); err != nil {
+		return nil, err
+	}
+	s.m.expireHolds(bookID)
+
+	// a copy on the shelf at the pickup branch, at any branch without one, is borrowed instead
+	var elsewhere *memoryItem
+	for _, itemID := range sortedIDs(s.m.items) {
+		item := s.m.items[itemID]
+		if item.BookID != bookID || item.Status != ItemAvailable {
+			continue
+		}
+		if pickupBranchID == 0 || item.BranchID == pickupBranchID {
+			return nil, status.Error(codes.FailedPrecondition, "book is available, borrow it instead")
+		}
+		if elsewhere == nil && item.BranchID != 0 {
+			elsewhere = item
+		}
+	}
+	for _, borrow := range s.m.borrows {
+		if borrow.BookID == bookID && borrow.UserID == userID && !borrow.Returned {
+			return nil, status.Error(codes.FailedPrecondition, "user already borrows this book")
+		}
+	}
+	if s.m.activeHold(bookID, userID) != nil {
+		return nil, status.Error(codes.AlreadyExists, "user already holds this book")
+	}
+
+	hold := &memoryHold{
+		HoldID:         s.m.nextID("holds"),
+		BookID:         bookID,
+		UserID:         userID,
+		Status:         HoldWaiting,
+		PickupBranchID: pickupBranchID,
+		PlacedAt:       time.Now().UTC(),
+	}
+	s.m.holds[hold.HoldID] = hold
+	placed := &proto.Hold{
+		HoldId:         int32(hold.HoldID),
+		BookId:         int32(bookID),
+		UserId:         int32(userID),
+		PickupBranchId: int32(pickupBranchID),
+		PlacedAt:       hold.PlacedAt.Format("2006-01-02 15:04:05"),
+	}
+
+	// a copy on the shelf of another branch is sent over right away
+	if elsewhere != nil {
+		s.m.routeToHold(hold, elsewhere.ItemID, elsewhere.BranchID, pickupBranchID)
+		s.m.setItemStatus(elsewhere, ItemInTransit)
+		placed.Status = HoldInTransit
+		placed.Barcode = elsewhere.Barcode
+		return placed, nil
+	}
+
+	for _, other := range s.m.holds {
+		if other.BookID == bookID && other.Status == HoldWaiting {
+			placed.Position++
+		}
+	}
+	placed.Status = HoldWaiting
+	return placed, nil
+}
+
+// cancels an active hold like cancelHold, the reserved copy goes to the next patron, a copy in transit once it is received
+func (s *memoryHoldStore) Cancel(ctx context.Context, holdID int, checkAccess func(userID int) error) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	hold, ok := s.m.holds[holdID]
+	if !ok {
+		return errHoldNotFound
+	}
+	if err := checkAccess(hold.UserID); err != nil {
+		return err
+	}
+	if !isActiveHold(hold) {
+		return status.Error(codes.FailedPrecondition, "hold is no longer active")
+	}
+	previous := hold.Status
+	hold.Status = HoldCancelled
+	hold.ClosedAt = time.Now().UTC()
+	if previous == HoldReady {
+		s.m.putBackItem(hold.ItemID)
+	}
+	return nil
+}
+
+func (s *memoryHoldStore) List(ctx context.Context, bookID int, userID int) ([]*proto.Hold, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	var active []*memoryHold
+	for _, hold := range s.m.holds {
+		if isActiveHold(hold) && (bookID == 0 || hold.BookID == bookID) && (userID == 0 || hold.UserID == userID) {
+			active = append(active, hold)
+		}
+	}
+	// by book, then in queue order
+	queued := func(a, b *memoryHold) bool {
+		if !a.PlacedAt.Equal(b.PlacedAt) {
+			return a.PlacedAt.Before(b.PlacedAt)
+		}
+		return a.HoldID < b.HoldID
+	}
+	sort.Slice(active, func(i, j int) bool {
+		if active[i].BookID != active[j].BookID {
+			return active[i].BookID < active[j].BookID
+		}
+		return queued(active[i], active[j])
+	})
+
+	var holds []*proto.Hold
+	now := time.Now().UTC()
+	for _, hold := range active {
+		listed := &proto.Hold{
+			HoldId:          int32(hold.HoldID),
+			BookId:          int32(hold.BookID),
+			UserId:          int32(hold.UserID),
+			Status:          hold.Status,
+			PlacedAt:        hold.PlacedAt.Format("2006-01-02 15:04:05"),
+			ReadyAt:         formatOptionalTime(hold.ReadyAt),
+			PickupExpiresAt: formatOptionalTime(hold.PickupExpiresAt),
+			PickupBranchId:  int32(hold.PickupBranchID),
+		}
+		if item, ok := s.m.items[hold.ItemID]; ok {
+			listed.Barcode = item.Barcode
+		}
+		if hold.Status == HoldWaiting {
+			for _, other := range s.m.holds {
+				if other.BookID == hold.BookID && other.Status == HoldWaiting && !queued(hold, other) {
+					listed.Position++
+				}
+			}
+		}
+		// not swept yet, the next borrow or hold on the book expires it
+		if hold.Status == HoldReady && now.After(hold.PickupExpiresAt) {
+			listed.Status = HoldExpired
+		}
+		holds = append(holds, listed)
+	}
+	return holds, nil
+}
+
+type memoryBranchStore struct {
+	m *memoryDB
+}
+
+func memoryBranchToProto(branch *memoryBranch) *proto.Branch {
+	return &proto.Branch{
+		BranchId:  int32(branch.BranchID),
+		Name:      branch.Name,
+		Address:   branch.Address,
+		CreatedAt: branch.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (s *memoryBranchStore) Create(ctx context.Context, name string, address string) (*proto.Branch, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	for _, branch := range s.m.branches {
+		if branch.Name == name {
+			return nil, errDuplicate
+		}
+	}
+	branch := &memoryBranch{BranchID: s.m.nextID("branches"), Name: name, Address: address, CreatedAt: time.Now().UTC()}
+	s.m.branches[branch.BranchID] = branch
+	return memoryBranchToProto(branch), nil
+}
+
+func (s *memoryBranchStore) List(ctx context.Context) ([]*proto.Branch, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	var branches []*proto.Branch
+	for _, branchID := range sortedIDs(s.m.branches) {
+		branches = append(branches, memoryBranchToProto(s.m.branches[branchID]))
+	}
+	return branches, nil
+}
+
+func (s *memoryBranchStore) Exists(ctx context.Context, branchID int) (bool, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	_, ok := s.m.branches[branchID]
+	return ok, nil
+}
+
+func (m *memoryDB) transferToProto(transfer *memoryTransfer) *proto.Transfer {
+	item := m.items[transfer.ItemID]
+	return &proto.Transfer{
+		TransferId:   int32(transfer.TransferID),
+		ItemId:       int32(transfer.ItemID),
+		Barcode:      item.Barcode,
+		BookId:       int32(item.BookID),
+		FromBranchId: int32(transfer.FromBranchID),
+		ToBranchId:   int32(transfer.ToBranchID),
+		HoldId:       int32(transfer.HoldID),
+		Status:       transfer.Status,
+		RequestedAt:  transfer.RequestedAt.Format("2006-01-02 15:04:05"),
+		ShippedAt:    formatOptionalTime(transfer.ShippedAt),
+		ReceivedAt:   formatOptionalTime(transfer.ReceivedAt),
+	}
+}
+
+// sets a copy on the shelf aside for another branch like requestTransfer
+func (s *memoryBranchStore) RequestTransfer(ctx context.Context, barcode string, toBranchID int) (*proto.Transfer, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if _, ok := s.m.branches[toBranchID]; !ok {
+		return nil, errBranchNotFound
+	}
+	item := s.m.itemByBarcode(barcode)
+	if item == nil {
+		return nil, errItemNotFound
+	}
+	if item.Status != ItemAvailable {
+		return nil, errItemUnavailable
+	}
+	if item.BranchID == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "item is not assigned to a branch")
+	}
+	if item.BranchID == toBranchID {
+		return nil, status.Error(codes.FailedPrecondition, "item is already at that branch")
+	}
+	transfer := s.m.createTransfer(item.ItemID, item.BranchID, toBranchID, 0)
+	s.m.setItemStatus(item, ItemInTransit)
+	return s.m.transferToProto(transfer), nil
+}
+
+func (s *memoryBranchStore) ShipTransfer(ctx context.Context, transferID int) (*proto.Transfer, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	transfer, ok := s.m.transfers[transferID]
+	if !ok {
+		return nil, errTransferNotFound
+	}
+	if transfer.Status != TransferRequested {
+		return nil, status.Errorf(codes.FailedPrecondition, "transfer is %s, not %s", transfer.Status, TransferRequested)
+	}
+	transfer.Status = TransferInTransit
+	transfer.ShippedAt = time.Now().UTC()
+	return s.m.transferToProto(transfer), nil
+}
+
+// checks a shipped copy in like receiveTransfer: on the hold shelf when it was sent for a hold that still
+// waits for it, put back like a returned copy otherwise
+func (s *memoryBranchStore) ReceiveTransfer(ctx context.Context, transferID int) (*proto.Transfer, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	transfer, ok := s.m.transfers[transferID]
+	if !ok {
+		return nil, errTransferNotFound
+	}
+	if transfer.Status != TransferInTransit {
+		return nil, status.Errorf(codes.FailedPrecondition, "transfer is %s, not %s", transfer.Status, TransferInTransit)
+	}
+	transfer.Status = TransferReceived
+	transfer.ReceivedAt = time.Now().UTC()
+
+	item := s.m.items[transfer.ItemID]
+	item.BranchID = transfer.ToBranchID
+	ready := false
+	if hold, ok := s.m.holds[transfer.HoldID]; ok && item.Condition != ConditionDamaged {
+		ready = s.m.readyHold(hold, item.ItemID)
+	}
+	if ready {
+		s.m.setItemStatus(item, ItemOnHold)
+	} else {
+		// the hold was cancelled or fulfilled on the way, or the copy arrived damaged
+		s.m.unreserveItem(item.ItemID)
+		s.m.putBackCopy(item)
+	}
+	return s.m.transferToProto(transfer), nil
+}
+
+func (s *memoryBranchStore) ListTransfers(ctx context.Context, branchID int, transferStatus string) ([]*proto.Transfer, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	var transfers []*proto.Transfer
+	for _, transferID := range sortedIDs(s.m.transfers) {
+		transfer := s.m.transfers[transferID]
+		if (branchID == 0 || transfer.FromBranchID == branchID || transfer.ToBranchID == branchID) && (transferStatus == "" || transfer.Status == transferStatus) {
+			transfers = append(transfers, s.m.transferToProto(transfer))
+		}
+	}
+	return transfers, nil
+}
+
+type memoryCalendarStore struct {
+	m *memoryDB
+}
+
+func (s *memoryCalendarStore) Get(ctx context.Context, branchID int) (*proto.Calendar, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	calendar := &proto.Calendar{BranchId: int32(branchID)}
+	for weekday := 0; weekday < 7; weekday++ {
+		if hours, setFor, ok := s.m.hoursInEffect(branchID, weekday); ok {
+			calendar.Hours = append(calendar.Hours, &proto.OpeningHours{
+				BranchId: int32(setFor),
+				Weekday:  int32(weekday),
+				OpensAt:  hours.OpensAt,
+				ClosesAt: hours.ClosesAt,
+				Closed:   hours.Closed,
+			})
+		}
+	}
+	for _, holidayID := range sortedIDs(s.m.holidays) {
+		if holiday := s.m.holidays[holidayID]; holiday.BranchID == 0 || holiday.BranchID == branchID {
+			calendar.Holidays = append(calendar.Holidays, &proto.Holiday{
+				HolidayId: int32(holiday.HolidayID),
+				BranchId:  int32(holiday.BranchID),
+				Date:      holiday.Date,
+				Name:      holiday.Name,
+			})
+		}
+	}
+	sort.SliceStable(calendar.Holidays, func(i, j int) bool {
+		return calendar.Holidays[i].Date < calendar.Holidays[j].Date
+	})
+	return calendar, nil
+}
+
+func (s *memoryCalendarStore) SetHours(ctx context.Context, hours *proto.OpeningHours) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	s.m.openingHours[memoryWeekday{BranchID: int(hours.BranchId), Weekday: int(hours.Weekday)}] = &memoryHours{
+		OpensAt:  hours.OpensAt,
+		ClosesAt: hours.ClosesAt,
+		Closed:   hours.Closed,
+	}
+	return nil
+}
+
+func (s *memoryCalendarStore) AddHoliday(ctx context.Context, holiday *proto.Holiday) (int, error) {
+	date, err := time.Parse("2006-01-02", holiday.Date)
+	if err != nil {
+		return 0, err
+	}
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	for _, existing := range s.m.holidays {
+		if existing.BranchID == int(holiday.BranchId) && existing.Date == date.Format("2006-01-02") {
+			existing.Name = holiday.Name
+			return existing.HolidayID, nil
+		}
+	}
+	added := &memoryHoliday{HolidayID: s.m.nextID("holidays"), BranchID: int(holiday.BranchId), Date: date.Format("2006-01-02"), Name: holiday.Name}
+	s.m.holidays[added.HolidayID] = added
+	return added.HolidayID, nil
+}
+
+func (s *memoryCalendarStore) DeleteHoliday(ctx context.Context, holidayID int) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if _, ok := s.m.holidays[holidayID]; !ok {
+		return errNotFound
+	}
+	delete(s.m.holidays, holidayID)
+	return nil
+}
+
+type memoryPolicyStore struct {
+	m *memoryDB
+}
+
+func (s *memoryPolicyStore) List(ctx context.Context) ([]circulationPolicy, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	var policies []circulationPolicy
+	for _, policy := range s.m.policies {
+		policies = append(policies, *policy)
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		if policies[i].Role != policies[j].Role {
+			return policies[i].Role < policies[j].Role
+		}
+		return policies[i].CategoryID < policies[j].CategoryID
+	})
+	return policies, nil
+}
+
+func (s *memoryPolicyStore) Set(ctx context.Context, policy circulationPolicy) (int, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	for _, existing := range s.m.policies {
+		if existing.Role == policy.Role && existing.CategoryID == policy.CategoryID {
+			policy.PolicyID = existing.PolicyID
+			*existing = policy
+			return policy.PolicyID, nil
+		}
+	}
+	policy.PolicyID = s.m.nextID("circulation_policies")
+	s.m.policies[policy.PolicyID] = &policy
+	return policy.PolicyID, nil
+}
+
+func (s *memoryPolicyStore) Delete(ctx context.Context, policyID int) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if _, ok := s.m.policies[policyID]; !ok {
+		return errNotFound
+	}
+	delete(s.m.policies, policyID)
+	return nil
+}
+
+type memoryNotificationStore struct {
+	m *memoryDB
+}
+
+// true when the notice of the kind was already sent for the borrow and due date
+func (m *memoryDB) noticeSent(borrowingID int, kind string, dueDate time.Time) bool {
+	for _, notification := range m.notifications {
+		if notification.BorrowingID == borrowingID && notification.Kind == kind && notification.DueDate.Equal(dueDate) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *memoryNotificationStore) Due(ctx context.Context, now time.Time, dueSoonBefore time.Time) ([]dueNotice, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	var notices []dueNotice
+	for _, borrow := range s.m.borrows {
+		book, ok := s.m.books[borrow.BookID]
+		if borrow.Returned || !ok || !borrow.ReturnDate.Before(dueSoonBefore) {
+			continue
+		}
+		kind := NoticeDueSoon
+		if borrow.ReturnDate.Before(now) {
+			kind = NoticeOverdue
+		}
+		if s.m.noticeSent(borrow.BorrowingID, kind, borrow.ReturnDate) {
+			continue
+		}
+		notices = append(notices, dueNotice{borrowingID: borrow.BorrowingID, userID: borrow.UserID, kind: kind, title: book.Title, returnDate: borrow.ReturnDate})
+	}
+	sort.Slice(notices, func(i, j int) bool {
+		if !notices[i].returnDate.Equal(notices[j].returnDate) {
+			return notices[i].returnDate.Before(notices[j].returnDate)
+		}
+		return notices[i].borrowingID < notices[j].borrowingID
+	})
+	return notices, nil
+}
+
+func (s *memoryNotificationStore) Claim(ctx context.Context, notice dueNotice, recipient string, subject string, sentAt time.Time) (int, bool, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if s.m.noticeSent(notice.borrowingID, notice.kind, notice.returnDate) {
+		return 0, false, nil
+	}
+	notification := &memoryNotification{
+		NotificationID: s.m.nextID("notifications"),
+		UserID:         notice.userID,
+		BorrowingID:    notice.borrowingID,
+		Kind:           notice.kind,
+		DueDate:        notice.returnDate,
+		Recipient:      recipient,
+		Subject:        subject,
+		SentAt:         sentAt,
+	}
+	s.m.notifications[notification.NotificationID] = notification
+	return notification.NotificationID, true, nil
+}
+
+func (s *memoryNotificationStore) Release(ctx context.Context, notificationID int) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	delete(s.m.notifications, notificationID)
+	return nil
+}
+
+func (s *memoryNotificationStore) List(ctx context.Context, userID int) ([]*proto.Notification, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	// newest first
+	ids := sortedIDs(s.m.notifications)
+	sort.Slice(ids, func(i, j int) bool {
+		a, b := s.m.notifications[ids[i]], s.m.notifications[ids[j]]
+		if !a.SentAt.Equal(b.SentAt) {
+			return a.SentAt.After(b.SentAt)
+		}
+		return a.NotificationID > b.NotificationID
+	})
+	var notifications []*proto.Notification
+	for _, notificationID := range ids {
+		notification := s.m.notifications[notificationID]
+		if notification.UserID != userID {
+			continue
+		}
+		notifications = append(notifications, &proto.Notification{
+			NotificationId: int32(notification.NotificationID),
+			UserId:         int32(notification.UserID),
+			BorrowingId:    int32(notification.BorrowingID),
+			Kind:           notification.Kind,
+			DueDate:        notification.DueDate.Format("2006-01-02 15:04:05"),
+			Recipient:      notification.Recipient,
+			Subject:        notification.Subject,
+			SentAt:         notification.SentAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return notifications, nil
+}
+
+type memoryAPIKeyStore struct {
+	m *memoryDB
+}
+
+func (s *memoryAPIKeyStore) Create(ctx context.Context, key apiKeyRecord, keyHash string) (int, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if _, ok := s.m.users[key.UserID]; !ok {
+		return 0, errNotFound
+	}
+	for _, existing := range s.m.apiKeys {
+		if existing.KeyHash == keyHash {
+			return 0, errDuplicate
+		}
+	}
+	key.KeyID = s.m.nextID("api_keys")
+	key.Scopes = append([]string(nil), key.Scopes...)
+	s.m.apiKeys[key.KeyID] = &memoryAPIKey{apiKeyRecord: key, KeyHash: keyHash}
+	return key.KeyID, nil
+}
+
+// the key with the username and role of its owner, false when the owner is gone
+func (m *memoryDB) apiKeyWithOwner(key *memoryAPIKey) (apiKeyRecord, bool) {
+	user, ok := m.users[key.UserID]
+	if !ok {
+		return apiKeyRecord{}, false
+	}
+	record := key.apiKeyRecord
+	record.Username = user.Username
+	record.OwnerRole = user.Role
+	record.Scopes = append([]string(nil), key.Scopes...)
+	return record, true
+}
+
+func (s *memoryAPIKeyStore) Get(ctx context.Context, keyID int) (apiKeyRecord, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if key, ok := s.m.apiKeys[keyID]; ok {
+		if record, ok := s.m.apiKeyWithOwner(key); ok {
+			return record, nil
+		}
+	}
+	return apiKeyRecord{}, errNotFound
+}
+
+func (s *memoryAPIKeyStore) GetByHash(ctx context.Context, keyHash string) (apiKeyRecord, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	for _, key := range s.m.apiKeys {
+		if key.KeyHash != keyHash {
+			continue
+		}
+		if record, ok := s.m.apiKeyWithOwner(key); ok {
+			return record, nil
+		}
+	}
+	return apiKeyRecord{}, errNotFound
+}
+
+func (s *memoryAPIKeyStore) List(ctx context.Context, userID int) ([]apiKeyRecord, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	var keys []apiKeyRecord
+	for _, keyID := range sortedIDs(s.m.apiKeys) {
+		if key := s.m.apiKeys[keyID]; key.UserID == userID {
+			if record, ok := s.m.apiKeyWithOwner(key); ok {
+				keys = append(keys, record)
+			}
+		}
+	}
+	return keys, nil
+}
+
+func (s *memoryAPIKeyStore) Revoke(ctx context.Context, keyID int, at time.Time) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if key, ok := s.m.apiKeys[keyID]; ok && key.RevokedAt.IsZero() {
+		key.RevokedAt = at
+	}
+	return nil
+}
+
+func (s *memoryAPIKeyStore) Touch(ctx context.Context, keyID int, at time.Time) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if key, ok := s.m.apiKeys[keyID]; ok && key.LastUsedAt.Before(at.Add(-time.Minute)) {
+		key.LastUsedAt = at
+	}
+	return nil
+}
+
+type memoryPasswordResetStore struct {
+	m *memoryDB
+}
+
+func (s *memoryPasswordResetStore) Create(ctx context.Context, userID int, tokenHash string, expiresAt time.Time, createdAt time.Time) error {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	if _, ok := s.m.users[userID]; !ok {
+		return errNotFound
+	}
+	for _, reset := range s.m.passwordResets {
+		if reset.UserID == userID && reset.UsedAt.IsZero() {
+			reset.UsedAt = createdAt
+		}
+	}
+	reset := &memoryPasswordReset{ResetID: s.m.nextID("password_resets"), UserID: userID, TokenHash: tokenHash, ExpiresAt: expiresAt, CreatedAt: createdAt}
+	s.m.passwordResets[reset.ResetID] = reset
+	return nil
+}
+
+func (s *memoryPasswordResetStore) Use(ctx context.Context, tokenHash string, at time.Time, newHash func(user userCredentials) (string, error)) (userCredentials, error) {
+	s.m.mu.Lock()
+	defer s.m.mu.Unlock()
+	var reset *memoryPasswordReset
+	for _, candidate := range s.m.passwordResets {
+		if candidate.TokenHash == tokenHash {
+			reset = candidate
+		}
+	}
+	if reset == nil || !reset.UsedAt.IsZero() || at.After(reset.ExpiresAt) {
+		return userCredentials{}, errNotFound
+	}
+	user, ok := s.m.users[reset.UserID]
+	if !ok {
+		return userCredentials{}, errNotFound
+	}
+	credentials := memoryCredentials(user)
+
+	passwordHash, err := newHash(credentials)
+	if err != nil {
+		return credentials, err
+	}
+	reset.UsedAt = at
+	user.Password = passwordHash
+	user.PasswordChangedAt = at
+	user.UpdatedAt = at
+	// whoever knew the old password is locked out
+	for _, session := range s.m.sessions {
+		if session.UserID == user.UserID {
+			session.Revoked = true
+		}
+	}
+	return credentials, nil
+}
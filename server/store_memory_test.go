@@ -0,0 +1,262 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	"gogrpc-rpc-boiler/server/models"
+)
+
+// the in-memory stores need no database, these always run
+
+func TestMemoryStoresKeepUniqueConstraints(t *testing.T) {
+	m := newMemoryDB()
+	ctx := context.Background()
+
+	users := &memoryUserStore{m: m}
+	if _, err := users.Create(ctx, models.UserSensitive{Username: "alice", Email: "alice@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, user := range []models.UserSensitive{
+		{Username: "alice", Email: "other@example.com"},
+		{Username: "bob", Email: "alice@example.com"},
+	} {
+		if _, err := users.Create(ctx, user); err != errDuplicate {
+			t.Fatalf("user %s <%s>: got %v, want errDuplicate", user.Username, user.Email, err)
+		}
+	}
+
+	categories := &memoryCategoryStore{m: m}
+	for _, name := range []string{"fiction", "poetry"} {
+		if err := categories.Create(ctx, models.Category{Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := categories.Create(ctx, models.Category{Name: "fiction"}); err != errDuplicate {
+		t.Fatalf("second category fiction: got %v, want errDuplicate", err)
+	}
+	if err := categories.Update(ctx, models.UpdateCategory{CategoryID: 2, NewName: "fiction"}); err != errDuplicate {
+		t.Fatalf("rename to a taken name: got %v, want errDuplicate", err)
+	}
+	if err := categories.Update(ctx, models.UpdateCategory{CategoryID: 9, NewName: "drama"}); err != errNotFound {
+		t.Fatalf("update of a missing category: got %v, want errNotFound", err)
+	}
+
+	books := &memoryBookStore{m: m}
+	isbn := "9780000000001"
+	if err := books.Create(ctx, models.Book{Title: "first", ISBN: &isbn}); err != nil {
+		t.Fatal(err)
+	}
+	if err := books.Create(ctx, models.Book{Title: "second", ISBN: &isbn}); err != errDuplicate {
+		t.Fatalf("second book with ISBN %s: got %v, want errDuplicate", isbn, err)
+	}
+	if _, err := books.Get(ctx, 9); err != errNotFound {
+		t.Fatalf("get of a missing book: got %v, want errNotFound", err)
+	}
+}
+
+func TestMemoryBorrowsNeverOversell(t *testing.T) {
+	m := newMemoryDB()
+	ctx := context.Background()
+	books := &memoryBookStore{m: m}
+	borrows := &memoryBorrowStore{m: m}
+
+	const stock, borrowers = 3, 30
+	if _, err := (&memoryBranchStore{m: m}).Create(ctx, "main", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := books.Create(ctx, models.Book{Title: "memory test"}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < stock; i++ {
+		if _, err := books.AddItem(ctx, &proto.Item{BookId: 1, Barcode: fmt.Sprintf("M%d", i), Condition: ConditionGood, BranchId: 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var borrowed []int
+	for i := 0; i < borrowers; i++ {
+		wg.Add(1)
+		go func(userID int, barcode string) {
+			defer wg.Done()
+			borrowingID, _, err := borrows.Create(ctx, barcode, userID, 0, RoleMember, time.Now())
+			if err == errItemUnavailable {
+				return
+			} else if err != nil {
+				t.Errorf("borrow failed: %v", err)
+				return
+			}
+			mu.Lock()
+			borrowed = append(borrowed, borrowingID)
+			mu.Unlock()
+		}(i+1, fmt.Sprintf("M%d", i%stock))
+	}
+	wg.Wait()
+
+	book, err := books.Get(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(borrowed) != stock || book.AvailableStock != 0 {
+		t.Fatalf("got %d borrows and available_stock %d, want %d and 0", len(borrowed), book.AvailableStock, stock)
+	}
+	if err := borrows.Update(ctx, models.UpdateBorrow{BorrowingID: borrowed[0], NewBookID: 1, NewUserID: 99, NewBorrowedDate: "2024-01-01", NewReturnDate: "2024-01-15"}, false); err != nil {
+		t.Fatalf("edit keeping the copy: %v", err)
+	}
+
+	for _, borrowingID := range borrowed {
+		if _, err := borrows.Return(ctx, borrowingID, 0, time.Now()); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := borrows.Return(ctx, borrowingID, 0, time.Now()); err != errBorrowNotFound {
+			t.Fatalf("second return of %d: got %v, want errBorrowNotFound", borrowingID, err)
+		}
+	}
+	if book, _ = books.Get(ctx, 1); book.AvailableStock != stock || book.TotalStock != stock {
+		t.Fatalf("stock %d/%d after all returns, want %d/%d", book.AvailableStock, book.TotalStock, stock, stock)
+	}
+	// the edited borrow was due in January 2024, its return was charged
+	if fines, _ := borrows.OutstandingFines(ctx, 99); fines != fineCapPerItem {
+		t.Fatalf("fines of user 99 = %d, want the cap of %d", fines, fineCapPerItem)
+	}
+}
+
+func TestMemoryHoldIsFilledFromAnotherBranch(t *testing.T) {
+	m := newMemoryDB()
+	ctx := context.Background()
+	books := &memoryBookStore{m: m}
+	borrows := &memoryBorrowStore{m: m}
+	holds := &memoryHoldStore{m: m}
+	branches := &memoryBranchStore{m: m}
+
+	north, err := branches.Create(ctx, "north", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	south, err := branches.Create(ctx, "south", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := branches.Create(ctx, "north", ""); err != errDuplicate {
+		t.Fatalf("second branch north: got %v, want errDuplicate", err)
+	}
+	if err := books.Create(ctx, models.Book{Title: "travelling"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := books.AddItem(ctx, &proto.Item{BookId: 1, Barcode: "T1", Condition: ConditionGood, BranchId: north.BranchId}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := borrows.Create(ctx, "T1", 1, int(south.BranchId), RoleMember, time.Now()); err != errItemElsewhere {
+		t.Fatalf("borrow at south = %v, want %v", err, errItemElsewhere)
+	}
+	borrowingID, _, err := borrows.Create(ctx, "T1", 1, int(north.BranchId), RoleMember, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	hold, err := holds.Place(ctx, 1, 2, int(south.BranchId), RoleMember)
+	if err != nil {
+		t.Fatal(err)
+	}
+	anyone := func(userID int) error { return nil }
+	if _, err := borrows.Renew(ctx, borrowingID, anyone); err != errRenewalHolds {
+		t.Fatalf("renewal with a waiting hold = %v, want %v", err, errRenewalHolds)
+	}
+
+	// returned at north, the copy has to travel to south for the hold
+	if _, err := borrows.Return(ctx, borrowingID, int(north.BranchId), time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	transfers, err := branches.ListTransfers(ctx, 0, TransferRequested)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(transfers) != 1 || transfers[0].HoldId != hold.HoldId {
+		t.Fatalf("requested transfers = %v, want one for hold %d", transfers, hold.HoldId)
+	}
+	if listed, _ := holds.List(ctx, 1, 0); len(listed) != 1 || listed[0].Status != HoldInTransit || listed[0].Barcode != "T1" {
+		t.Fatalf("holds = %v, want hold %d in transit with T1", listed, hold.HoldId)
+	}
+	if _, _, err := borrows.Create(ctx, "T1", 3, int(north.BranchId), RoleMember, time.Now()); err != errItemUnavailable {
+		t.Fatalf("borrow in transit = %v, want %v", err, errItemUnavailable)
+	}
+
+	// receiving before shipping is refused
+	transferID := int(transfers[0].TransferId)
+	if _, err := branches.ReceiveTransfer(ctx, transferID); err == nil {
+		t.Fatal("received a transfer that was never shipped")
+	}
+	if _, err := branches.ShipTransfer(ctx, transferID); err != nil {
+		t.Fatal(err)
+	}
+	if transfer, err := branches.ReceiveTransfer(ctx, transferID); err != nil || transfer.Status != TransferReceived {
+		t.Fatalf("receive = %v, %v, want status %s", transfer, err, TransferReceived)
+	}
+	if listed, _ := holds.List(ctx, 1, 0); len(listed) != 1 || listed[0].Status != HoldReady {
+		t.Fatalf("holds = %v, want hold %d ready", listed, hold.HoldId)
+	}
+	if _, _, err := borrows.Create(ctx, "T1", 3, int(south.BranchId), RoleMember, time.Now()); err != errItemReserved {
+		t.Fatalf("borrow by another patron = %v, want %v", err, errItemReserved)
+	}
+	if _, _, err := borrows.Create(ctx, "T1", 2, int(south.BranchId), RoleMember, time.Now()); err != nil {
+		t.Fatalf("borrow at south = %v, want success", err)
+	}
+	if listed, _ := holds.List(ctx, 1, 0); len(listed) != 0 {
+		t.Fatalf("holds = %v, want none after pickup", listed)
+	}
+}
+
+func TestMemoryFinesArePaidAndWaived(t *testing.T) {
+	m := newMemoryDB()
+	ctx := context.Background()
+	books := &memoryBookStore{m: m}
+	borrows := &memoryBorrowStore{m: m}
+
+	if _, err := (&memoryBranchStore{m: m}).Create(ctx, "main", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := books.Create(ctx, models.Book{Title: "late"}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := books.AddItem(ctx, &proto.Item{BookId: 1, Barcode: fmt.Sprintf("L%d", i), Condition: ConditionGood, BranchId: 1}); err != nil {
+			t.Fatal(err)
+		}
+		borrowedDate := time.Now().AddDate(0, -2, 0)
+		borrowingID, _, err := borrows.Create(ctx, fmt.Sprintf("L%d", i), 1, 0, RoleMember, borrowedDate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := borrows.Return(ctx, borrowingID, 0, time.Now()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if owed, _ := borrows.OutstandingFines(ctx, 1); owed != 2*fineCapPerItem {
+		t.Fatalf("outstanding = %d, want %d", owed, 2*fineCapPerItem)
+	}
+
+	if _, err := borrows.PayFine(ctx, 1, fineCapPerItem+1); err == nil {
+		t.Fatal("paid more than the fine")
+	}
+	if fine, err := borrows.PayFine(ctx, 1, fineCapPerItem); err != nil || fine.Status != FinePaid || fine.ClosedAt == "" {
+		t.Fatalf("pay = %v, %v, want status %s", fine, err, FinePaid)
+	}
+	if fine, err := borrows.WaiveFine(ctx, 2, "waived by admin: test"); err != nil || fine.Status != FineWaived {
+		t.Fatalf("waive = %v, %v, want status %s", fine, err, FineWaived)
+	}
+	if _, err := borrows.WaiveFine(ctx, 2, "again"); err == nil {
+		t.Fatal("waived a fine twice")
+	}
+	if _, err := borrows.PayFine(ctx, 3, 1); err != errFineNotFound {
+		t.Fatalf("pay of a missing fine = %v, want %v", err, errFineNotFound)
+	}
+	if owed, _ := borrows.OutstandingFines(ctx, 1); owed != 0 {
+		t.Fatalf("outstanding after pay and waive = %d, want 0", owed)
+	}
+}
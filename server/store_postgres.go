@@ -0,0 +1,1279 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	"gogrpc-rpc-boiler/server/models"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// stores on the four Postgres databases, schema in server/db/migrations
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+// true when the query returns a row
+func rowExists(ctx context.Context, db *sql.DB, query string, args ...any) (bool, error) {
+	var scan int
+	err := db.QueryRowContext(ctx, query, args...).Scan(&scan)
+	if err == sql.ErrNoRows {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// errNotFound when the statement changed no row
+func checkAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errNotFound
+	}
+	return nil
+}
+
+type pgUserStore struct {
+	db *sql.DB
+}
+
+func (s *pgUserStore) Create(ctx context.Context, user models.UserSensitive) (int, error) {
+	var userID int
+	err := s.db.QueryRowContext(ctx, "INSERT INTO users (username, password_hash, first_name, last_name, email, role, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING user_id",
+		user.Username, user.Password, user.FirstName, user.LastName, user.Email, user.Role, user.CreatedAt, user.UpdatedAt).Scan(&userID)
+	if isUniqueViolation(err) {
+		return 0, errDuplicate
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to insert user: %v", err)
+	}
+	return userID, nil
+}
+
+func (s *pgUserStore) getCredentials(ctx context.Context, where string, arg any) (userCredentials, error) {
+	var user userCredentials
+	err := s.db.QueryRowContext(ctx, "SELECT user_id, username, password_hash, email, role, email_verified FROM users WHERE "+where+" = $1", arg).
+		Scan(&user.UserID, &user.Username, &user.PasswordHash, &user.Email, &user.Role, &user.EmailVerified)
+	if err == sql.ErrNoRows {
+		return user, errNotFound
+	} else if err != nil {
+		return user, fmt.Errorf("failed to get user: %v", err)
+	}
+	return user, nil
+}
+
+func (s *pgUserStore) GetCredentials(ctx context.Context, username string) (userCredentials, error) {
+	return s.getCredentials(ctx, "username", username)
+}
+
+func (s *pgUserStore) GetCredentialsByID(ctx context.Context, userID int) (userCredentials, error) {
+	return s.getCredentials(ctx, "user_id", userID)
+}
+
+func (s *pgUserStore) GetCredentialsByEmail(ctx context.Context, email string) (userCredentials, error) {
+	return s.getCredentials(ctx, "email", email)
+}
+
+func (s *pgUserStore) Get(ctx context.Context, userID int) (models.User, error) {
+	var user models.User
+	err := s.db.QueryRowContext(ctx, "SELECT user_id, username, first_name, last_name, email, role FROM users WHERE user_id = $1", userID).
+		Scan(&user.UserID, &user.Username, &user.FirstName, &user.LastName, &user.Email, &user.Role)
+	if err == sql.ErrNoRows {
+		return user, errNotFound
+	} else if err != nil {
+		return user, fmt.Errorf("failed to get user: %v", err)
+	}
+	return user, nil
+}
+
+func (s *pgUserStore) Exists(ctx context.Context, userID int) (bool, error) {
+	return rowExists(ctx, s.db, "SELECT 1 FROM users WHERE user_id = $1 LIMIT 1", userID)
+}
+
+func (s *pgUserStore) SetPassword(ctx context.Context, userID int, passwordHash string, changedAt time.Time) error {
+	return inTx(ctx, s.db, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, "UPDATE users SET password_hash = $1, password_changed_at = $2, updated_at = $2 WHERE user_id = $3", passwordHash, changedAt, userID)
+		if err != nil {
+			return fmt.Errorf("failed to update password: %v", err)
+		}
+		if err := checkAffected(res); err != nil {
+			return err
+		}
+		// tokens issued with the old password stop working
+		if err := revokeUserSessions(ctx, tx, userID); err != nil {
+			return fmt.Errorf("failed to revoke sessions: %v", err)
+		}
+		return nil
+	})
+}
+
+func (s *pgUserStore) Delete(ctx context.Context, userID int) error {
+	res, err := s.db.ExecContext(ctx, "DELETE FROM users WHERE user_id = $1", userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %v", err)
+	}
+	return checkAffected(res)
+}
+
+func (s *pgUserStore) RecordImpersonation(ctx context.Context, record impersonationRecord) error {
+	_, err := s.db.ExecContext(ctx, "INSERT INTO impersonation_audit (impersonator_id, impersonator_username, target_user_id, target_username, session_id, client_addr, expires_at, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		record.ImpersonatorID, record.ImpersonatorUsername, record.TargetUserID, record.TargetUsername, record.SessionID, record.ClientAddr, record.ExpiresAt, record.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to write impersonation audit record: %v", err)
+	}
+	return nil
+}
+
+func (s *pgUserStore) VerifyEmail(ctx context.Context, userID int, email string, verifiedAt time.Time) (bool, error) {
+	res, err := s.db.ExecContext(ctx, "UPDATE users SET email_verified = TRUE, email_verified_at = $1 WHERE user_id = $2 AND email = $3 AND email_verified = FALSE",
+		verifiedAt, userID, email)
+	if err != nil {
+		return false, fmt.Errorf("failed to verify email: %v", err)
+	}
+	if err := checkAffected(res); err != errNotFound {
+		return err == nil, err
+	}
+	exists, err := rowExists(ctx, s.db, "SELECT 1 FROM users WHERE user_id = $1 AND email = $2", userID, email)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user: %v", err)
+	}
+	if !exists {
+		return false, errNotFound
+	}
+	return false, nil
+}
+
+func (s *pgUserStore) Rehash(ctx context.Context, userID int, oldHash string, newHash string) error {
+	_, err := s.db.ExecContext(ctx, "UPDATE users SET password_hash = $1, updated_at = $2 WHERE user_id = $3 AND password_hash = $4",
+		newHash, time.Now().UTC(), userID, oldHash)
+	if err != nil {
+		return fmt.Errorf("failed to update password: %v", err)
+	}
+	return nil
+}
+
+type pgSessionStore struct {
+	db *sql.DB
+}
+
+func (s *pgSessionStore) Create(ctx context.Context, userID int, refreshTokenHash string, expiresAt time.Time, createdAt time.Time) (int, error) {
+	var sessionID int
+	err := s.db.QueryRowContext(ctx, "INSERT INTO sessions (user_id, refresh_token_hash, expires_at, created_at) VALUES ($1, $2, $3, $4) RETURNING session_id",
+		userID, refreshTokenHash, expiresAt, createdAt).Scan(&sessionID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create session: %v", err)
+	}
+	return sessionID, nil
+}
+
+func (s *pgSessionStore) Get(ctx context.Context, sessionID int) (sessionRecord, error) {
+	session := sessionRecord{SessionID: sessionID}
+	var revokedAt, passwordChangedAt sql.NullTime
+	err := s.db.QueryRowContext(ctx, "SELECT s.user_id, u.username, u.role, s.refresh_token_hash, s.expires_at, s.revoked_at, u.password_changed_at FROM sessions s JOIN users u ON u.user_id = s.user_id WHERE s.session_id = $1",
+		sessionID).Scan(&session.UserID, &session.Username, &session.Role, &session.RefreshTokenHash, &session.ExpiresAt, &revokedAt, &passwordChangedAt)
+	if err == sql.ErrNoRows {
+		return session, errNotFound
+	} else if err != nil {
+		return session, fmt.Errorf("failed to get session: %v", err)
+	}
+	session.Revoked = revokedAt.Valid
+	session.PasswordChangedAt = passwordChangedAt.Time
+	return session, nil
+}
+
+func (s *pgSessionStore) Rotate(ctx context.Context, sessionID int, oldHash string, newHash string, at time.Time) error {
+	res, err := s.db.ExecContext(ctx, "UPDATE sessions SET refresh_token_hash = $1, last_refreshed_at = $2 WHERE session_id = $3 AND refresh_token_hash = $4 AND revoked_at IS NULL AND expires_at > $2",
+		newHash, at, sessionID, oldHash)
+	if err != nil {
+		return fmt.Errorf("failed to rotate refresh token: %v", err)
+	}
+	return checkAffected(res)
+}
+
+func (s *pgSessionStore) Revoke(ctx context.Context, sessionID int, at time.Time) error {
+	_, err := s.db.ExecContext(ctx, "UPDATE sessions SET revoked_at = $1 WHERE session_id = $2 AND revoked_at IS NULL", at, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %v", err)
+	}
+	return nil
+}
+
+type pgLoginAttemptStore struct {
+	db *sql.DB
+}
+
+func (s *pgLoginAttemptStore) LockedUntil(ctx context.Context, key string) (time.Time, error) {
+	var lockedUntil sql.NullTime
+	err := s.db.QueryRowContext(ctx, "SELECT locked_until FROM login_attempts WHERE attempt_key = $1", key).Scan(&lockedUntil)
+	if err != nil && err != sql.ErrNoRows {
+		return time.Time{}, fmt.Errorf("failed to check login attempts: %v", err)
+	}
+	return lockedUntil.Time, nil
+}
+
+func (s *pgLoginAttemptStore) RecordFailure(ctx context.Context, key string, at time.Time, window time.Duration) (int, error) {
+	var failures int
+	err := s.db.QueryRowContext(ctx, `INSERT INTO login_attempts (attempt_key, failures, last_failure_at) VALUES ($1, 1, $2)
+        ON CONFLICT (attempt_key) DO UPDATE SET
+            failures = CASE WHEN login_attempts.last_failure_at < $3 THEN 1 ELSE login_attempts.failures + 1 END,
+            last_failure_at = $2
+        RETURNING failures`, key, at, at.Add(-window)).Scan(&failures)
+	if err != nil {
+		return 0, fmt.Errorf("failed to record login failure: %v", err)
+	}
+	return failures, nil
+}
+
+func (s *pgLoginAttemptStore) Lock(ctx context.Context, key string, until time.Time) error {
+	_, err := s.db.ExecContext(ctx, "UPDATE login_attempts SET locked_until = $1 WHERE attempt_key = $2", until, key)
+	if err != nil {
+		return fmt.Errorf("failed to lock out %s: %v", key, err)
+	}
+	return nil
+}
+
+func (s *pgLoginAttemptStore) Clear(ctx context.Context, key string) error {
+	_, err := s.db.ExecContext(ctx, "DELETE FROM login_attempts WHERE attempt_key = $1", key)
+	if err != nil {
+		return fmt.Errorf("failed to clear login failures: %v", err)
+	}
+	return nil
+}
+
+type pgAuthorStore struct {
+	db *sql.DB
+}
+
+func (s *pgAuthorStore) Create(ctx context.Context, author models.Author) error {
+	_, err := s.db.ExecContext(ctx, "INSERT INTO authors (name, birthdate, nationality, biography, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6)",
+		author.Name, author.Birthdate, author.Nationality, author.Biography, author.CreatedAt, author.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert author: %v", err)
+	}
+	return nil
+}
+
+func listAuthors(ctx context.Context, db *sql.DB, query string, args ...any) ([]*proto.AuthorMin, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authors: %v", err)
+	}
+	defer rows.Close()
+
+	var authorMins []*proto.AuthorMin
+	for rows.Next() {
+		var authorMin proto.AuthorMin
+		if err := rows.Scan(&authorMin.AuthorId, &authorMin.Name); err != nil {
+			return nil, fmt.Errorf("failed to scan author: %v", err)
+		}
+		authorMins = append(authorMins, &authorMin)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get authors: %v", err)
+	}
+	return authorMins, nil
+}
+
+func (s *pgAuthorStore) List(ctx context.Context, min int, max int) ([]*proto.AuthorMin, error) {
+	return listAuthors(ctx, s.db, "SELECT author_id, name FROM authors WHERE author_id BETWEEN $1 AND $2 ORDER BY author_id", min, max)
+}
+
+func (s *pgAuthorStore) Search(ctx context.Context, name string) ([]*proto.AuthorMin, error) {
+	return listAuthors(ctx, s.db, "SELECT author_id, name FROM authors WHERE name ILIKE $1 ORDER BY author_id", "%"+name+"%")
+}
+
+func (s *pgAuthorStore) Get(ctx context.Context, authorID int) (models.Author, error) {
+	var author models.Author
+	err := s.db.QueryRowContext(ctx, "SELECT name, birthdate, nationality, biography, created_at, updated_at FROM authors WHERE author_id = $1", authorID).
+		Scan(&author.Name, &author.Birthdate, &author.Nationality, &author.Biography, &author.CreatedAt, &author.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return author, errNotFound
+	} else if err != nil {
+		return author, fmt.Errorf("failed to get author: %v", err)
+	}
+	return author, nil
+}
+
+func (s *pgAuthorStore) Update(ctx context.Context, author models.UpdateAuthor) error {
+	res, err := s.db.ExecContext(ctx, "UPDATE authors SET name = $1, birthdate = $2, nationality = $3, biography = $4, updated_at = $5 WHERE author_id = $6",
+		author.NewName, author.NewBirthdate, author.NewNationality, author.NewBiography, author.UpdatedAt, author.AuthorID)
+	if err != nil {
+		return fmt.Errorf("failed to update author: %v", err)
+	}
+	return checkAffected(res)
+}
+
+func (s *pgAuthorStore) Delete(ctx context.Context, authorID int) error {
+	res, err := s.db.ExecContext(ctx, "DELETE FROM authors WHERE author_id = $1", authorID)
+	if err != nil {
+		return fmt.Errorf("failed to delete author: %v", err)
+	}
+	return checkAffected(res)
+}
+
+func (s *pgAuthorStore) Exists(ctx context.Context, authorID int) (bool, error) {
+	return rowExists(ctx, s.db, "SELECT 1 FROM authors WHERE author_id = $1 LIMIT 1", authorID)
+}
+
+type pgCategoryStore struct {
+	db *sql.DB
+}
+
+func (s *pgCategoryStore) Create(ctx context.Context, category models.Category) error {
+	_, err := s.db.ExecContext(ctx, "INSERT INTO categories (name, description, created_at, updated_at) VALUES ($1, $2, $3, $4)",
+		category.Name, category.Description, category.CreatedAt, category.UpdatedAt)
+	if isUniqueViolation(err) {
+		return errDuplicate
+	} else if err != nil {
+		return fmt.Errorf("failed to create category: %v", err)
+	}
+	return nil
+}
+
+func listCategories(ctx context.Context, db *sql.DB, query string, args ...any) ([]*proto.CategoryMin, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %v", err)
+	}
+	defer rows.Close()
+
+	var categoryMins []*proto.CategoryMin
+	for rows.Next() {
+		var categoryMin proto.CategoryMin
+		if err := rows.Scan(&categoryMin.CategoryId, &categoryMin.Name); err != nil {
+			return nil, fmt.Errorf("failed to scan category: %v", err)
+		}
+		categoryMins = append(categoryMins, &categoryMin)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get categories: %v", err)
+	}
+	return categoryMins, nil
+}
+
+func (s *pgCategoryStore) List(ctx context.Context, min int, max int) ([]*proto.CategoryMin, error) {
+	return listCategories(ctx, s.db, "SELECT category_id, name FROM categories WHERE category_id BETWEEN $1 AND $2 ORDER BY category_id", min, max)
+}
+
+func (s *pgCategoryStore) Search(ctx context.Context, name string) ([]*proto.CategoryMin, error) {
+	return listCategories(ctx, s.db, "SELECT category_id, name FROM categories WHERE name ILIKE $1 ORDER BY category_id", "%"+name+"%")
+}
+
+func (s *pgCategoryStore) Get(ctx context.Context, categoryID int) (models.Category, error) {
+	var category models.Category
+	err := s.db.QueryRowContext(ctx, "SELECT name, description, created_at, updated_at FROM categories WHERE category_id = $1", categoryID).
+		Scan(&category.Name, &category.Description, &category.CreatedAt, &category.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return category, errNotFound
+	} else if err != nil {
+		return category, fmt.Errorf("failed to get category: %v", err)
+	}
+	return category, nil
+}
+
+func (s *pgCategoryStore) Update(ctx context.Context, category models.UpdateCategory) error {
+	res, err := s.db.ExecContext(ctx, "UPDATE categories SET name = $1, description = $2, updated_at = $3 WHERE category_id = $4",
+		category.NewName, category.NewDescription, category.UpdatedAt, category.CategoryID)
+	if isUniqueViolation(err) {
+		return errDuplicate
+	} else if err != nil {
+		return fmt.Errorf("failed to update category: %v", err)
+	}
+	return checkAffected(res)
+}
+
+func (s *pgCategoryStore) Delete(ctx context.Context, categoryID int) error {
+	res, err := s.db.ExecContext(ctx, "DELETE FROM categories WHERE category_id = $1", categoryID)
+	if err != nil {
+		return fmt.Errorf("failed to delete category: %v", err)
+	}
+	return checkAffected(res)
+}
+
+func (s *pgCategoryStore) Exists(ctx context.Context, categoryID int) (bool, error) {
+	return rowExists(ctx, s.db, "SELECT 1 FROM categories WHERE category_id = $1 LIMIT 1", categoryID)
+}
+
+type pgBookStore struct {
+	db *sql.DB
+}
+
+func (s *pgBookStore) Create(ctx context.Context, book models.Book) error {
+	_, err := s.db.ExecContext(ctx, "INSERT INTO books (title, category_id, author_id, published_date, isbn, total_stock, available_stock, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, 0, 0, $6, $7)",
+		book.Title, book.CategoryID, book.AuthorID, book.PublishedDate, book.ISBN, book.CreatedAt, book.UpdatedAt)
+	if isUniqueViolation(err) {
+		return errDuplicate
+	} else if err != nil {
+		return fmt.Errorf("failed to insert book: %v", err)
+	}
+	return nil
+}
+
+func listBooks(ctx context.Context, db *sql.DB, query string, args ...any) ([]*proto.BookMin, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get books: %v", err)
+	}
+	defer rows.Close()
+
+	var bookMins []*proto.BookMin
+	for rows.Next() {
+		var bookMin proto.BookMin
+		var publishedDate sql.NullTime
+		if err := rows.Scan(&bookMin.BookId, &bookMin.Title, &bookMin.CategoryId, &bookMin.AuthorId, &publishedDate, &bookMin.AvailableStock); err != nil {
+			return nil, fmt.Errorf("failed to scan book: %v", err)
+		}
+		if publishedDate.Valid {
+			bookMin.PublishedDate = publishedDate.Time.Format(time.RFC3339)
+		}
+		bookMins = append(bookMins, &bookMin)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get books: %v", err)
+	}
+	return bookMins, nil
+}
+
+const bookMinColumns = "book_id, title, category_id, author_id, published_date, available_stock"
+
+func (s *pgBookStore) List(ctx context.Context, min int, max int) ([]*proto.BookMin, error) {
+	return listBooks(ctx, s.db, "SELECT "+bookMinColumns+" FROM books WHERE book_id BETWEEN $1 AND $2 ORDER BY book_id", min, max)
+}
+
+func (s *pgBookStore) ListByPublishedDate(ctx context.Context, start string, end string) ([]*proto.BookMin, error) {
+	return listBooks(ctx, s.db, "SELECT "+bookMinColumns+" FROM books WHERE published_date BETWEEN $1 AND $2 ORDER BY published_date, book_id", start, end)
+}
+
+func (s *pgBookStore) Search(ctx context.Context, title string) ([]*proto.BookMin, error) {
+	return listBooks(ctx, s.db, "SELECT "+bookMinColumns+" FROM books WHERE title ILIKE $1 ORDER BY book_id", "%"+title+"%")
+}
+
+func (s *pgBookStore) Get(ctx context.Context, bookID int) (models.Book, error) {
+	var book models.Book
+	err := s.db.QueryRowContext(ctx, "SELECT title, category_id, author_id, published_date, isbn, total_stock, available_stock, created_at, updated_at FROM books WHERE book_id = $1", bookID).
+		Scan(&book.Title, &book.CategoryID, &book.AuthorID, &book.PublishedDate, &book.ISBN, &book.TotalStock, &book.AvailableStock, &book.CreatedAt, &book.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return book, errNotFound
+	} else if err != nil {
+		return book, fmt.Errorf("failed to get book: %v", err)
+	}
+	return book, nil
+}
+
+func (s *pgBookStore) Update(ctx context.Context, book models.UpdateBook) error {
+	res, err := s.db.ExecContext(ctx, "UPDATE books SET title = $1, category_id = $2, author_id = $3, published_date = $4, isbn = $5, updated_at = $6 WHERE book_id = $7",
+		book.NewTitle, book.NewCategoryID, book.NewAuthorID, book.NewPublishedDate, book.NewISBN, book.UpdatedAt, book.BookID)
+	if isUniqueViolation(err) {
+		return errDuplicate
+	} else if err != nil {
+		return fmt.Errorf("failed to update book: %v", err)
+	}
+	return checkAffected(res)
+}
+
+func (s *pgBookStore) Delete(ctx context.Context, bookID int) error {
+	return inTx(ctx, s.db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "DELETE FROM transfers WHERE item_id IN (SELECT item_id FROM items WHERE book_id = $1)", bookID)
+		if err != nil {
+			return fmt.Errorf("failed to delete transfers: %v", err)
+		}
+		_, err = tx.ExecContext(ctx, "DELETE FROM items WHERE book_id = $1", bookID)
+		if err != nil {
+			return fmt.Errorf("failed to delete items: %v", err)
+		}
+		res, err := tx.ExecContext(ctx, "DELETE FROM books WHERE book_id = $1", bookID)
+		if err != nil {
+			return fmt.Errorf("failed to delete book: %v", err)
+		}
+		return checkAffected(res)
+	})
+}
+
+func (s *pgBookStore) Exists(ctx context.Context, bookID int) (bool, error) {
+	return rowExists(ctx, s.db, "SELECT 1 FROM books WHERE book_id = $1 LIMIT 1", bookID)
+}
+
+func (s *pgBookStore) IsAuthorInUse(ctx context.Context, authorID int) (bool, error) {
+	return rowExists(ctx, s.db, "SELECT 1 FROM books WHERE author_id = $1 LIMIT 1", authorID)
+}
+
+func (s *pgBookStore) IsCategoryInUse(ctx context.Context, categoryID int) (bool, error) {
+	return rowExists(ctx, s.db, "SELECT 1 FROM books WHERE category_id = $1 LIMIT 1", categoryID)
+}
+
+func (s *pgBookStore) Recommend(ctx context.Context, categoryID int, limit int) ([]*proto.BookMin, error) {
+	return listBooks(ctx, s.db, "SELECT "+bookMinColumns+" FROM books WHERE category_id = $1 ORDER BY RANDOM() LIMIT $2", categoryID, limit)
+}
+
+// the copy goes to the first waiting hold or on the shelf
+func (s *pgBookStore) AddItem(ctx context.Context, req *proto.Item) (*proto.Item, error) {
+	var item *proto.Item
+	err := inTx(ctx, s.db, func(tx *sql.Tx) error {
+		if err := checkBranch(ctx, tx, int(req.BranchId)); err != nil {
+			return err
+		}
+		if err := lockBook(ctx, tx, int(req.BookId)); err != nil {
+			return err
+		}
+		var scan int
+		err := tx.QueryRowContext(ctx, "SELECT 1 FROM items WHERE barcode = $1", req.Barcode).Scan(&scan)
+		if err == nil {
+			return status.Errorf(codes.AlreadyExists, "barcode %s is already in use", req.Barcode)
+		} else if err != sql.ErrNoRows {
+			return fmt.Errorf("failed to check barcode: %v", err)
+		}
+
+		now := time.Now().UTC()
+		var itemID int
+		err = tx.QueryRowContext(ctx, "INSERT INTO items (book_id, barcode, condition, status, shelf_location, created_at, updated_at, branch_id) VALUES ($1, $2, $3, $4, $5, $6, $6, $7) RETURNING item_id",
+			req.BookId, req.Barcode, req.Condition, ItemOnLoan, req.ShelfLocation, now, req.BranchId).Scan(&itemID)
+		if err != nil {
+			return fmt.Errorf("failed to insert item: %v", err)
+		}
+		if err := putBackCopy(ctx, tx, int(req.BookId), itemID); err != nil {
+			return err
+		}
+
+		item, err = scanItem(tx.QueryRowContext(ctx, "SELECT "+itemColumns+" FROM items WHERE item_id = $1", itemID))
+		if err != nil {
+			return fmt.Errorf("failed to get item: %v", err)
+		}
+		return nil
+	})
+	return item, err
+}
+
+func (s *pgBookStore) ListItems(ctx context.Context, bookID int, itemStatus string, branchID int) ([]*proto.Item, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT "+itemColumns+" FROM items WHERE ($1 = 0 OR book_id = $1) AND ($2 = '' OR status = $2) AND ($3 = 0 OR branch_id = $3) ORDER BY book_id, item_id",
+		bookID, itemStatus, branchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get items: %v", err)
+	}
+	defer rows.Close()
+
+	var items []*proto.Item
+	for rows.Next() {
+		item, err := scanItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan item: %v", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get items: %v", err)
+	}
+	return items, nil
+}
+
+func (s *pgBookStore) MarkItemLost(ctx context.Context, barcode string) (*proto.Item, error) {
+	return markItemLost(ctx, s.db, barcode)
+}
+
+func (s *pgBookStore) MarkItemDamaged(ctx context.Context, barcode string) (*proto.Item, error) {
+	return markItemDamaged(ctx, s.db, barcode)
+}
+
+type pgBorrowStore struct {
+	db *sql.DB
+}
+
+func (s *pgBorrowStore) Create(ctx context.Context, barcode string, userID int, branchID int, role string, borrowedDate time.Time) (int, time.Time, error) {
+	if branchID != 0 {
+		if err := checkBranch(ctx, s.db, branchID); err != nil {
+			return 0, time.Time{}, err
+		}
+	}
+	return borrowItem(ctx, s.db, barcode, userID, branchID, role, borrowedDate)
+}
+
+func (s *pgBorrowStore) Return(ctx context.Context, borrowingID int, branchID int, returnedDate time.Time) (int, error) {
+	if branchID != 0 {
+		if err := checkBranch(ctx, s.db, branchID); err != nil {
+			return 0, err
+		}
+	}
+	_, fine, err := returnBook(ctx, s.db, borrowingID, branchID, returnedDate)
+	return fine, err
+}
+
+func (s *pgBorrowStore) OutstandingFines(ctx context.Context, userID int) (int, error) {
+	return outstandingFines(ctx, s.db, userID)
+}
+
+func (s *pgBorrowStore) List(ctx context.Context, filter borrowFilter) ([]*proto.BorrowOrReturnMin, error) {
+	query := "SELECT borrowing_id, book_id, user_id, borrowed_date FROM borrowing WHERE returned = $1"
+	args := []any{filter.Returned}
+	switch {
+	case filter.UserID != 0:
+		query += " AND user_id = $2"
+		args = append(args, filter.UserID)
+	case filter.StartDate != "" || filter.EndDate != "":
+		query += " AND borrowed_date BETWEEN $2 AND $3"
+		args = append(args, filter.StartDate, filter.EndDate)
+	default:
+		query += " AND borrowing_id BETWEEN $2 AND $3"
+		args = append(args, filter.MinID, filter.MaxID)
+	}
+	query += " ORDER BY borrowing_id"
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get borrowings: %v", err)
+	}
+	defer rows.Close()
+
+	var borrowOrReturnMins []*proto.BorrowOrReturnMin
+	for rows.Next() {
+		var borrowOrReturnMin proto.BorrowOrReturnMin
+		var borrowedDate time.Time
+		if err := rows.Scan(&borrowOrReturnMin.BorrowingId, &borrowOrReturnMin.BookId, &borrowOrReturnMin.UserId, &borrowedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan borrowings: %v", err)
+		}
+		borrowOrReturnMin.BorrowedDate = borrowedDate.Format(time.RFC3339)
+		borrowOrReturnMins = append(borrowOrReturnMins, &borrowOrReturnMin)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get borrowings: %v", err)
+	}
+	return borrowOrReturnMins, nil
+}
+
+func (s *pgBorrowStore) Overdue(ctx context.Context, asOf time.Time, start *time.Time, end *time.Time) ([]overdueBorrow, error) {
+	query := "SELECT borrowing_id, book_id, user_id, COALESCE(branch_id, 0), borrowed_date, return_date FROM borrowing WHERE return_date < $1 AND borrowed_date <= $1 AND (returned = FALSE OR returned_date > $1)"
+	args := []any{asOf}
+	if start != nil {
+		args = append(args, *start)
+		query += fmt.Sprintf(" AND return_date >= $%d", len(args))
+	}
+	if end != nil {
+		args = append(args, *end)
+		query += fmt.Sprintf(" AND return_date < $%d", len(args))
+	}
+	query += " ORDER BY return_date, borrowing_id"
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get overdues: %v", err)
+	}
+	defer rows.Close()
+
+	var overdues []overdueBorrow
+	for rows.Next() {
+		var overdue overdueBorrow
+		if err := rows.Scan(&overdue.BorrowingID, &overdue.BookID, &overdue.UserID, &overdue.BranchID, &overdue.BorrowedDate, &overdue.ReturnDate); err != nil {
+			return nil, fmt.Errorf("failed to scan overdues: %v", err)
+		}
+		overdues = append(overdues, overdue)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get overdues: %v", err)
+	}
+
+	// days late count the days the branch of the loan was open
+	calendars := map[int]*libraryCalendar{}
+	for i, overdue := range overdues {
+		calendar, ok := calendars[overdue.BranchID]
+		if !ok {
+			calendar, err = loadCalendar(ctx, s.db, overdue.BranchID)
+			if err != nil {
+				return nil, err
+			}
+			calendars[overdue.BranchID] = calendar
+		}
+		overdues[i].DaysLate = calendar.openDaysLate(overdue.ReturnDate, asOf)
+	}
+	return overdues, nil
+}
+
+func (s *pgBorrowStore) StillBorrows(ctx context.Context, userID int) (bool, error) {
+	return rowExists(ctx, s.db, "SELECT 1 FROM borrowing WHERE user_id = $1 AND returned = FALSE LIMIT 1", userID)
+}
+
+func (s *pgBorrowStore) Update(ctx context.Context, borrow models.UpdateBorrow, returned bool) error {
+	return inTx(ctx, s.db, func(tx *sql.Tx) error {
+		var oldBookID int
+		var oldReturned bool
+		var itemID sql.NullInt64
+		err := tx.QueryRowContext(ctx, "SELECT book_id, returned, item_id FROM borrowing WHERE borrowing_id = $1 FOR UPDATE", borrow.BorrowingID).Scan(&oldBookID, &oldReturned, &itemID)
+		if err == sql.ErrNoRows {
+			return errBorrowNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to get borrow: %v", err)
+		}
+
+		// the same copy stays out when only dates or the borrower change
+		keepItem := !oldReturned && !returned && oldBookID == borrow.NewBookID
+		if !oldReturned && !keepItem {
+			if err := putBackBorrowedCopy(ctx, tx, oldBookID, itemID); err != nil {
+				return err
+			}
+		}
+		if !returned && !keepItem {
+			newItemID, err := takeCopy(ctx, tx, borrow.NewBookID)
+			if err != nil {
+				return err
+			}
+			itemID = sql.NullInt64{Int64: int64(newItemID), Valid: true}
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE borrowing SET book_id = $1, item_id = $2, user_id = $3, borrowed_date = $4, return_date = $5, returned = $6 WHERE borrowing_id = $7",
+			borrow.NewBookID, itemID, borrow.NewUserID, borrow.NewBorrowedDate, borrow.NewReturnDate, returned, borrow.BorrowingID)
+		if err != nil {
+			return fmt.Errorf("failed to update borrow: %v", err)
+		}
+		return nil
+	})
+}
+
+func (s *pgBorrowStore) Delete(ctx context.Context, borrowingID int) error {
+	return inTx(ctx, s.db, func(tx *sql.Tx) error {
+		var bookID int
+		var returned bool
+		var itemID sql.NullInt64
+		err := tx.QueryRowContext(ctx, "DELETE FROM borrowing WHERE borrowing_id = $1 RETURNING book_id, returned, item_id", borrowingID).Scan(&bookID, &returned, &itemID)
+		if err == sql.ErrNoRows {
+			return errBorrowNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to delete borrow: %v", err)
+		}
+		if returned {
+			return nil
+		}
+		return putBackBorrowedCopy(ctx, tx, bookID, itemID)
+	})
+}
+
+func (s *pgBorrowStore) Renew(ctx context.Context, borrowingID int, checkAccess func(userID int) error) (*proto.RenewedBorrow, error) {
+	return renewBorrow(ctx, s.db, borrowingID, checkAccess)
+}
+
+func (s *pgBorrowStore) ListFines(ctx context.Context, userID int) ([]*proto.Fine, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT "+fineColumns+" FROM fines WHERE user_id = $1 ORDER BY fine_id", userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fines: %v", err)
+	}
+	defer rows.Close()
+
+	var fines []*proto.Fine
+	for rows.Next() {
+		fine, err := scanFine(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan fine: %v", err)
+		}
+		fines = append(fines, fine)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get fines: %v", err)
+	}
+	return fines, nil
+}
+
+func (s *pgBorrowStore) PayFine(ctx context.Context, fineID int, amountCents int) (*proto.Fine, error) {
+	return payFine(ctx, s.db, fineID, amountCents)
+}
+
+func (s *pgBorrowStore) WaiveFine(ctx context.Context, fineID int, note string) (*proto.Fine, error) {
+	fine, err := scanFine(s.db.QueryRowContext(ctx, "UPDATE fines SET status = $1, note = $2, closed_at = $3 WHERE fine_id = $4 AND status = $5 RETURNING "+fineColumns,
+		FineWaived, note, time.Now().UTC(), fineID, FineOutstanding))
+	if err == sql.ErrNoRows {
+		var fineStatus string
+		err = s.db.QueryRowContext(ctx, "SELECT status FROM fines WHERE fine_id = $1", fineID).Scan(&fineStatus)
+		if err == sql.ErrNoRows {
+			return nil, errFineNotFound
+		} else if err == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "fine is already %s", fineStatus)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to waive fine: %v", err)
+	}
+	return fine, nil
+}
+
+type pgHoldStore struct {
+	db *sql.DB
+}
+
+func (s *pgHoldStore) Place(ctx context.Context, bookID int, userID int, pickupBranchID int, role string) (*proto.Hold, error) {
+	if pickupBranchID != 0 {
+		if err := checkBranch(ctx, s.db, pickupBranchID); err != nil {
+			return nil, err
+		}
+	}
+	return placeHold(ctx, s.db, bookID, userID, pickupBranchID, role)
+}
+
+func (s *pgHoldStore) Cancel(ctx context.Context, holdID int, checkAccess func(userID int) error) error {
+	return cancelHold(ctx, s.db, holdID, checkAccess)
+}
+
+func (s *pgHoldStore) List(ctx context.Context, bookID int, userID int) ([]*proto.Hold, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT h.hold_id, h.book_id, h.user_id, h.status, h.placed_at, h.ready_at, h.pickup_expires_at, COALESCE(i.barcode, ''), COALESCE(h.pickup_branch_id, 0),
+            CASE WHEN h.status = $1 THEN (SELECT COUNT(*) FROM holds q WHERE q.book_id = h.book_id AND q.status = $1 AND (q.placed_at, q.hold_id) <= (h.placed_at, h.hold_id)) ELSE 0 END
+        FROM holds h LEFT JOIN items i ON i.item_id = h.item_id
+        WHERE h.status IN ($1, $2, $5) AND ($3 = 0 OR h.book_id = $3) AND ($4 = 0 OR h.user_id = $4)
+        ORDER BY h.book_id, h.placed_at, h.hold_id`, HoldWaiting, HoldReady, bookID, userID, HoldInTransit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get holds: %v", err)
+	}
+	defer rows.Close()
+
+	var holds []*proto.Hold
+	now := time.Now().UTC()
+	for rows.Next() {
+		var hold proto.Hold
+		var placedAt time.Time
+		var readyAt, pickupExpiresAt sql.NullTime
+		if err := rows.Scan(&hold.HoldId, &hold.BookId, &hold.UserId, &hold.Status, &placedAt, &readyAt, &pickupExpiresAt, &hold.Barcode, &hold.PickupBranchId, &hold.Position); err != nil {
+			return nil, fmt.Errorf("failed to scan hold: %v", err)
+		}
+		// not swept yet, the next borrow or hold on the book expires it
+		if hold.Status == HoldReady && pickupExpiresAt.Valid && now.After(pickupExpiresAt.Time) {
+			hold.Status = HoldExpired
+		}
+		hold.PlacedAt = placedAt.Format("2006-01-02 15:04:05")
+		hold.ReadyAt = formatNullTime(readyAt)
+		hold.PickupExpiresAt = formatNullTime(pickupExpiresAt)
+		holds = append(holds, &hold)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get holds: %v", err)
+	}
+	return holds, nil
+}
+
+type pgBranchStore struct {
+	db *sql.DB
+}
+
+func (s *pgBranchStore) Create(ctx context.Context, name string, address string) (*proto.Branch, error) {
+	var branch proto.Branch
+	var createdAt time.Time
+	err := s.db.QueryRowContext(ctx, "INSERT INTO branches (name, address, created_at) VALUES ($1, $2, $3) ON CONFLICT (name) DO NOTHING RETURNING branch_id, name, address, created_at",
+		name, address, time.Now().UTC()).Scan(&branch.BranchId, &branch.Name, &branch.Address, &createdAt)
+	if err == sql.ErrNoRows {
+		return nil, errDuplicate
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to insert branch: %v", err)
+	}
+	branch.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+	return &branch, nil
+}
+
+func (s *pgBranchStore) List(ctx context.Context) ([]*proto.Branch, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT branch_id, name, address, created_at FROM branches ORDER BY branch_id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branches: %v", err)
+	}
+	defer rows.Close()
+
+	var branches []*proto.Branch
+	for rows.Next() {
+		var branch proto.Branch
+		var createdAt time.Time
+		if err := rows.Scan(&branch.BranchId, &branch.Name, &branch.Address, &createdAt); err != nil {
+			return nil, fmt.Errorf("failed to scan branch: %v", err)
+		}
+		branch.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+		branches = append(branches, &branch)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get branches: %v", err)
+	}
+	return branches, nil
+}
+
+func (s *pgBranchStore) Exists(ctx context.Context, branchID int) (bool, error) {
+	return rowExists(ctx, s.db, "SELECT 1 FROM branches WHERE branch_id = $1", branchID)
+}
+
+func (s *pgBranchStore) RequestTransfer(ctx context.Context, barcode string, toBranchID int) (*proto.Transfer, error) {
+	return requestTransfer(ctx, s.db, barcode, toBranchID)
+}
+
+func (s *pgBranchStore) ShipTransfer(ctx context.Context, transferID int) (*proto.Transfer, error) {
+	return shipTransfer(ctx, s.db, transferID)
+}
+
+func (s *pgBranchStore) ReceiveTransfer(ctx context.Context, transferID int) (*proto.Transfer, error) {
+	return receiveTransfer(ctx, s.db, transferID)
+}
+
+func (s *pgBranchStore) ListTransfers(ctx context.Context, branchID int, transferStatus string) ([]*proto.Transfer, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT "+transferColumns+` FROM transfers t JOIN items i ON i.item_id = t.item_id
+        WHERE ($1 = 0 OR t.from_branch_id = $1 OR t.to_branch_id = $1) AND ($2 = '' OR t.status = $2)
+        ORDER BY t.transfer_id`, branchID, transferStatus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transfers: %v", err)
+	}
+	defer rows.Close()
+
+	var transfers []*proto.Transfer
+	for rows.Next() {
+		transfer, err := scanTransfer(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan transfer: %v", err)
+		}
+		transfers = append(transfers, transfer)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get transfers: %v", err)
+	}
+	return transfers, nil
+}
+
+type pgCalendarStore struct {
+	db *sql.DB
+}
+
+func (s *pgCalendarStore) Get(ctx context.Context, branchID int) (*proto.Calendar, error) {
+	calendar := proto.Calendar{BranchId: int32(branchID)}
+
+	// the hours in effect per weekday, the branch's own or the defaults
+	rows, err := s.db.QueryContext(ctx, "SELECT DISTINCT ON (weekday) branch_id, weekday, opens_at, closes_at, closed FROM opening_hours WHERE branch_id IN (0, $1) ORDER BY weekday, branch_id DESC", branchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get opening hours: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var hours proto.OpeningHours
+		if err := rows.Scan(&hours.BranchId, &hours.Weekday, &hours.OpensAt, &hours.ClosesAt, &hours.Closed); err != nil {
+			return nil, fmt.Errorf("failed to scan opening hours: %v", err)
+		}
+		calendar.Hours = append(calendar.Hours, &hours)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get opening hours: %v", err)
+	}
+
+	holidays, err := s.db.QueryContext(ctx, "SELECT holiday_id, branch_id, holiday_date, name FROM holidays WHERE branch_id IN (0, $1) ORDER BY holiday_date, holiday_id", branchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get holidays: %v", err)
+	}
+	defer holidays.Close()
+	for holidays.Next() {
+		var holiday proto.Holiday
+		var date time.Time
+		if err := holidays.Scan(&holiday.HolidayId, &holiday.BranchId, &date, &holiday.Name); err != nil {
+			return nil, fmt.Errorf("failed to scan holiday: %v", err)
+		}
+		holiday.Date = date.Format("2006-01-02")
+		calendar.Holidays = append(calendar.Holidays, &holiday)
+	}
+	if err := holidays.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get holidays: %v", err)
+	}
+	return &calendar, nil
+}
+
+func (s *pgCalendarStore) SetHours(ctx context.Context, hours *proto.OpeningHours) error {
+	_, err := s.db.ExecContext(ctx, `INSERT INTO opening_hours (branch_id, weekday, opens_at, closes_at, closed, updated_at) VALUES ($1, $2, $3, $4, $5, $6)
+        ON CONFLICT (branch_id, weekday) DO UPDATE SET opens_at = EXCLUDED.opens_at, closes_at = EXCLUDED.closes_at, closed = EXCLUDED.closed, updated_at = EXCLUDED.updated_at`,
+		hours.BranchId, hours.Weekday, hours.OpensAt, hours.ClosesAt, hours.Closed, time.Now().UTC())
+	if err != nil {
+		return fmt.Errorf("failed to set opening hours: %v", err)
+	}
+	return nil
+}
+
+func (s *pgCalendarStore) AddHoliday(ctx context.Context, holiday *proto.Holiday) (int, error) {
+	var holidayID int
+	err := s.db.QueryRowContext(ctx, `INSERT INTO holidays (branch_id, holiday_date, name) VALUES ($1, $2, $3)
+        ON CONFLICT (branch_id, holiday_date) DO UPDATE SET name = EXCLUDED.name RETURNING holiday_id`,
+		holiday.BranchId, holiday.Date, holiday.Name).Scan(&holidayID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to add holiday: %v", err)
+	}
+	return holidayID, nil
+}
+
+func (s *pgCalendarStore) DeleteHoliday(ctx context.Context, holidayID int) error {
+	res, err := s.db.ExecContext(ctx, "DELETE FROM holidays WHERE holiday_id = $1", holidayID)
+	if err != nil {
+		return fmt.Errorf("failed to delete holiday: %v", err)
+	}
+	return checkAffected(res)
+}
+
+type pgPolicyStore struct {
+	db *sql.DB
+}
+
+func (s *pgPolicyStore) List(ctx context.Context) ([]circulationPolicy, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT "+circulationPolicyColumns+" FROM circulation_policies ORDER BY role, category_id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get circulation policies: %v", err)
+	}
+	defer rows.Close()
+
+	var policies []circulationPolicy
+	for rows.Next() {
+		var policy circulationPolicy
+		if err := rows.Scan(&policy.PolicyID, &policy.Role, &policy.CategoryID, &policy.MaxLoans, &policy.LoanDays, &policy.Renewals, &policy.MaxHolds); err != nil {
+			return nil, fmt.Errorf("failed to scan circulation policy: %v", err)
+		}
+		policies = append(policies, policy)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get circulation policies: %v", err)
+	}
+	return policies, nil
+}
+
+func (s *pgPolicyStore) Set(ctx context.Context, policy circulationPolicy) (int, error) {
+	var policyID int
+	err := s.db.QueryRowContext(ctx, `INSERT INTO circulation_policies (role, category_id, max_loans, loan_days, renewals, max_holds, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7)
+        ON CONFLICT (role, category_id) DO UPDATE SET max_loans = EXCLUDED.max_loans, loan_days = EXCLUDED.loan_days, renewals = EXCLUDED.renewals, max_holds = EXCLUDED.max_holds, updated_at = EXCLUDED.updated_at
+        RETURNING policy_id`,
+		policy.Role, policy.CategoryID, policy.MaxLoans, policy.LoanDays, policy.Renewals, policy.MaxHolds, time.Now().UTC()).Scan(&policyID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to save circulation policy: %v", err)
+	}
+	return policyID, nil
+}
+
+func (s *pgPolicyStore) Delete(ctx context.Context, policyID int) error {
+	res, err := s.db.ExecContext(ctx, "DELETE FROM circulation_policies WHERE policy_id = $1", policyID)
+	if err != nil {
+		return fmt.Errorf("failed to delete circulation policy: %v", err)
+	}
+	return checkAffected(res)
+}
+
+type pgNotificationStore struct {
+	db *sql.DB
+}
+
+func (s *pgNotificationStore) Due(ctx context.Context, now time.Time, dueSoonBefore time.Time) ([]dueNotice, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT br.borrowing_id, br.user_id, b.title, br.return_date, k.kind
+        FROM borrowing br
+        JOIN books b ON b.book_id = br.book_id
+        CROSS JOIN LATERAL (SELECT CASE WHEN br.return_date < $1 THEN $2 ELSE $3 END AS kind) k
+        WHERE br.returned = FALSE AND br.return_date < $4
+        AND NOT EXISTS (SELECT 1 FROM notifications n WHERE n.borrowing_id = br.borrowing_id AND n.kind = k.kind AND n.due_date = br.return_date)
+        ORDER BY br.return_date, br.borrowing_id`, now, NoticeOverdue, NoticeDueSoon, dueSoonBefore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due borrowings: %v", err)
+	}
+	defer rows.Close()
+
+	var notices []dueNotice
+	for rows.Next() {
+		var notice dueNotice
+		if err := rows.Scan(&notice.borrowingID, &notice.userID, &notice.title, &notice.returnDate, &notice.kind); err != nil {
+			return nil, fmt.Errorf("failed to scan due borrowing: %v", err)
+		}
+		notices = append(notices, notice)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get due borrowings: %v", err)
+	}
+	return notices, nil
+}
+
+func (s *pgNotificationStore) Claim(ctx context.Context, notice dueNotice, recipient string, subject string, sentAt time.Time) (int, bool, error) {
+	var notificationID int
+	err := s.db.QueryRowContext(ctx, `INSERT INTO notifications (user_id, borrowing_id, kind, due_date, recipient, subject, sent_at) VALUES ($1, $2, $3, $4, $5, $6, $7)
+        ON CONFLICT (borrowing_id, kind, due_date) DO NOTHING RETURNING notification_id`,
+		notice.userID, notice.borrowingID, notice.kind, notice.returnDate, recipient, subject, sentAt).Scan(&notificationID)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	} else if err != nil {
+		return 0, false, fmt.Errorf("failed to record notification: %v", err)
+	}
+	return notificationID, true, nil
+}
+
+func (s *pgNotificationStore) Release(ctx context.Context, notificationID int) error {
+	if _, err := s.db.ExecContext(ctx, "DELETE FROM notifications WHERE notification_id = $1", notificationID); err != nil {
+		return fmt.Errorf("failed to delete notification: %v", err)
+	}
+	return nil
+}
+
+func (s *pgNotificationStore) List(ctx context.Context, userID int) ([]*proto.Notification, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT notification_id, user_id, borrowing_id, kind, due_date, recipient, subject, sent_at FROM notifications WHERE user_id = $1 ORDER BY sent_at DESC, notification_id DESC",
+		userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notifications: %v", err)
+	}
+	defer rows.Close()
+
+	var notifications []*proto.Notification
+	for rows.Next() {
+		var notification proto.Notification
+		var dueDate, sentAt time.Time
+		err := rows.Scan(&notification.NotificationId, &notification.UserId, &notification.BorrowingId, &notification.Kind, &dueDate,
+			&notification.Recipient, &notification.Subject, &sentAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan notification: %v", err)
+		}
+		notification.DueDate = dueDate.Format("2006-01-02 15:04:05")
+		notification.SentAt = sentAt.Format("2006-01-02 15:04:05")
+		notifications = append(notifications, &notification)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get notifications: %v", err)
+	}
+	return notifications, nil
+}
+
+type pgAPIKeyStore struct {
+	db *sql.DB
+}
+
+// NULL for an unset time
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func (s *pgAPIKeyStore) Create(ctx context.Context, key apiKeyRecord, keyHash string) (int, error) {
+	var keyID int
+	err := s.db.QueryRowContext(ctx, "INSERT INTO api_keys (user_id, name, key_prefix, key_hash, role, scopes, expires_at, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING key_id",
+		key.UserID, key.Name, key.Prefix, keyHash, key.Role, strings.Join(key.Scopes, ","), nullTime(key.ExpiresAt), key.CreatedAt).Scan(&keyID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert API key: %v", err)
+	}
+	return keyID, nil
+}
+
+const apiKeyColumns = "k.key_id, k.user_id, u.username, u.role, k.name, k.key_prefix, k.role, k.scopes, k.created_at, k.last_used_at, k.expires_at, k.revoked_at"
+
+func scanAPIKey(scanner interface{ Scan(...any) error }) (apiKeyRecord, error) {
+	var key apiKeyRecord
+	var scopes string
+	var lastUsedAt, expiresAt, revokedAt sql.NullTime
+	err := scanner.Scan(&key.KeyID, &key.UserID, &key.Username, &key.OwnerRole, &key.Name, &key.Prefix, &key.Role, &scopes, &key.CreatedAt, &lastUsedAt, &expiresAt, &revokedAt)
+	if err != nil {
+		return key, err
+	}
+	key.Scopes = strings.Split(scopes, ",")
+	key.LastUsedAt, key.ExpiresAt, key.RevokedAt = lastUsedAt.Time, expiresAt.Time, revokedAt.Time
+	return key, nil
+}
+
+func (s *pgAPIKeyStore) get(ctx context.Context, where string, arg any) (apiKeyRecord, error) {
+	key, err := scanAPIKey(s.db.QueryRowContext(ctx, "SELECT "+apiKeyColumns+" FROM api_keys k JOIN users u ON u.user_id = k.user_id WHERE "+where+" = $1", arg))
+	if err == sql.ErrNoRows {
+		return key, errNotFound
+	} else if err != nil {
+		return key, fmt.Errorf("failed to get API key: %v", err)
+	}
+	return key, nil
+}
+
+func (s *pgAPIKeyStore) Get(ctx context.Context, keyID int) (apiKeyRecord, error) {
+	return s.get(ctx, "k.key_id", keyID)
+}
+
+func (s *pgAPIKeyStore) GetByHash(ctx context.Context, keyHash string) (apiKeyRecord, error) {
+	return s.get(ctx, "k.key_hash", keyHash)
+}
+
+func (s *pgAPIKeyStore) List(ctx context.Context, userID int) ([]apiKeyRecord, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT "+apiKeyColumns+" FROM api_keys k JOIN users u ON u.user_id = k.user_id WHERE k.user_id = $1 ORDER BY k.key_id", userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API keys: %v", err)
+	}
+	defer rows.Close()
+
+	var keys []apiKeyRecord
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan API key: %v", err)
+		}
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get API keys: %v", err)
+	}
+	return keys, nil
+}
+
+func (s *pgAPIKeyStore) Revoke(ctx context.Context, keyID int, at time.Time) error {
+	if _, err := s.db.ExecContext(ctx, "UPDATE api_keys SET revoked_at = $1 WHERE key_id = $2 AND revoked_at IS NULL", at, keyID); err != nil {
+		return fmt.Errorf("failed to revoke API key: %v", err)
+	}
+	return nil
+}
+
+func (s *pgAPIKeyStore) Touch(ctx context.Context, keyID int, at time.Time) error {
+	_, err := s.db.ExecContext(ctx, "UPDATE api_keys SET last_used_at = $1 WHERE key_id = $2 AND (last_used_at IS NULL OR last_used_at < $3)",
+		at, keyID, at.Add(-time.Minute))
+	if err != nil {
+		return fmt.Errorf("failed to update API key: %v", err)
+	}
+	return nil
+}
+
+type pgPasswordResetStore struct {
+	db *sql.DB
+}
+
+func (s *pgPasswordResetStore) Create(ctx context.Context, userID int, tokenHash string, expiresAt time.Time, createdAt time.Time) error {
+	return inTx(ctx, s.db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "UPDATE password_resets SET used_at = $1 WHERE user_id = $2 AND used_at IS NULL", createdAt, userID)
+		if err != nil {
+			return fmt.Errorf("failed to invalidate old reset tokens: %v", err)
+		}
+		_, err = tx.ExecContext(ctx, "INSERT INTO password_resets (user_id, token_hash, expires_at, created_at) VALUES ($1, $2, $3, $4)",
+			userID, tokenHash, expiresAt, createdAt)
+		if err != nil {
+			return fmt.Errorf("failed to insert reset token: %v", err)
+		}
+		return nil
+	})
+}
+
+func (s *pgPasswordResetStore) Use(ctx context.Context, tokenHash string, at time.Time, newHash func(user userCredentials) (string, error)) (userCredentials, error) {
+	var user userCredentials
+	err := inTx(ctx, s.db, func(tx *sql.Tx) error {
+		// lock the token, two concurrent confirmations must not both use it
+		var resetID int
+		var expiresAt time.Time
+		var usedAt sql.NullTime
+		err := tx.QueryRowContext(ctx, `SELECT r.reset_id, r.expires_at, r.used_at, u.user_id, u.username, u.password_hash, u.email, u.role, u.email_verified
+            FROM password_resets r JOIN users u ON u.user_id = r.user_id WHERE r.token_hash = $1 FOR UPDATE OF r`, tokenHash).
+			Scan(&resetID, &expiresAt, &usedAt, &user.UserID, &user.Username, &user.PasswordHash, &user.Email, &user.Role, &user.EmailVerified)
+		if err == sql.ErrNoRows {
+			return errNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to get reset token: %v", err)
+		}
+		if usedAt.Valid || at.After(expiresAt) {
+			return errNotFound
+		}
+
+		passwordHash, err := newHash(user)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE password_resets SET used_at = $1 WHERE reset_id = $2", at, resetID); err != nil {
+			return fmt.Errorf("failed to use reset token: %v", err)
+		}
+		_, err = tx.ExecContext(ctx, "UPDATE users SET password_hash = $1, password_changed_at = $2, updated_at = $2 WHERE user_id = $3", passwordHash, at, user.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to update password: %v", err)
+		}
+		// whoever knew the old password is locked out
+		if err := revokeUserSessions(ctx, tx, user.UserID); err != nil {
+			return fmt.Errorf("failed to revoke sessions: %v", err)
+		}
+		return nil
+	})
+	return user, err
+}
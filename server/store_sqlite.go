@@ -0,0 +1,1066 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	"gogrpc-rpc-boiler/server/models"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// stores on the single SQLite file of STORE=sqlite, schema in server/db/migrations/sqlite. they cover users, authors,
+// categories, books with their copies, and borrowing with renewals and the fine ledger, under the default circulation
+// policy. there are no branches, holds, calendars, policies, reminders, API keys or password resets,
+// so only sqliteMethods are served.
+// times are written in UTC and compared with julianday(), dates are written as YYYY-MM-DD
+
+var sqliteMethods = map[string]bool{
+	proto.UtilService_HelloWorld_FullMethodName:             true,
+	proto.UtilService_Ping_FullMethodName:                   true,
+	proto.UtilService_GetJWKS_FullMethodName:                true,
+	proto.UtilService_AuthWithoutCredentials_FullMethodName: true,
+
+	proto.UserService_CreateUser_FullMethodName:         true,
+	proto.UserService_LoginAuth_FullMethodName:          true,
+	proto.UserService_RefreshToken_FullMethodName:       true,
+	proto.UserService_Logout_FullMethodName:             true,
+	proto.UserService_ChangePassword_FullMethodName:     true,
+	proto.UserService_DeleteUser_FullMethodName:         true,
+	proto.UserService_GetUser_FullMethodName:            true,
+	proto.UserService_DoesUserExist_FullMethodName:      true,
+	proto.UserService_UnlockAccount_FullMethodName:      true,
+	proto.UserService_VerifyEmail_FullMethodName:        true,
+	proto.UserService_ResendVerification_FullMethodName: true,
+	proto.UserService_IsEmailVerified_FullMethodName:    true,
+
+	proto.AuthorService_CreateAuthor_FullMethodName:     true,
+	proto.AuthorService_GetAuthors_FullMethodName:       true,
+	proto.AuthorService_GetAuthorsByName_FullMethodName: true,
+	proto.AuthorService_GetAuthorByID_FullMethodName:    true,
+	proto.AuthorService_EditAuthor_FullMethodName:       true,
+	proto.AuthorService_DeleteAuthor_FullMethodName:     true,
+	proto.AuthorService_DoesAuthorExist_FullMethodName:  true,
+
+	proto.CategoryService_CreateCategory_FullMethodName:      true,
+	proto.CategoryService_GetCategories_FullMethodName:       true,
+	proto.CategoryService_GetCategoriesByName_FullMethodName: true,
+	proto.CategoryService_GetCategoryByID_FullMethodName:     true,
+	proto.CategoryService_EditCategory_FullMethodName:        true,
+	proto.CategoryService_DeleteCategory_FullMethodName:      true,
+	proto.CategoryService_DoesCategoryExist_FullMethodName:   true,
+
+	proto.BookAndBorrowService_CreateBook_FullMethodName:             true,
+	proto.BookAndBorrowService_GetBooks_FullMethodName:               true,
+	proto.BookAndBorrowService_GetBooksByDate_FullMethodName:         true,
+	proto.BookAndBorrowService_GetBooksByName_FullMethodName:         true,
+	proto.BookAndBorrowService_GetBookByID_FullMethodName:            true,
+	proto.BookAndBorrowService_EditBook_FullMethodName:               true,
+	proto.BookAndBorrowService_DeleteBook_FullMethodName:             true,
+	proto.BookAndBorrowService_IsAuthorInUseByBook_FullMethodName:    true,
+	proto.BookAndBorrowService_IsCategoryInUseByBook_FullMethodName:  true,
+	proto.BookAndBorrowService_GetBookRecommendations_FullMethodName: true,
+	proto.BookAndBorrowService_CreateItem_FullMethodName:             true,
+	proto.BookAndBorrowService_CreateBorrow_FullMethodName:           true,
+	proto.BookAndBorrowService_CreateReturn_FullMethodName:           true,
+	proto.BookAndBorrowService_GetBorrowings_FullMethodName:          true,
+	proto.BookAndBorrowService_GetBorrowingsByDate_FullMethodName:    true,
+	proto.BookAndBorrowService_GetBorrowingsByUserID_FullMethodName:  true,
+	proto.BookAndBorrowService_GetReturns_FullMethodName:             true,
+	proto.BookAndBorrowService_GetReturnsByDate_FullMethodName:       true,
+	proto.BookAndBorrowService_GetReturnsByUserID_FullMethodName:     true,
+	proto.BookAndBorrowService_GetOverdues_FullMethodName:            true,
+	proto.BookAndBorrowService_EditBorrow_FullMethodName:             true,
+	proto.BookAndBorrowService_DeleteBorrow_FullMethodName:           true,
+	proto.BookAndBorrowService_DoesUserStillBorrow_FullMethodName:    true,
+	proto.BookAndBorrowService_ListItems_FullMethodName:              true,
+	proto.BookAndBorrowService_MarkItemLost_FullMethodName:           true,
+	proto.BookAndBorrowService_MarkItemDamaged_FullMethodName:        true,
+	proto.BookAndBorrowService_RenewBorrow_FullMethodName:            true,
+	proto.BookAndBorrowService_GetUserFines_FullMethodName:           true,
+	proto.BookAndBorrowService_PayFine_FullMethodName:                true,
+	proto.BookAndBorrowService_WaiveFine_FullMethodName:              true,
+}
+
+// refuses the RPCs the SQLite stores cannot serve
+func checkStoreSupports(method string) error {
+	if storeBackend == StoreSQLite && !sqliteMethods[method] {
+		return errNeedsPostgres(method)
+	}
+	return nil
+}
+
+func errNeedsPostgres(what string) error {
+	return status.Errorf(codes.Unimplemented, "%s needs the postgres store", what)
+}
+
+func isSQLiteUniqueViolation(err error) bool {
+	var sqliteErr *sqlite.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE
+}
+
+// a DATE column value, NULL for a nil date
+func sqliteDate(date *time.Time) any {
+	if date == nil {
+		return nil
+	}
+	return date.Format("2006-01-02")
+}
+
+// checks a YYYY-MM-DD date from a request before it is written as is
+func parseSQLiteDate(date string) (string, error) {
+	parsed, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Format("2006-01-02"), nil
+}
+
+type sqliteUserStore struct {
+	db *sql.DB
+}
+
+func (s *sqliteUserStore) Create(ctx context.Context, user models.UserSensitive) (int, error) {
+	var userID int
+	err := s.db.QueryRowContext(ctx, "INSERT INTO users (username, password_hash, first_name, last_name, email, role, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?) RETURNING user_id",
+		user.Username, user.Password, user.FirstName, user.LastName, user.Email, user.Role, user.CreatedAt.UTC(), user.UpdatedAt.UTC()).Scan(&userID)
+	if isSQLiteUniqueViolation(err) {
+		return 0, errDuplicate
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to insert user: %v", err)
+	}
+	return userID, nil
+}
+
+func (s *sqliteUserStore) getCredentials(ctx context.Context, where string, arg any) (userCredentials, error) {
+	var user userCredentials
+	err := s.db.QueryRowContext(ctx, "SELECT user_id, username, password_hash, email, role, email_verified FROM users WHERE "+where+" = ?", arg).
+		Scan(&user.UserID, &user.Username, &user.PasswordHash, &user.Email, &user.Role, &user.EmailVerified)
+	if err == sql.ErrNoRows {
+		return user, errNotFound
+	} else if err != nil {
+		return user, fmt.Errorf("failed to get user: %v", err)
+	}
+	return user, nil
+}
+
+func (s *sqliteUserStore) GetCredentials(ctx context.Context, username string) (userCredentials, error) {
+	return s.getCredentials(ctx, "username", username)
+}
+
+func (s *sqliteUserStore) GetCredentialsByID(ctx context.Context, userID int) (userCredentials, error) {
+	return s.getCredentials(ctx, "user_id", userID)
+}
+
+func (s *sqliteUserStore) GetCredentialsByEmail(ctx context.Context, email string) (userCredentials, error) {
+	return s.getCredentials(ctx, "email", email)
+}
+
+func (s *sqliteUserStore) Get(ctx context.Context, userID int) (models.User, error) {
+	var user models.User
+	err := s.db.QueryRowContext(ctx, "SELECT user_id, username, first_name, last_name, email, role FROM users WHERE user_id = ?", userID).
+		Scan(&user.UserID, &user.Username, &user.FirstName, &user.LastName, &user.Email, &user.Role)
+	if err == sql.ErrNoRows {
+		return user, errNotFound
+	} else if err != nil {
+		return user, fmt.Errorf("failed to get user: %v", err)
+	}
+	return user, nil
+}
+
+func (s *sqliteUserStore) Exists(ctx context.Context, userID int) (bool, error) {
+	return rowExists(ctx, s.db, "SELECT 1 FROM users WHERE user_id = ? LIMIT 1", userID)
+}
+
+func (s *sqliteUserStore) SetPassword(ctx context.Context, userID int, passwordHash string, changedAt time.Time) error {
+	return inTx(ctx, s.db, func(tx *sql.Tx) error {
+		res, err := tx.ExecContext(ctx, "UPDATE users SET password_hash = ?1, password_changed_at = ?2, updated_at = ?2 WHERE user_id = ?3", passwordHash, changedAt.UTC(), userID)
+		if err != nil {
+			return fmt.Errorf("failed to update password: %v", err)
+		}
+		if err := checkAffected(res); err != nil {
+			return err
+		}
+		// tokens issued with the old password stop working
+		_, err = tx.ExecContext(ctx, "UPDATE sessions SET revoked_at = ? WHERE user_id = ? AND revoked_at IS NULL", changedAt.UTC(), userID)
+		if err != nil {
+			return fmt.Errorf("failed to revoke sessions: %v", err)
+		}
+		return nil
+	})
+}
+
+// sessions go with the user through ON DELETE CASCADE
+func (s *sqliteUserStore) Delete(ctx context.Context, userID int) error {
+	res, err := s.db.ExecContext(ctx, "DELETE FROM users WHERE user_id = ?", userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %v", err)
+	}
+	return checkAffected(res)
+}
+
+func (s *sqliteUserStore) RecordImpersonation(ctx context.Context, record impersonationRecord) error {
+	_, err := s.db.ExecContext(ctx, "INSERT INTO impersonation_audit (impersonator_id, impersonator_username, target_user_id, target_username, session_id, client_addr, expires_at, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		record.ImpersonatorID, record.ImpersonatorUsername, record.TargetUserID, record.TargetUsername, record.SessionID, record.ClientAddr, record.ExpiresAt.UTC(), record.CreatedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to write impersonation audit record: %v", err)
+	}
+	return nil
+}
+
+func (s *sqliteUserStore) VerifyEmail(ctx context.Context, userID int, email string, verifiedAt time.Time) (bool, error) {
+	res, err := s.db.ExecContext(ctx, "UPDATE users SET email_verified = TRUE, email_verified_at = ? WHERE user_id = ? AND email = ? AND email_verified = FALSE",
+		verifiedAt.UTC(), userID, email)
+	if err != nil {
+		return false, fmt.Errorf("failed to verify email: %v", err)
+	}
+	if err := checkAffected(res); err != errNotFound {
+		return err == nil, err
+	}
+	exists, err := rowExists(ctx, s.db, "SELECT 1 FROM users WHERE user_id = ? AND email = ?", userID, email)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user: %v", err)
+	}
+	if !exists {
+		return false, errNotFound
+	}
+	return false, nil
+}
+
+func (s *sqliteUserStore) Rehash(ctx context.Context, userID int, oldHash string, newHash string) error {
+	_, err := s.db.ExecContext(ctx, "UPDATE users SET password_hash = ?, updated_at = ? WHERE user_id = ? AND password_hash = ?",
+		newHash, time.Now().UTC(), userID, oldHash)
+	if err != nil {
+		return fmt.Errorf("failed to update password: %v", err)
+	}
+	return nil
+}
+
+type sqliteSessionStore struct {
+	db *sql.DB
+}
+
+func (s *sqliteSessionStore) Create(ctx context.Context, userID int, refreshTokenHash string, expiresAt time.Time, createdAt time.Time) (int, error) {
+	var sessionID int
+	err := s.db.QueryRowContext(ctx, "INSERT INTO sessions (user_id, refresh_token_hash, expires_at, created_at) VALUES (?, ?, ?, ?) RETURNING session_id",
+		userID, refreshTokenHash, expiresAt.UTC(), createdAt.UTC()).Scan(&sessionID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create session: %v", err)
+	}
+	return sessionID, nil
+}
+
+func (s *sqliteSessionStore) Get(ctx context.Context, sessionID int) (sessionRecord, error) {
+	session := sessionRecord{SessionID: sessionID}
+	var revokedAt, passwordChangedAt sql.NullTime
+	err := s.db.QueryRowContext(ctx, "SELECT s.user_id, u.username, u.role, s.refresh_token_hash, s.expires_at, s.revoked_at, u.password_changed_at FROM sessions s JOIN users u ON u.user_id = s.user_id WHERE s.session_id = ?",
+		sessionID).Scan(&session.UserID, &session.Username, &session.Role, &session.RefreshTokenHash, &session.ExpiresAt, &revokedAt, &passwordChangedAt)
+	if err == sql.ErrNoRows {
+		return session, errNotFound
+	} else if err != nil {
+		return session, fmt.Errorf("failed to get session: %v", err)
+	}
+	session.Revoked = revokedAt.Valid
+	session.PasswordChangedAt = passwordChangedAt.Time
+	return session, nil
+}
+
+func (s *sqliteSessionStore) Rotate(ctx context.Context, sessionID int, oldHash string, newHash string, at time.Time) error {
+	res, err := s.db.ExecContext(ctx, "UPDATE sessions SET refresh_token_hash = ?1, last_refreshed_at = ?2 WHERE session_id = ?3 AND refresh_token_hash = ?4 AND revoked_at IS NULL AND julianday(expires_at) > julianday(?2)",
+		newHash, at.UTC(), sessionID, oldHash)
+	if err != nil {
+		return fmt.Errorf("failed to rotate refresh token: %v", err)
+	}
+	return checkAffected(res)
+}
+
+func (s *sqliteSessionStore) Revoke(ctx context.Context, sessionID int, at time.Time) error {
+	_, err := s.db.ExecContext(ctx, "UPDATE sessions SET revoked_at = ? WHERE session_id = ? AND revoked_at IS NULL", at.UTC(), sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %v", err)
+	}
+	return nil
+}
+
+type sqliteLoginAttemptStore struct {
+	db *sql.DB
+}
+
+func (s *sqliteLoginAttemptStore) LockedUntil(ctx context.Context, key string) (time.Time, error) {
+	var lockedUntil sql.NullTime
+	err := s.db.QueryRowContext(ctx, "SELECT locked_until FROM login_attempts WHERE attempt_key = ?", key).Scan(&lockedUntil)
+	if err != nil && err != sql.ErrNoRows {
+		return time.Time{}, fmt.Errorf("failed to check login attempts: %v", err)
+	}
+	return lockedUntil.Time, nil
+}
+
+func (s *sqliteLoginAttemptStore) RecordFailure(ctx context.Context, key string, at time.Time, window time.Duration) (int, error) {
+	var failures int
+	err := s.db.QueryRowContext(ctx, `INSERT INTO login_attempts (attempt_key, failures, last_failure_at) VALUES (?1, 1, ?2)
+        ON CONFLICT (attempt_key) DO UPDATE SET
+            failures = CASE WHEN julianday(login_attempts.last_failure_at) < julianday(?3) THEN 1 ELSE login_attempts.failures + 1 END,
+            last_failure_at = ?2
+        RETURNING failures`, key, at.UTC(), at.Add(-window).UTC()).Scan(&failures)
+	if err != nil {
+		return 0, fmt.Errorf("failed to record login failure: %v", err)
+	}
+	return failures, nil
+}
+
+func (s *sqliteLoginAttemptStore) Lock(ctx context.Context, key string, until time.Time) error {
+	_, err := s.db.ExecContext(ctx, "UPDATE login_attempts SET locked_until = ? WHERE attempt_key = ?", until.UTC(), key)
+	if err != nil {
+		return fmt.Errorf("failed to lock out %s: %v", key, err)
+	}
+	return nil
+}
+
+func (s *sqliteLoginAttemptStore) Clear(ctx context.Context, key string) error {
+	_, err := s.db.ExecContext(ctx, "DELETE FROM login_attempts WHERE attempt_key = ?", key)
+	if err != nil {
+		return fmt.Errorf("failed to clear login failures: %v", err)
+	}
+	return nil
+}
+
+// like ILIKE '%pattern%', SQLite's LIKE already ignores the case of ASCII letters
+func likePattern(s string) string {
+	return "%" + strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s) + "%"
+}
+
+type sqliteAuthorStore struct {
+	db *sql.DB
+}
+
+func (s *sqliteAuthorStore) Create(ctx context.Context, author models.Author) error {
+	_, err := s.db.ExecContext(ctx, "INSERT INTO authors (name, birthdate, nationality, biography, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)",
+		author.Name, sqliteDate(author.Birthdate), author.Nationality, author.Biography, author.CreatedAt.UTC(), author.UpdatedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to insert author: %v", err)
+	}
+	return nil
+}
+
+func (s *sqliteAuthorStore) List(ctx context.Context, min int, max int) ([]*proto.AuthorMin, error) {
+	return listAuthors(ctx, s.db, "SELECT author_id, name FROM authors WHERE author_id BETWEEN ? AND ? ORDER BY author_id", min, max)
+}
+
+func (s *sqliteAuthorStore) Search(ctx context.Context, name string) ([]*proto.AuthorMin, error) {
+	return listAuthors(ctx, s.db, `SELECT author_id, name FROM authors WHERE name LIKE ? ESCAPE '\' ORDER BY author_id`, likePattern(name))
+}
+
+func (s *sqliteAuthorStore) Get(ctx context.Context, authorID int) (models.Author, error) {
+	var author models.Author
+	err := s.db.QueryRowContext(ctx, "SELECT name, birthdate, nationality, biography, created_at, updated_at FROM authors WHERE author_id = ?", authorID).
+		Scan(&author.Name, &author.Birthdate, &author.Nationality, &author.Biography, &author.CreatedAt, &author.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return author, errNotFound
+	} else if err != nil {
+		return author, fmt.Errorf("failed to get author: %v", err)
+	}
+	return author, nil
+}
+
+func (s *sqliteAuthorStore) Update(ctx context.Context, author models.UpdateAuthor) error {
+	birthdate, err := parseSQLiteDate(author.NewBirthdate)
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, "UPDATE authors SET name = ?, birthdate = ?, nationality = ?, biography = ?, updated_at = ? WHERE author_id = ?",
+		author.NewName, birthdate, author.NewNationality, author.NewBiography, author.UpdatedAt.UTC(), author.AuthorID)
+	if err != nil {
+		return fmt.Errorf("failed to update author: %v", err)
+	}
+	return checkAffected(res)
+}
+
+func (s *sqliteAuthorStore) Delete(ctx context.Context, authorID int) error {
+	res, err := s.db.ExecContext(ctx, "DELETE FROM authors WHERE author_id = ?", authorID)
+	if err != nil {
+		return fmt.Errorf("failed to delete author: %v", err)
+	}
+	return checkAffected(res)
+}
+
+func (s *sqliteAuthorStore) Exists(ctx context.Context, authorID int) (bool, error) {
+	return rowExists(ctx, s.db, "SELECT 1 FROM authors WHERE author_id = ? LIMIT 1", authorID)
+}
+
+type sqliteCategoryStore struct {
+	db *sql.DB
+}
+
+func (s *sqliteCategoryStore) Create(ctx context.Context, category models.Category) error {
+	_, err := s.db.ExecContext(ctx, "INSERT INTO categories (name, description, created_at, updated_at) VALUES (?, ?, ?, ?)",
+		category.Name, category.Description, category.CreatedAt.UTC(), category.UpdatedAt.UTC())
+	if isSQLiteUniqueViolation(err) {
+		return errDuplicate
+	} else if err != nil {
+		return fmt.Errorf("failed to create category: %v", err)
+	}
+	return nil
+}
+
+func (s *sqliteCategoryStore) List(ctx context.Context, min int, max int) ([]*proto.CategoryMin, error) {
+	return listCategories(ctx, s.db, "SELECT category_id, name FROM categories WHERE category_id BETWEEN ? AND ? ORDER BY category_id", min, max)
+}
+
+func (s *sqliteCategoryStore) Search(ctx context.Context, name string) ([]*proto.CategoryMin, error) {
+	return listCategories(ctx, s.db, `SELECT category_id, name FROM categories WHERE name LIKE ? ESCAPE '\' ORDER BY category_id`, likePattern(name))
+}
+
+func (s *sqliteCategoryStore) Get(ctx context.Context, categoryID int) (models.Category, error) {
+	var category models.Category
+	err := s.db.QueryRowContext(ctx, "SELECT name, description, created_at, updated_at FROM categories WHERE category_id = ?", categoryID).
+		Scan(&category.Name, &category.Description, &category.CreatedAt, &category.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return category, errNotFound
+	} else if err != nil {
+		return category, fmt.Errorf("failed to get category: %v", err)
+	}
+	return category, nil
+}
+
+func (s *sqliteCategoryStore) Update(ctx context.Context, category models.UpdateCategory) error {
+	res, err := s.db.ExecContext(ctx, "UPDATE categories SET name = ?, description = ?, updated_at = ? WHERE category_id = ?",
+		category.NewName, category.NewDescription, category.UpdatedAt.UTC(), category.CategoryID)
+	if isSQLiteUniqueViolation(err) {
+		return errDuplicate
+	} else if err != nil {
+		return fmt.Errorf("failed to update category: %v", err)
+	}
+	return checkAffected(res)
+}
+
+func (s *sqliteCategoryStore) Delete(ctx context.Context, categoryID int) error {
+	res, err := s.db.ExecContext(ctx, "DELETE FROM categories WHERE category_id = ?", categoryID)
+	if err != nil {
+		return fmt.Errorf("failed to delete category: %v", err)
+	}
+	return checkAffected(res)
+}
+
+func (s *sqliteCategoryStore) Exists(ctx context.Context, categoryID int) (bool, error) {
+	return rowExists(ctx, s.db, "SELECT 1 FROM categories WHERE category_id = ? LIMIT 1", categoryID)
+}
+
+// recounts the stock of a book from its items, like syncStock
+func sqliteSyncStock(ctx context.Context, tx *sql.Tx, bookID int) error {
+	_, err := tx.ExecContext(ctx, `UPDATE books SET
+        total_stock = (SELECT COUNT(*) FROM items WHERE book_id = ?1 AND status NOT IN (?2, ?3)),
+        available_stock = (SELECT COUNT(*) FROM items WHERE book_id = ?1 AND status = ?4)
+        WHERE book_id = ?1`, bookID, ItemLost, ItemDamaged, ItemAvailable)
+	if err != nil {
+		return fmt.Errorf("failed to update stock: %v", err)
+	}
+	return nil
+}
+
+// sets the status of a copy and recounts the stock of its book
+func sqliteSetItemStatus(ctx context.Context, tx *sql.Tx, bookID int, itemID int, itemStatus string) error {
+	_, err := tx.ExecContext(ctx, "UPDATE items SET status = ?, updated_at = ? WHERE item_id = ?", itemStatus, time.Now().UTC(), itemID)
+	if err != nil {
+		return fmt.Errorf("failed to update item: %v", err)
+	}
+	return sqliteSyncStock(ctx, tx, bookID)
+}
+
+// puts a copy back on the shelf, a copy marked damaged leaves circulation instead.
+// there are no holds to serve
+func sqlitePutBackCopy(ctx context.Context, tx *sql.Tx, bookID int, itemID sql.NullInt64) error {
+	if !itemID.Valid {
+		return nil
+	}
+	var condition string
+	err := tx.QueryRowContext(ctx, "SELECT condition FROM items WHERE item_id = ?", itemID.Int64).Scan(&condition)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get item: %v", err)
+	}
+	next := ItemAvailable
+	if condition == ConditionDamaged {
+		next = ItemDamaged
+	}
+	return sqliteSetItemStatus(ctx, tx, bookID, int(itemID.Int64), next)
+}
+
+// takes any copy of the book off the shelf, errBookUnavailable when none is left
+func sqliteTakeCopy(ctx context.Context, tx *sql.Tx, bookID int) (int, error) {
+	var itemID int
+	err := tx.QueryRowContext(ctx, "SELECT item_id FROM items WHERE book_id = ? AND status = ? ORDER BY item_id LIMIT 1", bookID, ItemAvailable).Scan(&itemID)
+	if err == sql.ErrNoRows {
+		return 0, errBookUnavailable
+	} else if err != nil {
+		return 0, fmt.Errorf("failed to get item: %v", err)
+	}
+	return itemID, sqliteSetItemStatus(ctx, tx, bookID, itemID, ItemOnLoan)
+}
+
+type sqliteBookStore struct {
+	db *sql.DB
+}
+
+func (s *sqliteBookStore) Create(ctx context.Context, book models.Book) error {
+	_, err := s.db.ExecContext(ctx, "INSERT INTO books (title, category_id, author_id, published_date, isbn, total_stock, available_stock, created_at, updated_at) VALUES (?, ?, ?, ?, ?, 0, 0, ?, ?)",
+		book.Title, book.CategoryID, book.AuthorID, sqliteDate(book.PublishedDate), book.ISBN, book.CreatedAt.UTC(), book.UpdatedAt.UTC())
+	if isSQLiteUniqueViolation(err) {
+		return errDuplicate
+	} else if err != nil {
+		return fmt.Errorf("failed to insert book: %v", err)
+	}
+	return nil
+}
+
+func (s *sqliteBookStore) List(ctx context.Context, min int, max int) ([]*proto.BookMin, error) {
+	return listBooks(ctx, s.db, "SELECT "+bookMinColumns+" FROM books WHERE book_id BETWEEN ? AND ? ORDER BY book_id", min, max)
+}
+
+// published_date holds YYYY-MM-DD, which compares like the date
+func (s *sqliteBookStore) ListByPublishedDate(ctx context.Context, start string, end string) ([]*proto.BookMin, error) {
+	return listBooks(ctx, s.db, "SELECT "+bookMinColumns+" FROM books WHERE published_date BETWEEN ? AND ? ORDER BY published_date, book_id", start, end)
+}
+
+func (s *sqliteBookStore) Search(ctx context.Context, title string) ([]*proto.BookMin, error) {
+	return listBooks(ctx, s.db, "SELECT "+bookMinColumns+` FROM books WHERE title LIKE ? ESCAPE '\' ORDER BY book_id`, likePattern(title))
+}
+
+func (s *sqliteBookStore) Get(ctx context.Context, bookID int) (models.Book, error) {
+	var book models.Book
+	err := s.db.QueryRowContext(ctx, "SELECT title, category_id, author_id, published_date, isbn, total_stock, available_stock, created_at, updated_at FROM books WHERE book_id = ?", bookID).
+		Scan(&book.Title, &book.CategoryID, &book.AuthorID, &book.PublishedDate, &book.ISBN, &book.TotalStock, &book.AvailableStock, &book.CreatedAt, &book.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return book, errNotFound
+	} else if err != nil {
+		return book, fmt.Errorf("failed to get book: %v", err)
+	}
+	return book, nil
+}
+
+func (s *sqliteBookStore) Update(ctx context.Context, book models.UpdateBook) error {
+	publishedDate, err := parseSQLiteDate(book.NewPublishedDate)
+	if err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, "UPDATE books SET title = ?, category_id = ?, author_id = ?, published_date = ?, isbn = ?, updated_at = ? WHERE book_id = ?",
+		book.NewTitle, book.NewCategoryID, book.NewAuthorID, publishedDate, book.NewISBN, book.UpdatedAt.UTC(), book.BookID)
+	if isSQLiteUniqueViolation(err) {
+		return errDuplicate
+	} else if err != nil {
+		return fmt.Errorf("failed to update book: %v", err)
+	}
+	return checkAffected(res)
+}
+
+func (s *sqliteBookStore) Delete(ctx context.Context, bookID int) error {
+	return inTx(ctx, s.db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "DELETE FROM items WHERE book_id = ?", bookID)
+		if err != nil {
+			return fmt.Errorf("failed to delete items: %v", err)
+		}
+		res, err := tx.ExecContext(ctx, "DELETE FROM books WHERE book_id = ?", bookID)
+		if err != nil {
+			return fmt.Errorf("failed to delete book: %v", err)
+		}
+		return checkAffected(res)
+	})
+}
+
+func (s *sqliteBookStore) Exists(ctx context.Context, bookID int) (bool, error) {
+	return rowExists(ctx, s.db, "SELECT 1 FROM books WHERE book_id = ? LIMIT 1", bookID)
+}
+
+func (s *sqliteBookStore) IsAuthorInUse(ctx context.Context, authorID int) (bool, error) {
+	return rowExists(ctx, s.db, "SELECT 1 FROM books WHERE author_id = ? LIMIT 1", authorID)
+}
+
+func (s *sqliteBookStore) IsCategoryInUse(ctx context.Context, categoryID int) (bool, error) {
+	return rowExists(ctx, s.db, "SELECT 1 FROM books WHERE category_id = ? LIMIT 1", categoryID)
+}
+
+func (s *sqliteBookStore) Recommend(ctx context.Context, categoryID int, limit int) ([]*proto.BookMin, error) {
+	return listBooks(ctx, s.db, "SELECT "+bookMinColumns+" FROM books WHERE category_id = ? ORDER BY RANDOM() LIMIT ?", categoryID, limit)
+}
+
+// there are no branches to check and no holds to serve, the copy goes on the shelf
+func (s *sqliteBookStore) AddItem(ctx context.Context, req *proto.Item) (*proto.Item, error) {
+	var item *proto.Item
+	err := inTx(ctx, s.db, func(tx *sql.Tx) error {
+		var scan int
+		err := tx.QueryRowContext(ctx, "SELECT 1 FROM books WHERE book_id = ?", req.BookId).Scan(&scan)
+		if err == sql.ErrNoRows {
+			return errBookNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to get book: %v", err)
+		}
+
+		now := time.Now().UTC()
+		var itemID int
+		err = tx.QueryRowContext(ctx, "INSERT INTO items (book_id, barcode, condition, status, shelf_location, created_at, updated_at, branch_id) VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?6, ?7) RETURNING item_id",
+			req.BookId, req.Barcode, req.Condition, ItemOnLoan, req.ShelfLocation, now, req.BranchId).Scan(&itemID)
+		if isSQLiteUniqueViolation(err) {
+			return status.Errorf(codes.AlreadyExists, "barcode %s is already in use", req.Barcode)
+		} else if err != nil {
+			return fmt.Errorf("failed to insert item: %v", err)
+		}
+		if err := sqlitePutBackCopy(ctx, tx, int(req.BookId), sql.NullInt64{Int64: int64(itemID), Valid: true}); err != nil {
+			return err
+		}
+
+		item, err = scanItem(tx.QueryRowContext(ctx, "SELECT "+itemColumns+" FROM items WHERE item_id = ?", itemID))
+		if err != nil {
+			return fmt.Errorf("failed to get item: %v", err)
+		}
+		return nil
+	})
+	return item, err
+}
+
+type sqliteBorrowStore struct {
+	db *sql.DB
+}
+
+// every borrow is under defaultCirculationPolicy and due after its loan period
+func (s *sqliteBorrowStore) Create(ctx context.Context, barcode string, userID int, branchID int, role string, borrowedDate time.Time) (int, time.Time, error) {
+	policy := defaultCirculationPolicy
+	returnDate := borrowedDate.AddDate(0, 0, policy.LoanDays)
+	var borrowingID int
+	err := inTx(ctx, s.db, func(tx *sql.Tx) error {
+		var itemID, bookID, itemBranchID int
+		var itemStatus string
+		err := tx.QueryRowContext(ctx, "SELECT item_id, book_id, status, COALESCE(branch_id, 0) FROM items WHERE barcode = ?", barcode).Scan(&itemID, &bookID, &itemStatus, &itemBranchID)
+		if err == sql.ErrNoRows {
+			return errItemNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to get item: %v", err)
+		}
+
+		var loans int
+		if err := tx.QueryRowContext(ctx, "SELECT COUNT(*) FROM borrowing WHERE user_id = ? AND returned = FALSE", userID).Scan(&loans); err != nil {
+			return fmt.Errorf("failed to count loans: %v", err)
+		}
+		if loans >= policy.MaxLoans {
+			return errLoanLimit
+		}
+
+		// the copy has to be at the desk it is checked out from
+		if branchID == 0 {
+			branchID = itemBranchID
+		} else if itemBranchID != branchID {
+			return errItemElsewhere
+		}
+		if itemStatus != ItemAvailable {
+			return errItemUnavailable
+		}
+		if err := sqliteSetItemStatus(ctx, tx, bookID, itemID, ItemOnLoan); err != nil {
+			return err
+		}
+
+		err = tx.QueryRowContext(ctx, "INSERT INTO borrowing (book_id, item_id, user_id, borrowed_date, return_date, returned, loan_days, renewals_allowed, branch_id) VALUES (?, ?, ?, ?, ?, FALSE, ?, ?, ?) RETURNING borrowing_id",
+			bookID, itemID, userID, borrowedDate.UTC(), returnDate.UTC(), policy.LoanDays, policy.Renewals, branchID).Scan(&borrowingID)
+		if err != nil {
+			return fmt.Errorf("failed to insert borrow: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return borrowingID, returnDate, nil
+}
+
+// every day counts as open, the fine goes to the fines ledger
+func (s *sqliteBorrowStore) Return(ctx context.Context, borrowingID int, branchID int, returnedDate time.Time) (int, error) {
+	var fine int
+	err := inTx(ctx, s.db, func(tx *sql.Tx) error {
+		var bookID, userID int
+		var itemID sql.NullInt64
+		var returnDate time.Time
+		err := tx.QueryRowContext(ctx, "UPDATE borrowing SET returned = TRUE, returned_date = ?, return_branch_id = NULLIF(?, 0) WHERE borrowing_id = ? AND returned = FALSE RETURNING book_id, user_id, item_id, return_date",
+			returnedDate.UTC(), branchID, borrowingID).Scan(&bookID, &userID, &itemID, &returnDate)
+		if err == sql.ErrNoRows {
+			return errBorrowNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to return borrow: %v", err)
+		}
+
+		late := daysLate(returnDate, returnedDate)
+		if fine = fineAmount(late); fine > 0 {
+			_, err = tx.ExecContext(ctx, "INSERT INTO fines (borrowing_id, book_id, user_id, days_late, amount_cents, paid_cents, status, created_at) VALUES (?, ?, ?, ?, ?, 0, ?, ?)",
+				borrowingID, bookID, userID, late, fine, FineOutstanding, time.Now().UTC())
+			if err != nil {
+				return fmt.Errorf("failed to insert fine: %v", err)
+			}
+		}
+
+		// the copy stays at the branch it is returned to
+		if itemID.Valid && branchID != 0 {
+			if _, err := tx.ExecContext(ctx, "UPDATE items SET branch_id = ? WHERE item_id = ?", branchID, itemID.Int64); err != nil {
+				return fmt.Errorf("failed to update item: %v", err)
+			}
+		}
+		return sqlitePutBackCopy(ctx, tx, bookID, itemID)
+	})
+	return fine, err
+}
+
+func (s *sqliteBorrowStore) OutstandingFines(ctx context.Context, userID int) (int, error) {
+	var balance int
+	err := s.db.QueryRowContext(ctx, "SELECT COALESCE(SUM(amount_cents - paid_cents), 0) FROM fines WHERE user_id = ? AND status = ?", userID, FineOutstanding).Scan(&balance)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get outstanding fines: %v", err)
+	}
+	return balance, nil
+}
+
+func (s *sqliteBorrowStore) List(ctx context.Context, filter borrowFilter) ([]*proto.BorrowOrReturnMin, error) {
+	query := "SELECT borrowing_id, book_id, user_id, borrowed_date FROM borrowing WHERE returned = ?"
+	args := []any{filter.Returned}
+	switch {
+	case filter.UserID != 0:
+		query += " AND user_id = ?"
+		args = append(args, filter.UserID)
+	case filter.StartDate != "" || filter.EndDate != "":
+		query += " AND julianday(borrowed_date) BETWEEN julianday(?) AND julianday(?)"
+		args = append(args, filter.StartDate, filter.EndDate)
+	default:
+		query += " AND borrowing_id BETWEEN ? AND ?"
+		args = append(args, filter.MinID, filter.MaxID)
+	}
+	query += " ORDER BY borrowing_id"
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get borrowings: %v", err)
+	}
+	defer rows.Close()
+
+	var borrowOrReturnMins []*proto.BorrowOrReturnMin
+	for rows.Next() {
+		var borrowOrReturnMin proto.BorrowOrReturnMin
+		var borrowedDate time.Time
+		if err := rows.Scan(&borrowOrReturnMin.BorrowingId, &borrowOrReturnMin.BookId, &borrowOrReturnMin.UserId, &borrowedDate); err != nil {
+			return nil, fmt.Errorf("failed to scan borrowings: %v", err)
+		}
+		borrowOrReturnMin.BorrowedDate = borrowedDate.Format(time.RFC3339)
+		borrowOrReturnMins = append(borrowOrReturnMins, &borrowOrReturnMin)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get borrowings: %v", err)
+	}
+	return borrowOrReturnMins, nil
+}
+
+// without calendars every day counts as open
+func (s *sqliteBorrowStore) Overdue(ctx context.Context, asOf time.Time, start *time.Time, end *time.Time) ([]overdueBorrow, error) {
+	query := "SELECT borrowing_id, book_id, user_id, COALESCE(branch_id, 0), borrowed_date, return_date FROM borrowing WHERE julianday(return_date) < julianday(?1) AND julianday(borrowed_date) <= julianday(?1) AND (returned = FALSE OR julianday(returned_date) > julianday(?1))"
+	args := []any{asOf.UTC()}
+	if start != nil {
+		args = append(args, start.UTC())
+		query += fmt.Sprintf(" AND julianday(return_date) >= julianday(?%d)", len(args))
+	}
+	if end != nil {
+		args = append(args, end.UTC())
+		query += fmt.Sprintf(" AND julianday(return_date) < julianday(?%d)", len(args))
+	}
+	query += " ORDER BY julianday(return_date), borrowing_id"
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get overdues: %v", err)
+	}
+	defer rows.Close()
+
+	var overdues []overdueBorrow
+	for rows.Next() {
+		var overdue overdueBorrow
+		if err := rows.Scan(&overdue.BorrowingID, &overdue.BookID, &overdue.UserID, &overdue.BranchID, &overdue.BorrowedDate, &overdue.ReturnDate); err != nil {
+			return nil, fmt.Errorf("failed to scan overdues: %v", err)
+		}
+		overdue.DaysLate = daysLate(overdue.ReturnDate, asOf)
+		overdues = append(overdues, overdue)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get overdues: %v", err)
+	}
+	return overdues, nil
+}
+
+func (s *sqliteBorrowStore) StillBorrows(ctx context.Context, userID int) (bool, error) {
+	return rowExists(ctx, s.db, "SELECT 1 FROM borrowing WHERE user_id = ? AND returned = FALSE LIMIT 1", userID)
+}
+
+func (s *sqliteBorrowStore) Update(ctx context.Context, borrow models.UpdateBorrow, returned bool) error {
+	borrowedDate, err := time.Parse("2006-01-02", borrow.NewBorrowedDate)
+	if err != nil {
+		return err
+	}
+	returnDate, err := time.Parse("2006-01-02", borrow.NewReturnDate)
+	if err != nil {
+		return err
+	}
+	return inTx(ctx, s.db, func(tx *sql.Tx) error {
+		var oldBookID int
+		var oldReturned bool
+		var itemID sql.NullInt64
+		err := tx.QueryRowContext(ctx, "SELECT book_id, returned, item_id FROM borrowing WHERE borrowing_id = ?", borrow.BorrowingID).Scan(&oldBookID, &oldReturned, &itemID)
+		if err == sql.ErrNoRows {
+			return errBorrowNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to get borrow: %v", err)
+		}
+
+		// the same copy stays out when only dates or the borrower change
+		keepItem := !oldReturned && !returned && oldBookID == borrow.NewBookID
+		if !oldReturned && !keepItem {
+			if err := sqlitePutBackCopy(ctx, tx, oldBookID, itemID); err != nil {
+				return err
+			}
+		}
+		if !returned && !keepItem {
+			newItemID, err := sqliteTakeCopy(ctx, tx, borrow.NewBookID)
+			if err != nil {
+				return err
+			}
+			itemID = sql.NullInt64{Int64: int64(newItemID), Valid: true}
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE borrowing SET book_id = ?, item_id = ?, user_id = ?, borrowed_date = ?, return_date = ?, returned = ? WHERE borrowing_id = ?",
+			borrow.NewBookID, itemID, borrow.NewUserID, borrowedDate, returnDate, returned, borrow.BorrowingID)
+		if err != nil {
+			return fmt.Errorf("failed to update borrow: %v", err)
+		}
+		return nil
+	})
+}
+
+func (s *sqliteBorrowStore) Delete(ctx context.Context, borrowingID int) error {
+	return inTx(ctx, s.db, func(tx *sql.Tx) error {
+		var bookID int
+		var returned bool
+		var itemID sql.NullInt64
+		err := tx.QueryRowContext(ctx, "DELETE FROM borrowing WHERE borrowing_id = ? RETURNING book_id, returned, item_id", borrowingID).Scan(&bookID, &returned, &itemID)
+		if err == sql.ErrNoRows {
+			return errBorrowNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to delete borrow: %v", err)
+		}
+		if returned {
+			return nil
+		}
+		return sqlitePutBackCopy(ctx, tx, bookID, itemID)
+	})
+}
+
+func (s *sqliteBookStore) ListItems(ctx context.Context, bookID int, itemStatus string, branchID int) ([]*proto.Item, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT "+itemColumns+" FROM items WHERE (?1 = 0 OR book_id = ?1) AND (?2 = '' OR status = ?2) AND (?3 = 0 OR branch_id = ?3) ORDER BY book_id, item_id",
+		bookID, itemStatus, branchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get items: %v", err)
+	}
+	defer rows.Close()
+
+	var items []*proto.Item
+	for rows.Next() {
+		item, err := scanItem(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan item: %v", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get items: %v", err)
+	}
+	return items, nil
+}
+
+// applies change to the item with the barcode in a transaction, like changeItem
+func (s *sqliteBookStore) changeItem(ctx context.Context, barcode string, change func(tx *sql.Tx, bookID int, itemID int, itemStatus string) error) (*proto.Item, error) {
+	var item *proto.Item
+	err := inTx(ctx, s.db, func(tx *sql.Tx) error {
+		var bookID, itemID int
+		var itemStatus string
+		err := tx.QueryRowContext(ctx, "SELECT book_id, item_id, status FROM items WHERE barcode = ?", barcode).Scan(&bookID, &itemID, &itemStatus)
+		if err == sql.ErrNoRows {
+			return errItemNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to get item: %v", err)
+		}
+		if err := change(tx, bookID, itemID, itemStatus); err != nil {
+			return err
+		}
+		item, err = scanItem(tx.QueryRowContext(ctx, "SELECT "+itemColumns+" FROM items WHERE item_id = ?", itemID))
+		if err != nil {
+			return fmt.Errorf("failed to get item: %v", err)
+		}
+		return nil
+	})
+	return item, err
+}
+
+// a lost copy on loan stays on the borrow, returning it later puts it back in circulation
+func (s *sqliteBookStore) MarkItemLost(ctx context.Context, barcode string) (*proto.Item, error) {
+	return s.changeItem(ctx, barcode, func(tx *sql.Tx, bookID int, itemID int, itemStatus string) error {
+		if itemStatus == ItemLost {
+			return status.Error(codes.FailedPrecondition, "item is already lost")
+		}
+		return sqliteSetItemStatus(ctx, tx, bookID, itemID, ItemLost)
+	})
+}
+
+// a damaged copy on loan stays on the borrow and leaves circulation when it is returned
+func (s *sqliteBookStore) MarkItemDamaged(ctx context.Context, barcode string) (*proto.Item, error) {
+	return s.changeItem(ctx, barcode, func(tx *sql.Tx, bookID int, itemID int, itemStatus string) error {
+		if itemStatus == ItemDamaged {
+			return status.Error(codes.FailedPrecondition, "item is already damaged")
+		}
+		_, err := tx.ExecContext(ctx, "UPDATE items SET condition = ?, updated_at = ? WHERE item_id = ?", ConditionDamaged, time.Now().UTC(), itemID)
+		if err != nil {
+			return fmt.Errorf("failed to update item: %v", err)
+		}
+		if itemStatus == ItemAvailable {
+			return sqliteSetItemStatus(ctx, tx, bookID, itemID, ItemDamaged)
+		}
+		return nil
+	})
+}
+
+// extends a borrow by the loan period it was made under, checkAccess sees the borrower before anything changes.
+// there are no holds to wait for and every day counts as open
+func (s *sqliteBorrowStore) Renew(ctx context.Context, borrowingID int, checkAccess func(userID int) error) (*proto.RenewedBorrow, error) {
+	var renewed proto.RenewedBorrow
+	err := inTx(ctx, s.db, func(tx *sql.Tx) error {
+		var userID, renewalCount, loanDays, renewalLimit int
+		var returnDate time.Time
+		err := tx.QueryRowContext(ctx, "SELECT user_id, return_date, renewal_count, COALESCE(loan_days, ?2), COALESCE(renewals_allowed, ?3) FROM borrowing WHERE borrowing_id = ?1 AND returned = FALSE",
+			borrowingID, defaultCirculationPolicy.LoanDays, defaultCirculationPolicy.Renewals).Scan(&userID, &returnDate, &renewalCount, &loanDays, &renewalLimit)
+		if err == sql.ErrNoRows {
+			return errBorrowNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to get borrow: %v", err)
+		}
+		if err := checkAccess(userID); err != nil {
+			return err
+		}
+
+		if renewalCount >= renewalLimit {
+			return errRenewalLimit
+		}
+		if time.Now().After(returnDate.Add(renewalOverdueGrace)) {
+			return errRenewalOverdue
+		}
+
+		newReturnDate := returnDate.AddDate(0, 0, loanDays)
+		// the renewal count in the WHERE keeps two concurrent renewals from both passing the limit
+		res, err := tx.ExecContext(ctx, "UPDATE borrowing SET return_date = ?, renewal_count = renewal_count + 1, last_renewed_at = ? WHERE borrowing_id = ? AND renewal_count = ?",
+			newReturnDate.UTC(), time.Now().UTC(), borrowingID, renewalCount)
+		if err != nil {
+			return fmt.Errorf("failed to renew borrow: %v", err)
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return errRenewalLimit
+		}
+
+		renewed.BorrowingId = int32(borrowingID)
+		renewed.ReturnDate = newReturnDate.Format("2006-01-02 15:04:05")
+		renewed.RenewalCount = int32(renewalCount + 1)
+		renewed.RenewalsLeft = int32(renewalLimit - renewalCount - 1)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &renewed, nil
+}
+
+func (s *sqliteBorrowStore) ListFines(ctx context.Context, userID int) ([]*proto.Fine, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT "+fineColumns+" FROM fines WHERE user_id = ? ORDER BY fine_id", userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fines: %v", err)
+	}
+	defer rows.Close()
+
+	var fines []*proto.Fine
+	for rows.Next() {
+		fine, err := scanFine(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan fine: %v", err)
+		}
+		fines = append(fines, fine)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to get fines: %v", err)
+	}
+	return fines, nil
+}
+
+// records a payment on an outstanding fine like payFine, partial payments keep it outstanding
+func (s *sqliteBorrowStore) PayFine(ctx context.Context, fineID int, amountCents int) (*proto.Fine, error) {
+	var fine *proto.Fine
+	err := inTx(ctx, s.db, func(tx *sql.Tx) error {
+		var amount, paid int
+		var fineStatus string
+		err := tx.QueryRowContext(ctx, "SELECT amount_cents, paid_cents, status FROM fines WHERE fine_id = ?", fineID).Scan(&amount, &paid, &fineStatus)
+		if err == sql.ErrNoRows {
+			return errFineNotFound
+		} else if err != nil {
+			return fmt.Errorf("failed to get fine: %v", err)
+		}
+		if fineStatus != FineOutstanding {
+			return status.Errorf(codes.FailedPrecondition, "fine is already %s", fineStatus)
+		}
+		if amountCents > amount-paid {
+			return status.Errorf(codes.InvalidArgument, "payment exceeds the %d cents still owed", amount-paid)
+		}
+
+		paid += amountCents
+		var closedAt sql.NullTime
+		if paid == amount {
+			fineStatus = FinePaid
+			closedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+		}
+		// the paid amount in the WHERE keeps a concurrent payment from being counted twice
+		res, err := tx.ExecContext(ctx, "UPDATE fines SET paid_cents = ?, status = ?, closed_at = ? WHERE fine_id = ? AND paid_cents = ? AND status = ?",
+			paid, fineStatus, closedAt, fineID, paid-amountCents, FineOutstanding)
+		if err != nil {
+			return fmt.Errorf("failed to update fine: %v", err)
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return status.Error(codes.Aborted, "fine changed during the payment, try again")
+		}
+
+		fine, err = scanFine(tx.QueryRowContext(ctx, "SELECT "+fineColumns+" FROM fines WHERE fine_id = ?", fineID))
+		if err != nil {
+			return fmt.Errorf("failed to get fine: %v", err)
+		}
+		return nil
+	})
+	return fine, err
+}
+
+func (s *sqliteBorrowStore) WaiveFine(ctx context.Context, fineID int, note string) (*proto.Fine, error) {
+	fine, err := scanFine(s.db.QueryRowContext(ctx, "UPDATE fines SET status = ?, note = ?, closed_at = ? WHERE fine_id = ? AND status = ? RETURNING "+fineColumns,
+		FineWaived, note, time.Now().UTC(), fineID, FineOutstanding))
+	if err == sql.ErrNoRows {
+		var fineStatus string
+		err = s.db.QueryRowContext(ctx, "SELECT status FROM fines WHERE fine_id = ?", fineID).Scan(&fineStatus)
+		if err == sql.ErrNoRows {
+			return nil, errFineNotFound
+		} else if err == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "fine is already %s", fineStatus)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to waive fine: %v", err)
+	}
+	return fine, nil
+}
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	database "gogrpc-rpc-boiler/server/db"
+	"gogrpc-rpc-boiler/server/models"
+)
+
+// the SQLite stores run on a file in a temporary directory, these always run
+
+func openTestSQLite(t *testing.T) *sql.DB {
+	t.Setenv("SQLITE_PATH", filepath.Join(t.TempDir(), "library.db"))
+	database.ConnectSQLite()
+	db := database.SQLiteDB
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestSQLiteStoresKeepUniqueConstraints(t *testing.T) {
+	db := openTestSQLite(t)
+	ctx := context.Background()
+
+	users := &sqliteUserStore{db: db}
+	if _, err := users.Create(ctx, models.UserSensitive{Username: "alice", Email: "alice@example.com", Role: RoleMember}); err != nil {
+		t.Fatal(err)
+	}
+	for _, user := range []models.UserSensitive{
+		{Username: "alice", Email: "other@example.com"},
+		{Username: "bob", Email: "alice@example.com"},
+	} {
+		if _, err := users.Create(ctx, user); err != errDuplicate {
+			t.Fatalf("user %s <%s>: got %v, want errDuplicate", user.Username, user.Email, err)
+		}
+	}
+
+	categories := &sqliteCategoryStore{db: db}
+	for _, name := range []string{"fiction", "poetry"} {
+		if err := categories.Create(ctx, models.Category{Name: name}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := categories.Create(ctx, models.Category{Name: "fiction"}); err != errDuplicate {
+		t.Fatalf("second category fiction: got %v, want errDuplicate", err)
+	}
+	if err := categories.Update(ctx, models.UpdateCategory{CategoryID: 2, NewName: "fiction"}); err != errDuplicate {
+		t.Fatalf("rename to a taken name: got %v, want errDuplicate", err)
+	}
+	if err := categories.Update(ctx, models.UpdateCategory{CategoryID: 9, NewName: "drama"}); err != errNotFound {
+		t.Fatalf("update of a missing category: got %v, want errNotFound", err)
+	}
+
+	books := &sqliteBookStore{db: db}
+	isbn := "9780000000001"
+	published := time.Date(2001, 2, 3, 0, 0, 0, 0, time.UTC)
+	if err := books.Create(ctx, models.Book{Title: "first", ISBN: &isbn, PublishedDate: &published}); err != nil {
+		t.Fatal(err)
+	}
+	if err := books.Create(ctx, models.Book{Title: "second", ISBN: &isbn}); err != errDuplicate {
+		t.Fatalf("second book with ISBN %s: got %v, want errDuplicate", isbn, err)
+	}
+	if _, err := books.Get(ctx, 9); err != errNotFound {
+		t.Fatalf("get of a missing book: got %v, want errNotFound", err)
+	}
+	if bookMins, err := books.ListByPublishedDate(ctx, "2001-02-03", "2001-02-03"); err != nil || len(bookMins) != 1 {
+		t.Fatalf("books published on 2001-02-03: got %d, %v, want the first one", len(bookMins), err)
+	}
+
+	if _, err := books.AddItem(ctx, &proto.Item{BookId: 1, Barcode: "S1", Condition: ConditionGood, BranchId: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := books.AddItem(ctx, &proto.Item{BookId: 1, Barcode: "S1", Condition: ConditionGood, BranchId: 1}); err == nil {
+		t.Fatal("second copy with barcode S1 was added")
+	}
+	if _, err := db.Exec("UPDATE books SET available_stock = -1 WHERE book_id = 1"); err == nil {
+		t.Fatal("available_stock went below zero")
+	}
+}
+
+func TestSQLiteBorrowsNeverOversell(t *testing.T) {
+	db := openTestSQLite(t)
+	ctx := context.Background()
+	books := &sqliteBookStore{db: db}
+	borrows := &sqliteBorrowStore{db: db}
+
+	const stock, borrowers = 3, 30
+	if err := books.Create(ctx, models.Book{Title: "sqlite test"}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < stock; i++ {
+		if _, err := books.AddItem(ctx, &proto.Item{BookId: 1, Barcode: fmt.Sprintf("S%d", i), Condition: ConditionGood, BranchId: 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var borrowed []int
+	for i := 0; i < borrowers; i++ {
+		wg.Add(1)
+		go func(userID int, barcode string) {
+			defer wg.Done()
+			borrowingID, _, err := borrows.Create(ctx, barcode, userID, 0, RoleMember, time.Now())
+			if err == errItemUnavailable {
+				return
+			} else if err != nil {
+				t.Errorf("borrow failed: %v", err)
+				return
+			}
+			mu.Lock()
+			borrowed = append(borrowed, borrowingID)
+			mu.Unlock()
+		}(i+1, fmt.Sprintf("S%d", i%stock))
+	}
+	wg.Wait()
+
+	book, err := books.Get(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(borrowed) != stock || book.AvailableStock != 0 {
+		t.Fatalf("got %d borrows and available_stock %d, want %d and 0", len(borrowed), book.AvailableStock, stock)
+	}
+	if err := borrows.Update(ctx, models.UpdateBorrow{BorrowingID: borrowed[0], NewBookID: 1, NewUserID: 99, NewBorrowedDate: "2024-01-01", NewReturnDate: "2024-01-15"}, false); err != nil {
+		t.Fatalf("edit keeping the copy: %v", err)
+	}
+	overdues, err := borrows.Overdue(ctx, time.Now(), nil, nil)
+	if err != nil || len(overdues) != 1 || overdues[0].UserID != 99 {
+		t.Fatalf("overdues: got %+v, %v, want the edited borrow", overdues, err)
+	}
+
+	for _, borrowingID := range borrowed {
+		if _, err := borrows.Return(ctx, borrowingID, 0, time.Now()); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := borrows.Return(ctx, borrowingID, 0, time.Now()); err != errBorrowNotFound {
+			t.Fatalf("second return of %d: got %v, want errBorrowNotFound", borrowingID, err)
+		}
+	}
+	if book, _ = books.Get(ctx, 1); book.AvailableStock != stock || book.TotalStock != stock {
+		t.Fatalf("stock %d/%d after all returns, want %d/%d", book.AvailableStock, book.TotalStock, stock, stock)
+	}
+	// the edited borrow was due in January 2024, its return was charged
+	if fines, _ := borrows.OutstandingFines(ctx, 99); fines != fineCapPerItem {
+		t.Fatalf("fines of user 99 = %d, want the cap of %d", fines, fineCapPerItem)
+	}
+}
+
+func TestSQLiteRenewalsItemsAndFines(t *testing.T) {
+	db := openTestSQLite(t)
+	ctx := context.Background()
+	books := &sqliteBookStore{db: db}
+	borrows := &sqliteBorrowStore{db: db}
+	allow := func(int) error { return nil }
+
+	if err := books.Create(ctx, models.Book{Title: "renewed"}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := books.AddItem(ctx, &proto.Item{BookId: 1, Barcode: fmt.Sprintf("R%d", i), Condition: ConditionGood, BranchId: 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	borrowingID, returnDate, err := borrows.Create(ctx, "R0", 1, 0, RoleMember, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= defaultCirculationPolicy.Renewals; i++ {
+		renewed, err := borrows.Renew(ctx, borrowingID, allow)
+		if err != nil {
+			t.Fatalf("renewal %d: %v", i, err)
+		}
+		want := returnDate.AddDate(0, 0, i*defaultCirculationPolicy.LoanDays).Format("2006-01-02 15:04:05")
+		if renewed.ReturnDate != want || renewed.RenewalCount != int32(i) {
+			t.Fatalf("renewal %d: got %s and count %d, want %s and %d", i, renewed.ReturnDate, renewed.RenewalCount, want, i)
+		}
+	}
+	if _, err := borrows.Renew(ctx, borrowingID, allow); err != errRenewalLimit {
+		t.Fatalf("renewal past the limit: got %v, want errRenewalLimit", err)
+	}
+	overdueID, _, err := borrows.Create(ctx, "R1", 1, 0, RoleMember, time.Now().AddDate(0, -2, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := borrows.Renew(ctx, overdueID, allow); err != errRenewalOverdue {
+		t.Fatalf("renewal of an overdue borrow: got %v, want errRenewalOverdue", err)
+	}
+
+	if _, err := books.MarkItemDamaged(ctx, "R2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := books.MarkItemDamaged(ctx, "R2"); err == nil {
+		t.Fatal("damaged a copy twice")
+	}
+	if _, err := books.MarkItemLost(ctx, "R9"); err != errItemNotFound {
+		t.Fatalf("lost of a missing copy: got %v, want errItemNotFound", err)
+	}
+	if items, err := books.ListItems(ctx, 1, ItemAvailable, 0); err != nil || len(items) != 0 {
+		t.Fatalf("available copies: got %d, %v, want none", len(items), err)
+	}
+	if book, _ := books.Get(ctx, 1); book.AvailableStock != 0 || book.TotalStock != 2 {
+		t.Fatalf("stock %d/%d with two loans and a damaged copy, want 0/2", book.AvailableStock, book.TotalStock)
+	}
+
+	for _, id := range []int{borrowingID, overdueID} {
+		if _, err := borrows.Return(ctx, id, 0, time.Now()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fines, err := borrows.ListFines(ctx, 1)
+	if err != nil || len(fines) != 1 || int(fines[0].AmountCents) != fineCapPerItem {
+		t.Fatalf("fines: got %v, %v, want one of %d cents for the overdue borrow", fines, err, fineCapPerItem)
+	}
+	fineID := int(fines[0].FineId)
+	if fine, err := borrows.PayFine(ctx, fineID, 100); err != nil || fine.Status != FineOutstanding || fine.PaidCents != 100 {
+		t.Fatalf("partial pay = %v, %v, want 100 cents paid and still %s", fine, err, FineOutstanding)
+	}
+	if _, err := borrows.PayFine(ctx, fineID, fineCapPerItem); err == nil {
+		t.Fatal("paid more than the fine")
+	}
+	if fine, err := borrows.WaiveFine(ctx, fineID, "waived by admin: test"); err != nil || fine.Status != FineWaived {
+		t.Fatalf("waive = %v, %v, want status %s", fine, err, FineWaived)
+	}
+	if _, err := borrows.PayFine(ctx, fineID, 1); err == nil {
+		t.Fatal("paid a waived fine")
+	}
+	if _, err := borrows.WaiveFine(ctx, fineID+1, "missing"); err != errFineNotFound {
+		t.Fatalf("waive of a missing fine = %v, want %v", err, errFineNotFound)
+	}
+	if owed, _ := borrows.OutstandingFines(ctx, 1); owed != 0 {
+		t.Fatalf("outstanding after waive = %d, want 0", owed)
+	}
+}
+
+func TestSQLiteMigrationsGoDownAndUp(t *testing.T) {
+	db := openTestSQLite(t)
+	// one migration at a time, down to none
+	for reverted := true; reverted; {
+		var err error
+		if reverted, err = database.MigrateDown(db, "sqlite"); err != nil {
+			t.Fatalf("down: %v", err)
+		}
+	}
+	states, err := database.MigrationStatus(db, "sqlite")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, state := range states {
+		if state.Applied {
+			t.Fatalf("migration %s is applied after reverting all", state.Name)
+		}
+	}
+	if exists, _ := rowExists(context.Background(), db, "SELECT 1 FROM sqlite_master WHERE name = 'books'"); exists {
+		t.Fatal("books is still there after down")
+	}
+	if err := database.MigrateUp(db, "sqlite"); err != nil {
+		t.Fatal(err)
+	}
+	if states, err = database.MigrationStatus(db, "sqlite"); err != nil {
+		t.Fatal(err)
+	}
+	for _, state := range states {
+		if !state.Applied {
+			t.Fatalf("migration %s is pending after up", state.Name)
+		}
+	}
+	if err := (&sqliteCategoryStore{db: db}).Create(context.Background(), models.Category{Name: "fiction"}); err != nil {
+		t.Fatalf("schema after down and up: %v", err)
+	}
+}
+
+func TestMigrationStatusOfAnEmptyDatabaseOnlyReads(t *testing.T) {
+	db, err := sql.Open("sqlite", "file:"+filepath.Join(t.TempDir(), "empty.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	states, err := database.MigrationStatus(db, "sqlite")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, state := range states {
+		if state.Applied {
+			t.Fatalf("migration %s is applied to an empty database", state.Name)
+		}
+	}
+	if exists, _ := rowExists(context.Background(), db, "SELECT 1 FROM sqlite_master WHERE name = 'schema_migrations'"); exists {
+		t.Fatal("status created schema_migrations")
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	jwtgenerator "gogrpc-rpc-boiler/server/jwt"
+	logger "gogrpc-rpc-boiler/server/log"
+	"gogrpc-rpc-boiler/server/notify"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// what users with an unverified email may do, from UNVERIFIED_EMAIL_POLICY
+const (
+	UnverifiedAllow       = "allow"        // everything
+	UnverifiedBlockBorrow = "block_borrow" // log in, but no CreateBorrow (default)
+	UnverifiedBlockLogin  = "block_login"  // nothing, LoginAuth is refused
+)
+
+var unverifiedEmailPolicy = unverifiedPolicyFromEnv()
+
+func unverifiedPolicyFromEnv() string {
+	switch policy := os.Getenv("UNVERIFIED_EMAIL_POLICY"); policy {
+	case UnverifiedAllow, UnverifiedBlockLogin:
+		return policy
+	default:
+		return UnverifiedBlockBorrow
+	}
+}
+
+func verificationMessage(email string, username string, token string) notify.Message {
+	body := fmt.Sprintf("Hi %s,\n\nuse this token to verify your email address, it expires in %s:\n\n%s\n", username, jwtgenerator.EmailVerificationTTL, token)
+	if url := os.Getenv("EMAIL_VERIFICATION_URL"); url != "" {
+		body += fmt.Sprintf("\nor open %s?token=%s\n", url, token)
+	}
+	return notify.Message{To: email, Subject: "Verify your email address", Body: body}
+}
+
+func sendVerification(ctx context.Context, userID int, username string, email string) error {
+	token, err := jwtgenerator.GenerateEmailVerificationToken(userID, email)
+	if err != nil {
+		return fmt.Errorf("failed to generate verification token: %v", err)
+	}
+	if err := notifier.Send(ctx, verificationMessage(email, username, token)); err != nil {
+		return fmt.Errorf("failed to send verification email: %v", err)
+	}
+	return nil
+}
+
+func (s *server) VerifyEmail(ctx context.Context, req *proto.StringRequest) (*proto.StringResponse, error) {
+	userID, email, err := jwtgenerator.ParseEmailVerificationToken(req.RequestStr)
+	if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired verification token")
+	}
+
+	// the token only verifies the address it was sent to
+	changed, err := s.users.VerifyEmail(ctx, userID, email, time.Now().UTC())
+	if err == errNotFound {
+		logger.LogThis(fmt.Sprintf("[ERROR] verification token for user_id %d does not match the account", userID))
+		return nil, status.Error(codes.InvalidArgument, "invalid or expired verification token")
+	} else if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+		return nil, err
+	}
+	if !changed {
+		return &proto.StringResponse{ResponseStr: "Email already verified"}, nil
+	}
+	logger.LogThis(fmt.Sprintf("[INFO] email verified for user_id %d", userID))
+
+	return &proto.StringResponse{ResponseStr: "Email verified successfully"}, nil
+}
+
+// sends a new verification token to the caller's own address
+func (s *server) ResendVerification(ctx context.Context, req *emptypb.Empty) (*proto.StringResponse, error) {
+	caller := callerFromContext(ctx)
+
+	user, err := s.users.GetCredentialsByID(ctx, caller.UserID)
+	if err == errNotFound {
+		logger.LogThis(fmt.Sprintf("[ERROR] user_id %d not found", caller.UserID))
+		return nil, fmt.Errorf("user not found")
+	} else if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+		return nil, err
+	}
+	if user.EmailVerified {
+		logger.LogThis(fmt.Sprintf("[ERROR] email of user_id %d is already verified", caller.UserID))
+		return nil, status.Error(codes.FailedPrecondition, "email already verified")
+	}
+
+	if err := sendVerification(ctx, caller.UserID, caller.Username, user.Email); err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] %v", err))
+		return nil, err
+	}
+
+	return &proto.StringResponse{ResponseStr: "Verification email sent"}, nil
+}
+
+func (s *server) IsEmailVerified(ctx context.Context, req *proto.IntRequest) (*proto.BoolResponse, error) {
+	if err := requireSelfOrRole(callerFromContext(ctx), int(req.RequestInt), staffRoles); err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.GetCredentialsByID(ctx, int(req.RequestInt))
+	if err == errNotFound {
+		return &proto.BoolResponse{ResponseBool: false}, nil
+	} else if err != nil {
+		logger.LogThis(fmt.Sprintf("[ERROR] failed to check email verification: %v", err))
+		return nil, fmt.Errorf("failed to check email verification: %v", err)
+	}
+	return &proto.BoolResponse{ResponseBool: user.EmailVerified}, nil
+}
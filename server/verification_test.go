@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	proto "gogrpc-rpc-boiler/proto"
+	jwtgenerator "gogrpc-rpc-boiler/server/jwt"
+	"gogrpc-rpc-boiler/server/models"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestVerificationTokenIsNotAnAccessToken(t *testing.T) {
+	useTestKeyring(t)
+	s, _, userID := newTestServer(t)
+
+	token, err := jwtgenerator.GenerateEmailVerificationToken(userID, "reader@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := validateBearer(s, token); err == nil {
+		t.Fatal("verification token was accepted as an access token")
+	}
+	if _, err := jwtgenerator.ParseAccessToken(token); err == nil {
+		t.Fatal("access token parser accepted a verification token")
+	}
+
+	pair, err := s.issueTokenPair(context.Background(), userID, "reader", RoleMember)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// another service verifying through the JWKS and requiring the access token audience
+	jwks := jwtgenerator.PublicJWKS()
+	x, err := base64.RawURLEncoding.DecodeString(jwks[0].X)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFunc := func(*jwt.Token) (interface{}, error) { return ed25519.PublicKey(x), nil }
+	if _, err := jwt.Parse(pair.AccessToken, keyFunc, jwt.WithAudience(jwtgenerator.AccessTokenAudience)); err != nil {
+		t.Fatalf("JWKS verifier rejected an access token: %v", err)
+	}
+	if _, err := jwt.Parse(token, keyFunc, jwt.WithAudience(jwtgenerator.AccessTokenAudience)); err == nil {
+		t.Fatal("JWKS verifier accepted a verification token as an access token")
+	}
+	if _, err := s.VerifyEmail(context.Background(), &proto.StringRequest{RequestStr: pair.AccessToken}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("verification with an access token = %v, want InvalidArgument", err)
+	}
+	if _, err := s.VerifyEmail(context.Background(), &proto.StringRequest{RequestStr: token}); err != nil {
+		t.Fatalf("verification = %v", err)
+	}
+}
+
+func TestUnverifiedMemberCannotBorrow(t *testing.T) {
+	useTestKeyring(t)
+	s, _, userID := newTestServer(t)
+	useTestInterService(t, s)
+	oldPolicy := unverifiedEmailPolicy
+	unverifiedEmailPolicy = UnverifiedBlockBorrow
+	t.Cleanup(func() { unverifiedEmailPolicy = oldPolicy })
+	ctx := context.Background()
+
+	if _, err := s.branches.Create(ctx, "main", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.books.Create(ctx, models.Book{Title: "verified only"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.books.AddItem(ctx, &proto.Item{BookId: 1, Barcode: "V1", Condition: ConditionGood, BranchId: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	pair, err := s.issueTokenPair(ctx, userID, "reader", RoleMember)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caller, err := validateBearer(s, pair.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	memberCtx := context.WithValue(metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+pair.AccessToken)), principalKey{}, caller)
+
+	if _, err := s.CreateBorrow(memberCtx, &proto.Borrow{UserId: int32(userID), Barcode: "V1"}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("borrow before verification = %v, want FailedPrecondition", err)
+	}
+	if verified, err := s.users.VerifyEmail(ctx, userID, "reader@example.com", time.Now().UTC()); err != nil || !verified {
+		t.Fatalf("verify email = %t, %v", verified, err)
+	}
+	if _, err := s.CreateBorrow(memberCtx, &proto.Borrow{UserId: int32(userID), Barcode: "V1"}); err != nil {
+		t.Fatalf("borrow after verification = %v", err)
+	}
+}